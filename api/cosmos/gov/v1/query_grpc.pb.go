@@ -38,6 +38,14 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// VoteDelegations queries the vote delegations of a delegator.
+	//
+	// Since: cosmos-sdk 0.47
+	VoteDelegations(ctx context.Context, in *QueryVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsResponse, error)
+	// VoteDelegators queries the vote delegations made to a delegate.
+	//
+	// Since: cosmos-sdk 0.47
+	VoteDelegators(ctx context.Context, in *QueryVoteDelegatorsRequest, opts ...grpc.CallOption) (*QueryVoteDelegatorsResponse, error)
 }
 
 type queryClient struct {
@@ -120,6 +128,24 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) VoteDelegations(ctx context.Context, in *QueryVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsResponse, error) {
+	out := new(QueryVoteDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/VoteDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VoteDelegators(ctx context.Context, in *QueryVoteDelegatorsRequest, opts ...grpc.CallOption) (*QueryVoteDelegatorsResponse, error) {
+	out := new(QueryVoteDelegatorsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/VoteDelegators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -140,6 +166,14 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// VoteDelegations queries the vote delegations of a delegator.
+	//
+	// Since: cosmos-sdk 0.47
+	VoteDelegations(context.Context, *QueryVoteDelegationsRequest) (*QueryVoteDelegationsResponse, error)
+	// VoteDelegators queries the vote delegations made to a delegate.
+	//
+	// Since: cosmos-sdk 0.47
+	VoteDelegators(context.Context, *QueryVoteDelegatorsRequest) (*QueryVoteDelegatorsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -171,6 +205,12 @@ func (UnimplementedQueryServer) Deposits(context.Context, *QueryDepositsRequest)
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) VoteDelegations(context.Context, *QueryVoteDelegationsRequest) (*QueryVoteDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegations not implemented")
+}
+func (UnimplementedQueryServer) VoteDelegators(context.Context, *QueryVoteDelegatorsRequest) (*QueryVoteDelegatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegators not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -328,6 +368,42 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VoteDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/VoteDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteDelegations(ctx, req.(*QueryVoteDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VoteDelegators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteDelegators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/VoteDelegators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteDelegators(ctx, req.(*QueryVoteDelegatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -367,6 +443,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "VoteDelegations",
+			Handler:    _Query_VoteDelegations_Handler,
+		},
+		{
+			MethodName: "VoteDelegators",
+			Handler:    _Query_VoteDelegators_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
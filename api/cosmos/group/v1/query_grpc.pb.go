@@ -52,6 +52,10 @@ type QueryClient interface {
 	// then it simply returns the `final_tally_result` state stored in the
 	// proposal itself.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// FlattenedGroupMembers queries the effective members of a group, that is
+	// its direct members along with the members of its sub-groups, recursively
+	// up to the maximum sub-group depth.
+	FlattenedGroupMembers(ctx context.Context, in *QueryFlattenedGroupMembersRequest, opts ...grpc.CallOption) (*QueryFlattenedGroupMembersResponse, error)
 }
 
 type queryClient struct {
@@ -179,6 +183,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) FlattenedGroupMembers(ctx context.Context, in *QueryFlattenedGroupMembersRequest, opts ...grpc.CallOption) (*QueryFlattenedGroupMembersResponse, error) {
+	out := new(QueryFlattenedGroupMembersResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/FlattenedGroupMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -213,6 +226,10 @@ type QueryServer interface {
 	// then it simply returns the `final_tally_result` state stored in the
 	// proposal itself.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// FlattenedGroupMembers queries the effective members of a group, that is
+	// its direct members along with the members of its sub-groups, recursively
+	// up to the maximum sub-group depth.
+	FlattenedGroupMembers(context.Context, *QueryFlattenedGroupMembersRequest) (*QueryFlattenedGroupMembersResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -259,6 +276,9 @@ func (UnimplementedQueryServer) GroupsByMember(context.Context, *QueryGroupsByMe
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) FlattenedGroupMembers(context.Context, *QueryFlattenedGroupMembersRequest) (*QueryFlattenedGroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlattenedGroupMembers not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -506,6 +526,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FlattenedGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFlattenedGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FlattenedGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/FlattenedGroupMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FlattenedGroupMembers(ctx, req.(*QueryFlattenedGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -565,6 +603,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "FlattenedGroupMembers",
+			Handler:    _Query_FlattenedGroupMembers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -2721,9 +2721,10 @@ const (
 	// SIGN_MODE_DIRECT specifies a signing mode which uses SignDoc and is
 	// verified with raw bytes from Tx.
 	SignMode_SIGN_MODE_DIRECT SignMode = 1
-	// SIGN_MODE_TEXTUAL is a future signing mode that will verify some
-	// human-readable textual representation on top of the binary representation
-	// from SIGN_MODE_DIRECT. It is currently not supported.
+	// SIGN_MODE_TEXTUAL specifies a signing mode which signs a human-readable
+	// textual representation of the transaction, rendered as screens and
+	// encoded in CBOR, which ends with the hash of the raw bytes of the
+	// transaction as in SIGN_MODE_DIRECT.
 	SignMode_SIGN_MODE_TEXTUAL SignMode = 2
 	// SIGN_MODE_DIRECT_AUX specifies a signing mode which uses
 	// SignDocDirectAux. As opposed to SIGN_MODE_DIRECT, this sign mode does not
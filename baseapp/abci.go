@@ -18,6 +18,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -312,10 +313,30 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// Write the DeliverTx state into branched storage and commit the MultiStore.
 	// The write to the DeliverTx state writes all state transitions to the root
 	// MultiStore (app.cms) so when Commit() is called is persists those values.
+	// The state changes cached by the commit listener so far were written to
+	// the branched stores only, and are dropped to keep the ones written to
+	// the MultiStore.
+	ctx := app.deliverState.ctx
+	if app.commitListener != nil {
+		app.commitListener.PopStateCache()
+	}
 	app.deliverState.ms.Write()
 	commitID := app.cms.Commit()
 	app.logger.Info("commit synced", "commit", fmt.Sprintf("%X", commitID))
 
+	res = abci.ResponseCommit{
+		Data:         commitID.Hash,
+		RetainHeight: retainHeight,
+	}
+	if len(app.abciListeners) > 0 {
+		changeSet := app.commitListener.PopStateCache()
+		if app.streamingCommitSync {
+			app.listenCommit(ctx, res, changeSet)
+		} else {
+			app.queueListenCommit(ctx, res, changeSet)
+		}
+	}
+
 	// Reset the Check state to the latest committed.
 	//
 	// NOTE: This is safe because Tendermint holds a lock on the mempool for
@@ -345,12 +366,72 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 
 	go app.snapshotManager.SnapshotIfApplicable(header.Height)
 
-	return abci.ResponseCommit{
-		Data:         commitID.Hash,
-		RetainHeight: retainHeight,
+	return res
+}
+
+// listenCommit calls the ListenCommit hook of the streaming services with the
+// Commit response and the state changes of the block. If a streaming service
+// fails to process it while Commit waits for it, and the app is configured to
+// halt on streaming errors, listenCommit panics: Commit then never returns the
+// response of the failing block, so that Tendermint doesn't move on to the next
+// block.
+func (app *BaseApp) listenCommit(ctx sdk.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) {
+	for _, streamingListener := range app.abciListeners {
+		if err := streamingListener.ListenCommit(ctx, res, changeSet); err != nil {
+			app.logger.Error("Commit listening hook failed", "height", ctx.BlockHeight(), "err", err)
+			if app.streamingHaltOnError && app.streamingCommitSync {
+				panic(fmt.Errorf("commit listening hook failed at height %d: %w", ctx.BlockHeight(), err))
+			}
+		}
 	}
 }
 
+// listenCommitQueueSize is the number of committed blocks which can be waiting
+// for the streaming services in the background before Commit blocks.
+const listenCommitQueueSize = 100
+
+// listenCommitRequest holds the arguments of a ListenCommit hook processed in
+// the background.
+type listenCommitRequest struct {
+	ctx       sdk.Context
+	res       abci.ResponseCommit
+	changeSet []*storetypes.StoreKVPair
+}
+
+// queueListenCommit queues the ListenCommit hook of a committed block, to be
+// processed in the background by a single worker, so that the streaming
+// services receive the blocks one at a time and in height order.
+func (app *BaseApp) queueListenCommit(ctx sdk.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) {
+	if app.listenCommitQueue == nil {
+		app.listenCommitQueue = make(chan listenCommitRequest, listenCommitQueueSize)
+		app.listenCommitDone = make(chan struct{})
+		go func(queue <-chan listenCommitRequest, done chan<- struct{}) {
+			defer close(done)
+			for req := range queue {
+				app.listenCommit(req.ctx, req.res, req.changeSet)
+			}
+		}(app.listenCommitQueue, app.listenCommitDone)
+	}
+
+	app.listenCommitQueue <- listenCommitRequest{ctx: ctx, res: res, changeSet: changeSet}
+}
+
+// Close processes the ListenCommit hooks still queued in the background and
+// stops their worker. It must be called once the node has stopped, as no block
+// can be committed afterwards.
+func (app *BaseApp) Close() error {
+	if app.listenCommitQueue == nil {
+		return nil
+	}
+
+	close(app.listenCommitQueue)
+	<-app.listenCommitDone
+	app.listenCommitQueue = nil
+	app.listenCommitDone = nil
+
+	return nil
+}
+
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
 // back on os.Exit if both fail.
 func (app *BaseApp) halt() {
@@ -436,6 +517,10 @@ func (app *BaseApp) ListSnapshots(req abci.RequestListSnapshots) abci.ResponseLi
 	}
 
 	for _, snapshot := range snapshots {
+		// incremental snapshots can not be restored by state sync
+		if snapshot.Format == snapshottypes.FormatIncremental {
+			continue
+		}
 		abciSnapshot, err := snapshot.ToABCI()
 		if err != nil {
 			app.logger.Error("failed to list snapshots", "err", err)
@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -14,10 +15,10 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
-	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 )
 
@@ -54,7 +55,9 @@ type BaseApp struct { // nolint: maligned
 	grpcQueryRouter   *GRPCQueryRouter     // router for redirecting gRPC query calls
 	msgServiceRouter  *MsgServiceRouter    // router for redirecting Msg service messages
 	interfaceRegistry codectypes.InterfaceRegistry
-	txDecoder         sdk.TxDecoder // unmarshal []byte into sdk.Tx
+	txDecoder         sdk.TxDecoder   // unmarshal []byte into sdk.Tx
+	txEncoder         sdk.TxEncoder   // marshal sdk.Tx into []byte
+	mempool           mempool.Mempool // application side mempool
 
 	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
 	postHandler    sdk.AnteHandler  // post handler, optional, e.g. for tips
@@ -133,6 +136,26 @@ type BaseApp struct { // nolint: maligned
 	// abciListeners for hooking into the ABCI message processing of the BaseApp
 	// and exposing the requests and responses to external consumers
 	abciListeners []ABCIListener
+
+	// commitListener caches the state changes written at Commit to the stores
+	// exposed to the abciListeners, which are passed to their ListenCommit hook
+	commitListener *storetypes.MemoryListener
+
+	// streamingCommitSync makes Commit wait until every abciListener has
+	// processed the ListenCommit hook
+	streamingCommitSync bool
+
+	// listenCommitQueue holds the ListenCommit hooks processed in the background
+	// when streamingCommitSync is off
+	listenCommitQueue chan listenCommitRequest
+
+	// listenCommitDone is closed when the worker of the listenCommitQueue has
+	// processed all the queued hooks
+	listenCommitDone chan struct{}
+
+	// streamingHaltOnError halts the node when an abciListener fails to
+	// process the ListenCommit hook, when streamingCommitSync is on
+	streamingHaltOnError bool
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -154,6 +177,7 @@ func NewBaseApp(
 		grpcQueryRouter:  NewGRPCQueryRouter(),
 		msgServiceRouter: NewMsgServiceRouter(),
 		txDecoder:        txDecoder,
+		mempool:          mempool.NoOpMempool{},
 		fauxMerkleMode:   false,
 	}
 
@@ -291,6 +315,69 @@ func (app *BaseApp) CommitMultiStore() sdk.CommitMultiStore {
 	return app.cms
 }
 
+// Mempool returns the application side mempool of the BaseApp.
+func (app *BaseApp) Mempool() mempool.Mempool {
+	return app.mempool
+}
+
+// SelectTxs returns the raw txs to include in the next block, in the order
+// returned by the Select method of the app-side mempool. The selection stops at
+// the first tx which would make the txs exceed maxTxBytes in size or maxGas in
+// gas wanted, a negative maxGas meaning no gas limit. The given txs, i.e. the
+// ones the consensus engine would propose, are passed to Select as a hint and
+// are returned unchanged when the mempool selects nothing, e.g. with the
+// default NoOpMempool.
+//
+// The ABCI of Tendermint v0.35 doesn't let the application prepare the block
+// proposals, so nothing in the node calls SelectTxs: it is the hook through
+// which an external block builder lets the app-side mempool decide the content
+// of a block. Like the other ABCI methods, it must not be called concurrently
+// with CheckTx or DeliverTx.
+func (app *BaseApp) SelectTxs(txs [][]byte, maxTxBytes, maxGas int64) [][]byte {
+	var ctx sdk.Context
+	if app.checkState != nil {
+		ctx = app.checkState.ctx
+	}
+
+	iterator := app.mempool.Select(ctx, txs)
+	if iterator == nil {
+		return txs
+	}
+	if app.txEncoder == nil {
+		app.logger.Error("failed to select txs from mempool", "err", "no TxEncoder set")
+		return txs
+	}
+
+	var (
+		selected       [][]byte
+		totalTxBytes   int64
+		totalGasWanted uint64
+	)
+	for ; iterator != nil; iterator = iterator.Next() {
+		tx := iterator.Tx()
+		bz, err := app.txEncoder(tx)
+		if err != nil {
+			app.logger.Error("failed to encode mempool tx", "err", err)
+			continue
+		}
+
+		totalTxBytes += int64(len(bz))
+		if totalTxBytes > maxTxBytes {
+			break
+		}
+		if feeTx, ok := tx.(sdk.FeeTx); ok && maxGas >= 0 {
+			totalGasWanted += feeTx.GetGas()
+			if totalGasWanted > uint64(maxGas) {
+				break
+			}
+		}
+
+		selected = append(selected, bz)
+	}
+
+	return selected
+}
+
 // SnapshotManager returns the snapshot manager.
 // application use this to register extra extension snapshotters.
 func (app *BaseApp) SnapshotManager() *snapshots.Manager {
@@ -331,11 +418,7 @@ func (app *BaseApp) Init() error {
 	app.setCheckState(tmproto.Header{})
 	app.Seal()
 
-	rms, ok := app.cms.(*rootmulti.Store)
-	if !ok {
-		return fmt.Errorf("invalid commit multi-store; expected %T, got: %T", &rootmulti.Store{}, app.cms)
-	}
-	return rms.GetPruning().Validate()
+	return app.cms.GetPruning().Validate()
 }
 
 func (app *BaseApp) setMinGasPrices(gasPrices sdk.DecCoins) {
@@ -646,6 +729,14 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		return sdk.GasInfo{}, nil, nil, 0, err
 	}
 
+	// A tx included in a block leaves the app-side mempool whatever the outcome
+	// of its execution is. A failure to remove it must not change that outcome.
+	if mode == runTxModeDeliver {
+		if err := app.mempool.Remove(tx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+			app.logger.Error("failed to remove tx from mempool", "err", err)
+		}
+	}
+
 	if app.anteHandler != nil {
 		var (
 			anteCtx sdk.Context
@@ -679,12 +770,30 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		gasWanted = ctx.GasMeter().Limit()
 
 		if err != nil {
+			// A tx which became invalid after the last Commit is evicted from
+			// the app-side mempool.
+			if mode == runTxModeReCheck {
+				if rmErr := app.mempool.Remove(tx); rmErr != nil && !errors.Is(rmErr, mempool.ErrTxNotFound) {
+					app.logger.Error("failed to remove tx from mempool", "err", rmErr)
+				}
+			}
+
 			return gInfo, nil, nil, 0, err
 		}
 
 		priority = ctx.Priority()
+
+		// The tx enters the app-side mempool before the state changes of the
+		// AnteHandler are written, so that a tx rejected by the mempool, e.g.
+		// at capacity, does not bump the sequence of its sender in checkState.
+		if err := app.insertMempoolTx(ctx, tx, mode); err != nil {
+			return gInfo, nil, nil, priority, err
+		}
+
 		msCache.Write()
 		anteEvents = events.ToABCIEvents()
+	} else if err := app.insertMempoolTx(ctx, tx, mode); err != nil {
+		return gInfo, nil, nil, priority, err
 	}
 
 	// Create a new Context based off of the existing Context with a MultiStore branch
@@ -725,6 +834,18 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 	return gInfo, result, anteEvents, priority, err
 }
 
+// insertMempoolTx inserts a tx which passed CheckTx into the app-side mempool.
+// A tx passing a re-check after Commit is inserted again, which tells a
+// mempool evicting the txs that are no longer re-checked, i.e. that the
+// consensus engine dropped from its own mempool, that the tx is still pending.
+func (app *BaseApp) insertMempoolTx(ctx sdk.Context, tx sdk.Tx, mode runTxMode) error {
+	if mode != runTxModeCheck && mode != runTxModeReCheck {
+		return nil
+	}
+
+	return app.mempool.Insert(ctx, tx)
+}
+
 // runMsgs iterates through a list of messages and executes them with the provided
 // Context and execution mode. Messages will only be executed during simulation
 // and DeliverTx. An error is returned if any single message fails or if a
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/rand"
+	"sort"
 	"strings"
 	"sync"
 	"testing"
@@ -30,6 +32,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 )
 
@@ -965,6 +968,192 @@ func TestCheckTx(t *testing.T) {
 	require.Nil(t, storedBytes)
 }
 
+// counterMempool is a mempool which tracks txTest transactions by counter.
+type counterMempool struct {
+	txs       map[int64]sdk.Tx
+	insertErr error
+	removeErr error
+}
+
+var _ mempool.Mempool = (*counterMempool)(nil)
+
+func (mp *counterMempool) Insert(_ sdk.Context, tx sdk.Tx) error {
+	if mp.insertErr != nil {
+		return mp.insertErr
+	}
+
+	mp.txs[tx.(txTest).Counter] = tx
+	return nil
+}
+
+// Select returns the txs by decreasing counter.
+func (mp *counterMempool) Select(sdk.Context, [][]byte) mempool.Iterator {
+	if len(mp.txs) == 0 {
+		return nil
+	}
+
+	txs := make([]sdk.Tx, 0, len(mp.txs))
+	for _, tx := range mp.txs {
+		txs = append(txs, tx)
+	}
+	sort.Slice(txs, func(i, j int) bool { return txs[i].(txTest).Counter > txs[j].(txTest).Counter })
+	return &counterIterator{txs: txs}
+}
+
+func (mp *counterMempool) CountTx() int { return len(mp.txs) }
+
+func (mp *counterMempool) Remove(tx sdk.Tx) error {
+	if mp.removeErr != nil {
+		return mp.removeErr
+	}
+
+	counter := tx.(txTest).Counter
+	if _, ok := mp.txs[counter]; !ok {
+		return mempool.ErrTxNotFound
+	}
+
+	delete(mp.txs, counter)
+	return nil
+}
+
+type counterIterator struct {
+	txs []sdk.Tx
+}
+
+func (it *counterIterator) Next() mempool.Iterator {
+	if len(it.txs) == 1 {
+		return nil
+	}
+	return &counterIterator{txs: it.txs[1:]}
+}
+
+func (it *counterIterator) Tx() sdk.Tx { return it.txs[0] }
+
+func TestCheckTxMempool(t *testing.T) {
+	counterKey := []byte("counter-key")
+	mp := &counterMempool{txs: make(map[int64]sdk.Tx)}
+
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, counterKey)) }
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, SetMempool(mp))
+	require.Equal(t, mp, app.Mempool())
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	// txs passing the AnteHandler are inserted into the mempool
+	for i := int64(0); i < 3; i++ {
+		txBytes, err := codec.Marshal(newTxCounter(i, 0))
+		require.NoError(t, err)
+
+		r := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+		require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+	}
+	require.Equal(t, 3, mp.CountTx())
+
+	// txs failing the AnteHandler are not
+	tx := newTxCounter(3, 0)
+	tx.setFailOnAnte(true)
+	txBytes, err := codec.Marshal(tx)
+	require.NoError(t, err)
+	r := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.False(t, r.IsOK())
+	require.Equal(t, 3, mp.CountTx())
+
+	// a tx rejected by the mempool leaves the state of the AnteHandler, i.e.
+	// its counter, unchanged in checkState
+	mp.insertErr = mempool.ErrMempoolTxMaxCapacity
+	txBytes, err = codec.Marshal(newTxCounter(3, 0))
+	require.NoError(t, err)
+	r = app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.False(t, r.IsOK())
+	require.Equal(t, 3, mp.CountTx())
+
+	mp.insertErr = nil
+	r = app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+	require.Equal(t, 4, mp.CountTx())
+	delete(mp.txs, 3)
+
+	// a tx included in a block is removed from the mempool
+	header := tmproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	txBytes, err = codec.Marshal(newTxCounter(0, 0))
+	require.NoError(t, err)
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, 2, mp.CountTx())
+
+	// a failure to remove a tx from the mempool does not fail it
+	mp.removeErr = errors.New("remove failed")
+	txBytes, err = codec.Marshal(newTxCounter(1, 0))
+	require.NoError(t, err)
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, 2, mp.CountTx())
+	mp.removeErr = nil
+
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	// a tx failing re-check after Commit is evicted from the mempool
+	tx = newTxCounter(1, 0)
+	tx.setFailOnAnte(true)
+	txBytes, err = codec.Marshal(tx)
+	require.NoError(t, err)
+	r = app.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_Recheck})
+	require.False(t, r.IsOK())
+	require.Equal(t, 1, mp.CountTx())
+
+	// a tx passing re-check is inserted again, e.g. to reset its age
+	delete(mp.txs, 2)
+	txBytes, err = codec.Marshal(newTxCounter(2, 0))
+	require.NoError(t, err)
+	r = app.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_Recheck})
+	require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+	require.Equal(t, 1, mp.CountTx())
+}
+
+func TestSelectTxs(t *testing.T) {
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	// the txs proposed by the consensus engine are kept without mempool
+	app := setupBaseApp(t)
+	proposed := [][]byte{[]byte("tx")}
+	require.Equal(t, proposed, app.SelectTxs(proposed, 100, -1))
+
+	mp := &counterMempool{txs: make(map[int64]sdk.Tx)}
+	app = setupBaseApp(t, SetMempool(mp))
+	app.SetTxEncoder(aminoTxEncoder())
+	app.InitChain(abci.RequestInitChain{})
+	require.Equal(t, proposed, app.SelectTxs(proposed, 100, -1))
+
+	var txs [][]byte
+	for i := int64(0); i < 3; i++ {
+		tx := newTxCounter(i, 0)
+		require.NoError(t, mp.Insert(sdk.Context{}, *tx))
+		txBytes, err := codec.Marshal(tx)
+		require.NoError(t, err)
+		txs = append([][]byte{txBytes}, txs...)
+	}
+
+	// the txs are returned in the order of the mempool
+	require.Equal(t, txs, app.SelectTxs(proposed, 1000, -1))
+
+	// up to the max size of the txs
+	maxTxBytes := int64(len(txs[0]) + len(txs[1]))
+	require.Equal(t, txs[:2], app.SelectTxs(proposed, maxTxBytes, -1))
+	require.Equal(t, txs[:1], app.SelectTxs(proposed, maxTxBytes-1, -1))
+}
+
 // Test that successive DeliverTx can see each others' effects
 // on the store, both within and across blocks.
 func TestDeliverTx(t *testing.T) {
@@ -1013,6 +1202,179 @@ func TestDeliverTx(t *testing.T) {
 	}
 }
 
+// mockStreamingService records the Commit responses and change sets passed to
+// ListenCommit, and fails to process the block at failHeight, if set.
+type mockStreamingService struct {
+	mtx        sync.Mutex
+	listeners  map[storetypes.StoreKey][]storetypes.WriteListener
+	commits    []abci.ResponseCommit
+	changeSets [][]*storetypes.StoreKVPair
+	heights    []int64
+	failHeight int64
+}
+
+func (m *mockStreamingService) Stream(wg *sync.WaitGroup) error { return nil }
+
+func (m *mockStreamingService) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return m.listeners
+}
+
+func (m *mockStreamingService) ListenBeginBlock(sdk.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+
+func (m *mockStreamingService) ListenEndBlock(sdk.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+func (m *mockStreamingService) ListenDeliverTx(sdk.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+func (m *mockStreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if ctx.BlockHeight() == m.failHeight {
+		return fmt.Errorf("failed to process block %d", m.failHeight)
+	}
+
+	m.commits = append(m.commits, res)
+	m.changeSets = append(m.changeSets, changeSet)
+	m.heights = append(m.heights, ctx.BlockHeight())
+	return nil
+}
+
+func (m *mockStreamingService) committedHeights() []int64 {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return append([]int64(nil), m.heights...)
+}
+
+func (m *mockStreamingService) Close() error { return nil }
+
+func TestListenCommit(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(r)
+	}
+
+	streamer := &mockStreamingService{
+		listeners: map[storetypes.StoreKey][]storetypes.WriteListener{capKey1: nil},
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.SetStreamingService(streamer)
+	app.SetStreamingCommitSync(true)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	nBlocks := 2
+	for blockN := 0; blockN < nBlocks; blockN++ {
+		header := tmproto.Header{Height: int64(blockN) + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		counter := int64(blockN)
+		txBytes, err := codec.Marshal(newTxCounter(counter, counter))
+		require.NoError(t, err)
+
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+		app.EndBlock(abci.RequestEndBlock{})
+		commitRes := app.Commit()
+
+		// in commit-sync mode the listener must have been invoked before Commit returns
+		require.Len(t, streamer.commits, blockN+1)
+		require.Equal(t, commitRes, streamer.commits[blockN])
+
+		changeSet := streamer.changeSets[blockN]
+		require.Len(t, changeSet, 2)
+		keys := make([][]byte, 0, len(changeSet))
+		for _, pair := range changeSet {
+			require.Equal(t, capKey1.Name(), pair.StoreKey)
+			require.False(t, pair.Delete)
+			keys = append(keys, pair.Key)
+		}
+		require.ElementsMatch(t, [][]byte{anteKey, deliverKey}, keys)
+	}
+}
+
+func TestListenCommitAsyncOrder(t *testing.T) {
+	streamer := &mockStreamingService{
+		listeners: map[storetypes.StoreKey][]storetypes.WriteListener{capKey1: nil},
+	}
+
+	app := setupBaseApp(t)
+	app.SetStreamingService(streamer)
+	app.SetStreamingCommitSync(false)
+	app.InitChain(abci.RequestInitChain{})
+
+	nBlocks := 20
+	expHeights := make([]int64, 0, nBlocks)
+	for height := int64(1); height <= int64(nBlocks); height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+		expHeights = append(expHeights, height)
+	}
+
+	// the blocks are processed in the background, in height order, and the
+	// queued ones are processed when the app is closed
+	require.NoError(t, app.Close())
+	require.Equal(t, expHeights, streamer.committedHeights())
+	require.NoError(t, app.Close())
+}
+
+func TestListenCommitHaltOnError(t *testing.T) {
+	commit := func(app *BaseApp, height int64) abci.ResponseCommit {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{})
+		return app.Commit()
+	}
+
+	// in commit-sync mode, Commit never returns the response of the failing
+	// block, so that it's never acknowledged to Tendermint
+	streamer := &mockStreamingService{
+		listeners:  map[storetypes.StoreKey][]storetypes.WriteListener{capKey1: nil},
+		failHeight: 2,
+	}
+	app := setupBaseApp(t)
+	app.SetStreamingService(streamer)
+	app.SetStreamingCommitSync(true)
+	app.SetStreamingHaltOnError(true)
+	app.InitChain(abci.RequestInitChain{})
+
+	commit(app, 1)
+	require.Panics(t, func() { commit(app, 2) })
+	require.Equal(t, []int64{1}, streamer.committedHeights())
+
+	// in the background, the error is only logged as the following blocks are
+	// already committed
+	streamer = &mockStreamingService{
+		listeners:  map[storetypes.StoreKey][]storetypes.WriteListener{capKey1: nil},
+		failHeight: 2,
+	}
+	app = setupBaseApp(t)
+	app.SetStreamingService(streamer)
+	app.SetStreamingCommitSync(false)
+	app.SetStreamingHaltOnError(true)
+	app.InitChain(abci.RequestInitChain{})
+
+	for height := int64(1); height <= 3; height++ {
+		commit(app, height)
+	}
+	require.NoError(t, app.Close())
+	require.Equal(t, []int64{1, 3}, streamer.committedHeights())
+}
+
 // Number of messages doesn't matter to CheckTx.
 func TestMultiMsgCheckTx(t *testing.T) {
 	// TODO: ensure we get the same results
@@ -1829,7 +2191,7 @@ func TestSnapshotWithPruning(t *testing.T) {
 				pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
 			},
 			expectedSnapshots: []*abci.Snapshot{
-				{Height: 20, Format: 2, Chunks: 5},
+				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 5},
 			},
 		},
 		"prune everything with snapshot": {
@@ -1841,7 +2203,7 @@ func TestSnapshotWithPruning(t *testing.T) {
 				pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningEverything),
 			},
 			expectedSnapshots: []*abci.Snapshot{
-				{Height: 20, Format: 2, Chunks: 5},
+				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 5},
 			},
 		},
 		"default pruning with snapshot": {
@@ -1853,7 +2215,7 @@ func TestSnapshotWithPruning(t *testing.T) {
 				pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningDefault),
 			},
 			expectedSnapshots: []*abci.Snapshot{
-				{Height: 20, Format: 2, Chunks: 5},
+				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 5},
 			},
 		},
 		"custom": {
@@ -1865,8 +2227,8 @@ func TestSnapshotWithPruning(t *testing.T) {
 				pruningOpts:        pruningtypes.NewCustomPruningOptions(12, 12),
 			},
 			expectedSnapshots: []*abci.Snapshot{
-				{Height: 25, Format: 2, Chunks: 6},
-				{Height: 20, Format: 2, Chunks: 5},
+				{Height: 25, Format: snapshottypes.CurrentFormat, Chunks: 6},
+				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 5},
 			},
 		},
 		"no snapshots": {
@@ -1887,9 +2249,9 @@ func TestSnapshotWithPruning(t *testing.T) {
 				pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
 			},
 			expectedSnapshots: []*abci.Snapshot{
-				{Height: 9, Format: 2, Chunks: 2},
-				{Height: 6, Format: 2, Chunks: 2},
-				{Height: 3, Format: 2, Chunks: 1},
+				{Height: 9, Format: snapshottypes.CurrentFormat, Chunks: 2},
+				{Height: 6, Format: snapshottypes.CurrentFormat, Chunks: 2},
+				{Height: 3, Format: snapshottypes.CurrentFormat, Chunks: 1},
 			},
 		},
 	}
@@ -1965,7 +2327,7 @@ func TestLoadSnapshotChunk(t *testing.T) {
 	}{
 		"Existing snapshot": {2, snapshottypes.CurrentFormat, 1, false},
 		"Missing height":    {100, snapshottypes.CurrentFormat, 1, true},
-		"Missing format":    {2, 3, 1, true},
+		"Missing format":    {2, snapshottypes.FormatZlibStream, 1, true},
 		"Missing chunk":     {2, snapshottypes.CurrentFormat, 9, true},
 		"Zero height":       {0, snapshottypes.CurrentFormat, 1, true},
 		"Zero format":       {2, 0, 1, true},
@@ -2117,6 +2479,74 @@ func TestApplySnapshotChunk(t *testing.T) {
 	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
 }
 
+func TestIncrementalSnapshot(t *testing.T) {
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+	// the ante handler writes in CheckTx, and the handler fails on the "fail" key after writing it,
+	// so that only the changes of the successful txs of DeliverTx are committed
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			if ctx.IsCheckTx() {
+				ctx.KVStore(capKey1).Set([]byte("checked"), []byte{byte(ctx.BlockHeight())})
+			}
+			return ctx, nil
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgKeyValue, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			kv := msg.(*msgKeyValue)
+			ctx.KVStore(capKey2).Set(kv.Key, kv.Value)
+			if bytes.Equal(kv.Key, []byte("fail")) {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "failing msg")
+			}
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), testutil.GetTempDir(t))
+	require.NoError(t, err)
+	// the snapshots are created by hand, the large intervals only enable the incremental snapshots
+	opts := snapshottypes.SnapshotOptions{Interval: 1000, IncrementalInterval: 1000}
+
+	source := setupBaseApp(t, anteOpt, routerOpt, SetSnapshot(snapshotStore, opts))
+	source.InitChain(abci.RequestInitChain{})
+
+	for height := int64(1); height <= 4; height++ {
+		source.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+
+		key := []byte(fmt.Sprintf("key%d", height))
+		txBytes, err := codec.Marshal(txTest{Msgs: []sdk.Msg{msgKeyValue{Key: key, Value: key}}})
+		require.NoError(t, err)
+		resCheck := source.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+		require.True(t, resCheck.IsOK(), "%v", resCheck.String())
+		resDeliver := source.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.True(t, resDeliver.IsOK(), "%v", resDeliver.String())
+
+		txBytes, err = codec.Marshal(txTest{Msgs: []sdk.Msg{msgKeyValue{Key: []byte("fail"), Value: key}}})
+		require.NoError(t, err)
+		resDeliver = source.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.False(t, resDeliver.IsOK(), "%v", resDeliver.String())
+
+		source.EndBlock(abci.RequestEndBlock{Height: height})
+		source.Commit()
+
+		if height == 2 {
+			_, err = source.snapshotManager.Create(uint64(height))
+			require.NoError(t, err)
+		}
+	}
+	_, err = source.snapshotManager.CreateIncremental(2, 4)
+	require.NoError(t, err)
+
+	// restore the base snapshot then the incremental one on top of it
+	target := setupBaseApp(t, anteOpt, routerOpt, SetSnapshot(snapshotStore, opts))
+	require.NoError(t, target.snapshotManager.RestoreLocalSnapshot(2, snapshottypes.CurrentFormat))
+	require.NoError(t, target.snapshotManager.RestoreLocalSnapshot(4, snapshottypes.FormatIncremental))
+
+	// The target should now have the same hash as the source
+	assert.Equal(t, source.LastCommitID(), target.cms.LastCommitID())
+}
+
 // NOTE: represents a new custom router for testing purposes of WithRouter()
 type testCustomRouter struct {
 	routes sync.Map
@@ -17,6 +17,7 @@ import (
 type MsgServiceRouter struct {
 	interfaceRegistry codectypes.InterfaceRegistry
 	routes            map[string]MsgServiceHandler
+	msgFilter         MsgFilter
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
@@ -31,6 +32,10 @@ func NewMsgServiceRouter() *MsgServiceRouter {
 // MsgServiceHandler defines a function type which handles Msg service message.
 type MsgServiceHandler = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error)
 
+// MsgFilter is run by the MsgServiceRouter before the handler of every msg,
+// which is rejected if the filter returns an error.
+type MsgFilter = func(ctx sdk.Context, msg sdk.Msg) error
+
 // Handler returns the MsgServiceHandler for a given msg or nil if not found.
 func (msr *MsgServiceRouter) Handler(msg sdk.Msg) MsgServiceHandler {
 	return msr.routes[sdk.MsgTypeURL(msg)]
@@ -107,6 +112,12 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 		}
 
 		msr.routes[requestTypeName] = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+			if msr.msgFilter != nil {
+				if err := msr.msgFilter(ctx, req); err != nil {
+					return nil, err
+				}
+			}
+
 			ctx = ctx.WithEventManager(sdk.NewEventManager())
 			interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 				goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
@@ -129,6 +140,13 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 	}
 }
 
+// SetMsgFilter sets the MsgFilter run before the handler of every msg. As the
+// msgs executed by modules, e.g. by x/authz or x/gov, are routed by the same
+// router, the filter also applies to them.
+func (msr *MsgServiceRouter) SetMsgFilter(filter MsgFilter) {
+	msr.msgFilter = filter
+}
+
 // SetInterfaceRegistry sets the interface registry for the router.
 func (msr *MsgServiceRouter) SetInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) {
 	msr.interfaceRegistry = interfaceRegistry
@@ -1,6 +1,8 @@
 package baseapp_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -10,11 +12,13 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"cosmossdk.io/depinject"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -142,3 +146,28 @@ func TestMsgService(t *testing.T) {
 	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
 	require.Equal(t, abci.CodeTypeOK, res.Code, "res=%+v", res)
 }
+
+func TestMsgServiceFilter(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(interfaceRegistry)
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(interfaceRegistry)
+	testdata.RegisterMsgServer(router, testdata.MsgServerImpl{})
+
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	ctx := sdk.Context{}.WithContext(context.Background())
+	handler := router.Handler(msg)
+	require.NotNil(t, handler)
+
+	_, err := handler(ctx, msg)
+	require.NoError(t, err)
+
+	// the filter runs before the handler of every msg
+	filterErr := errors.New("filtered")
+	router.SetMsgFilter(func(_ sdk.Context, filtered sdk.Msg) error {
+		require.Equal(t, msg, filtered)
+		return filterErr
+	})
+	_, err = handler(ctx, msg)
+	require.ErrorIs(t, err, filterErr)
+}
@@ -7,11 +7,15 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	dbm2 "github.com/cosmos/cosmos-sdk/db"
 	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/v2alpha1/multi"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 // File for storing in-package BaseApp optional functions,
@@ -22,6 +26,45 @@ func SetPruning(opts pruningtypes.PruningOptions) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetPruning(opts) }
 }
 
+// SetStorePruning sets the pruning options of the named stores on the multistore associated
+// with the app, overriding the ones set with SetPruning for these stores.
+func SetStorePruning(opts map[string]pruningtypes.PruningOptions) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		if len(opts) == 0 {
+			return
+		}
+		cms, ok := bapp.cms.(storetypes.StorePruner)
+		if !ok {
+			panic(fmt.Sprintf("multistore %T does not support per-store pruning", bapp.cms))
+		}
+		for storeName, storeOpts := range opts {
+			cms.SetStorePruning(storeName, storeOpts)
+		}
+	}
+}
+
+// SetAsyncPruning sets the options of the background pruning on the multistore associated
+// with the app.
+func SetAsyncPruning(opts pruningtypes.AsyncPruningOptions) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		if !opts.Enabled {
+			return
+		}
+		cms, ok := bapp.cms.(storetypes.AsyncPruner)
+		if !ok {
+			panic(fmt.Sprintf("multistore %T does not support background pruning", bapp.cms))
+		}
+		cms.SetAsyncPruning(opts)
+	}
+}
+
+// SetMultiStoreV2 returns an option running the app on the v2 multistore, storing the state
+// in the given DB instead of the app DB. It replaces the multistore of the app, and must thus
+// precede the options configuring it.
+func SetMultiStoreV2(db dbm2.Connection, config multi.StoreConfig) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.SetCMS(multi.NewCompatStore(db, config)) }
+}
+
 // SetMinGasPrices returns an option that sets the minimum gas prices on the app.
 func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
@@ -75,6 +118,11 @@ func SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOpti
 	return func(app *BaseApp) { app.SetSnapshot(snapshotStore, opts) }
 }
 
+// SetMempool sets the application side mempool of the BaseApp.
+func SetMempool(mempool mempool.Mempool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetMempool(mempool) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -218,6 +266,11 @@ func (app *BaseApp) SetSnapshot(snapshotStore *snapshots.Store, opts snapshottyp
 		return
 	}
 	app.cms.SetSnapshotInterval(opts.Interval)
+	if opts.IncrementalInterval > 0 {
+		if cms, ok := app.cms.(snapshottypes.IncrementalSnapshotter); ok {
+			cms.EnableIncrementalSnapshots()
+		}
+	}
 	app.snapshotManager = snapshots.NewManager(snapshotStore, opts, app.cms, nil, app.logger)
 }
 
@@ -234,12 +287,50 @@ func (app *BaseApp) SetStreamingService(s StreamingService) {
 	for key, lis := range s.Listeners() {
 		app.cms.AddListeners(key, lis)
 	}
+	// cache the state changes written at Commit to the exposed stores, to be passed to the ListenCommit hook
+	if app.commitListener == nil {
+		app.commitListener = storetypes.NewMemoryListener()
+	}
+	for key := range s.Listeners() {
+		app.cms.AddListeners(key, []storetypes.WriteListener{app.commitListener})
+	}
 	// register the StreamingService within the BaseApp
-	// BaseApp will pass BeginBlock, DeliverTx, and EndBlock requests and responses to the streaming services to update their ABCI context
+	// BaseApp will pass BeginBlock, DeliverTx, EndBlock, and Commit requests and responses to the streaming services to update their ABCI context
 	app.abciListeners = append(app.abciListeners, s)
 }
 
+// SetStreamingCommitSync sets whether Commit waits until every streaming service
+// has processed the ListenCommit hook before returning. Otherwise the hooks
+// are processed in the background, one block at a time and in height order.
+func (app *BaseApp) SetStreamingCommitSync(sync bool) {
+	app.streamingCommitSync = sync
+}
+
+// SetStreamingHaltOnError sets whether the node is halted when a streaming
+// service fails to process the ListenCommit hook. It only applies when Commit
+// waits for the streaming services, see SetStreamingCommitSync: the hooks
+// processed in the background only log their errors, as the blocks following
+// a failing one have already been committed.
+func (app *BaseApp) SetStreamingHaltOnError(halt bool) {
+	app.streamingHaltOnError = halt
+}
+
 // SetTxDecoder sets the TxDecoder if it wasn't provided in the BaseApp constructor.
 func (app *BaseApp) SetTxDecoder(txDecoder sdk.TxDecoder) {
 	app.txDecoder = txDecoder
 }
+
+// SetTxEncoder sets the TxEncoder used to encode the txs selected from the
+// app-side mempool.
+func (app *BaseApp) SetTxEncoder(txEncoder sdk.TxEncoder) {
+	app.txEncoder = txEncoder
+}
+
+// SetMempool sets the application side mempool of the BaseApp.
+func (app *BaseApp) SetMempool(mempool mempool.Mempool) {
+	if app.sealed {
+		panic("SetMempool() on sealed BaseApp")
+	}
+
+	app.mempool = mempool
+}
@@ -18,6 +18,11 @@ type ABCIListener interface {
 	ListenEndBlock(ctx types.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
 	// ListenDeliverTx updates the steaming service with the latest DeliverTx messages
 	ListenDeliverTx(ctx types.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	// ListenCommit updates the streaming service with the latest Commit response and the state changes
+	// written to the exposed stores by the committed block. Returning nil acknowledges the block.
+	// The blocks are passed one at a time and in height order, even when the hooks are processed
+	// in the background.
+	ListenCommit(ctx types.Context, res abci.ResponseCommit, changeSet []*store.StoreKVPair) error
 }
 
 // StreamingService interface for registering WriteListeners with the BaseApp and updating the service with the ABCI messages using the hooks
@@ -25,35 +25,36 @@ import (
 // Context implements a typical context created in SDK modules for transaction
 // handling and queries.
 type Context struct {
-	FromAddress       sdk.AccAddress
-	Client            rpcclient.Client
-	GRPCClient        *grpc.ClientConn
-	ChainID           string
-	Codec             codec.Codec
-	InterfaceRegistry codectypes.InterfaceRegistry
-	Input             io.Reader
-	Keyring           keyring.Keyring
-	KeyringOptions    []keyring.Option
-	Output            io.Writer
-	OutputFormat      string
-	Height            int64
-	HomeDir           string
-	KeyringDir        string
-	From              string
-	BroadcastMode     string
-	FromName          string
-	SignModeStr       string
-	UseLedger         bool
-	Simulate          bool
-	GenerateOnly      bool
-	Offline           bool
-	SkipConfirm       bool
-	TxConfig          TxConfig
-	AccountRetriever  AccountRetriever
-	NodeURI           string
-	FeePayer          sdk.AccAddress
-	FeeGranter        sdk.AccAddress
-	Viper             *viper.Viper
+	FromAddress        sdk.AccAddress
+	Client             rpcclient.Client
+	GRPCClient         *grpc.ClientConn
+	ChainID            string
+	Codec              codec.Codec
+	InterfaceRegistry  codectypes.InterfaceRegistry
+	Input              io.Reader
+	Keyring            keyring.Keyring
+	KeyringOptions     []keyring.Option
+	Output             io.Writer
+	OutputFormat       string
+	Height             int64
+	HomeDir            string
+	KeyringDir         string
+	From               string
+	BroadcastMode      string
+	FromName           string
+	SignModeStr        string
+	UseLedger          bool
+	Simulate           bool
+	GenerateOnly       bool
+	Offline            bool
+	SkipConfirm        bool
+	TxConfig           TxConfig
+	AccountRetriever   AccountRetriever
+	GasPricesEstimator GasPricesEstimator
+	NodeURI            string
+	FeePayer           sdk.AccAddress
+	FeeGranter         sdk.AccAddress
+	Viper              *viper.Viper
 
 	// IsAux is true when the signer is an auxiliary signer (e.g. the tipper).
 	IsAux bool
@@ -244,6 +245,12 @@ func (ctx Context) WithAccountRetriever(retriever AccountRetriever) Context {
 	return ctx
 }
 
+// WithGasPricesEstimator returns the context with an updated GasPricesEstimator
+func (ctx Context) WithGasPricesEstimator(estimator GasPricesEstimator) Context {
+	ctx.GasPricesEstimator = estimator
+	return ctx
+}
+
 // WithInterfaceRegistry returns the context with an updated InterfaceRegistry
 func (ctx Context) WithInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) Context {
 	ctx.InterfaceRegistry = interfaceRegistry
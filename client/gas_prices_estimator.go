@@ -0,0 +1,14 @@
+package client
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GasPricesEstimator defines the interface used by the transaction factory to
+// estimate the gas prices required by the chain when neither the fees nor the
+// gas prices of a transaction are set. The gas prices are estimated in feeDenom,
+// or in the default fee denom of the chain if it is empty. An empty result
+// leaves the fees unset.
+type GasPricesEstimator interface {
+	EstimateGasPrices(clientCtx Context, feeDenom string) (sdk.DecCoins, error)
+}
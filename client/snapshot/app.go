@@ -0,0 +1,48 @@
+package snapshot
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+)
+
+// snapshotApp is implemented by the applications exposing their snapshot
+// manager, e.g. through BaseApp.
+type snapshotApp interface {
+	SnapshotManager() *snapshots.Manager
+}
+
+// newApp creates the application from the home directory of the server context.
+// The caller must close the returned application database.
+func newApp(ctx *server.Context, appCreator servertypes.AppCreator) (servertypes.Application, func() error, error) {
+	db, err := server.OpenDB(ctx.Config.RootDir, server.GetAppDBBackend(ctx.Viper))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the application only sets up its snapshot manager when state sync snapshots
+	// are enabled, the interval is irrelevant here since no block is committed
+	if ctx.Viper.GetUint64(server.FlagStateSyncSnapshotInterval) == 0 {
+		ctx.Viper.Set(server.FlagStateSyncSnapshotInterval, uint64(math.MaxUint64))
+	}
+
+	app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+	return app, db.Close, nil
+}
+
+// snapshotManager returns the snapshot manager of the application.
+func snapshotManager(app servertypes.Application) (*snapshots.Manager, error) {
+	sApp, ok := app.(snapshotApp)
+	if !ok {
+		return nil, fmt.Errorf("application of type %T does not expose its snapshot manager", app)
+	}
+
+	manager := sApp.SnapshotManager()
+	if manager == nil {
+		return nil, fmt.Errorf("application has no snapshot manager")
+	}
+	return manager, nil
+}
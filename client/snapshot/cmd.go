@@ -0,0 +1,28 @@
+package snapshot
+
+import (
+	"github.com/spf13/cobra"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// Cmd returns the snapshots group command
+func Cmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage local state sync snapshots",
+		Long: `Manage the state sync snapshots of the local snapshot store, without going through
+Tendermint state sync. Snapshots can be dumped to and loaded from archive files, which
+allows bootstrapping nodes without P2P state sync. The node must not be running.
+`,
+	}
+	cmd.AddCommand(
+		ListSnapshotsCmd(),
+		ExportSnapshotCmd(appCreator),
+		RestoreSnapshotCmd(appCreator),
+		DumpArchiveCmd(),
+		LoadArchiveCmd(),
+		DeleteSnapshotCmd(),
+	)
+	return cmd
+}
@@ -0,0 +1,44 @@
+package snapshot
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// DeleteSnapshotCmd returns the command to delete a local snapshot
+func DeleteSnapshotCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <height> <format>",
+		Short: "Delete a local snapshot",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseHeightAndFormat(args)
+			if err != nil {
+				return err
+			}
+
+			ctx := server.GetServerContextFromCmd(cmd)
+			snapshotStore, err := server.GetSnapshotStore(ctx.Viper)
+			if err != nil {
+				return err
+			}
+			return snapshotStore.Delete(height, format)
+		},
+	}
+}
+
+// parseHeightAndFormat parses the <height> <format> arguments of a command
+func parseHeightAndFormat(args []string) (uint64, uint32, error) {
+	height, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	format, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	return height, uint32(format), nil
+}
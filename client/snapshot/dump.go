@@ -0,0 +1,134 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+)
+
+const (
+	FlagOutput = "output"
+
+	// SnapshotFileName is the name of the archive entry holding the snapshot metadata,
+	// the chunks follow it in entries named after their index.
+	SnapshotFileName = "_snapshot"
+)
+
+// DumpArchiveCmd returns the command to dump a local snapshot to an archive file
+func DumpArchiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump <height> <format>",
+		Short: "Dump a local snapshot to a single archive file",
+		Long: `Dump a snapshot of the local snapshot store to a gzip compressed tar archive, which
+can be loaded into the snapshot store of another node with the load command.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseHeightAndFormat(args)
+			if err != nil {
+				return err
+			}
+
+			output, err := cmd.Flags().GetString(FlagOutput)
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				output = fmt.Sprintf("%d-%d.tar.gz", height, format)
+			}
+
+			ctx := server.GetServerContextFromCmd(cmd)
+			snapshotStore, err := server.GetSnapshotStore(ctx.Viper)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			if err := DumpArchive(snapshotStore, height, format, file); err != nil {
+				file.Close()
+				os.Remove(output)
+				return err
+			}
+			if err := file.Close(); err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot at height %d format %d dumped to %s\n", height, format, output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(FlagOutput, "o", "", "Output file, <height>-<format>.tar.gz by default")
+	return cmd
+}
+
+// DumpArchive writes the snapshot at the given height and format of the store to w,
+// as a gzip compressed tar archive.
+func DumpArchive(store *snapshots.Store, height uint64, format uint32, w io.Writer) error {
+	snapshot, err := store.Get(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return fmt.Errorf("snapshot at height %d format %d not found", height, format)
+	}
+	bz, err := snapshot.Marshal()
+	if err != nil {
+		return err
+	}
+
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+	if err := writeArchiveEntry(tarWriter, SnapshotFileName, bz); err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := store.LoadChunk(height, format, i)
+		if err != nil {
+			return err
+		}
+		if chunk == nil {
+			return fmt.Errorf("chunk %d of snapshot at height %d format %d not found", i, height, format)
+		}
+		bz, err := io.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			return err
+		}
+		if err := writeArchiveEntry(tarWriter, strconv.FormatUint(uint64(i), 10), bz); err != nil {
+			return err
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+func writeArchiveEntry(tarWriter *tar.Writer, name string, bz []byte) error {
+	header := &tar.Header{
+		Name: name,
+		Mode: 0o644,
+		Size: int64(len(bz)),
+	}
+	if err := tarWriter.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to write the header of archive entry %s: %w", name, err)
+	}
+	if _, err := tarWriter.Write(bz); err != nil {
+		return fmt.Errorf("failed to write archive entry %s: %w", name, err)
+	}
+	return nil
+}
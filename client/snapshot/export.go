@@ -0,0 +1,78 @@
+package snapshot
+
+import (
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+const (
+	FlagHeight     = "height"
+	FlagBaseHeight = "base-height"
+)
+
+// ExportSnapshotCmd returns the command to export the application state to a local snapshot
+func ExportSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the application state to a local snapshot",
+		Long: `Export the application state at the given height, by default the latest committed
+height, to a snapshot of the local snapshot store. The height must not have been pruned.
+
+With --base-height, an incremental snapshot is exported instead, holding the changes of the
+state since the snapshot at the base height, which it is restored on top of and which must be
+the latest snapshot of the store. The changes are only recorded while incremental snapshots are
+enabled (state-sync.snapshot-incremental-interval), but do not depend on the pruning of the
+state.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+			height, err := cmd.Flags().GetInt64(FlagHeight)
+			if err != nil {
+				return err
+			}
+			baseHeight, err := cmd.Flags().GetUint64(FlagBaseHeight)
+			if err != nil {
+				return err
+			}
+
+			app, closeDB, err := newApp(ctx, appCreator)
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			manager, err := snapshotManager(app)
+			if err != nil {
+				return err
+			}
+
+			if height == 0 {
+				height = app.Info(abci.RequestInfo{}).LastBlockHeight
+			}
+
+			var snapshot *snapshottypes.Snapshot
+			if baseHeight > 0 {
+				cmd.Printf("Exporting incremental snapshot for height %d on top of height %d\n", height, baseHeight)
+				snapshot, err = manager.CreateIncremental(baseHeight, uint64(height))
+			} else {
+				cmd.Printf("Exporting snapshot for height %d\n", height)
+				snapshot, err = manager.Create(uint64(height))
+			}
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot created at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(FlagHeight, 0, "Height to export, the latest committed height by default")
+	cmd.Flags().Uint64(FlagBaseHeight, 0, "Height of the snapshot to export an incremental snapshot on top of")
+	return cmd
+}
@@ -0,0 +1,141 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+// LoadArchiveCmd returns the command to load a snapshot archive file into the local snapshot store
+func LoadArchiveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "load <archive-file>",
+		Short: "Load a snapshot archive file into the local snapshot store",
+		Long: `Load a snapshot archive written by the dump command into the local snapshot store.
+The snapshot can then be restored with the restore command.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+			snapshotStore, err := server.GetSnapshotStore(ctx.Viper)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			snapshot, err := LoadArchive(snapshotStore, file)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot at height %d format %d loaded\n", snapshot.Height, snapshot.Format)
+			return nil
+		},
+	}
+}
+
+// LoadArchive reads a snapshot archive written by DumpArchive from r and saves the
+// snapshot to the store. The snapshot is not saved if its chunks do not match the
+// hashes of the archived metadata.
+func LoadArchive(store *snapshots.Store, r io.Reader) (*types.Snapshot, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot archive: %w", err)
+	}
+	defer gzipReader.Close()
+	tarReader := tar.NewReader(gzipReader)
+
+	header, err := tarReader.Next()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot archive: %w", err)
+	}
+	if header.Name != SnapshotFileName {
+		return nil, fmt.Errorf("invalid snapshot archive, expected entry %s, got %s", SnapshotFileName, header.Name)
+	}
+	bz, err := io.ReadAll(tarReader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot metadata: %w", err)
+	}
+	var snapshot types.Snapshot
+	if err := snapshot.Unmarshal(bz); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot metadata: %w", err)
+	}
+
+	// the chunks are read in the background until they are all saved, or done
+	// is closed because the store failed to save them
+	chunks := make(chan io.ReadCloser)
+	readErr := make(chan error, 1)
+	done := make(chan struct{})
+	go func() {
+		defer close(chunks)
+		for i := uint32(0); i < snapshot.Chunks; i++ {
+			header, err := tarReader.Next()
+			if err != nil {
+				readErr <- fmt.Errorf("failed to read chunk %d: %w", i, err)
+				return
+			}
+			if header.Name != strconv.FormatUint(uint64(i), 10) {
+				readErr <- fmt.Errorf("invalid snapshot archive, expected chunk %d, got %s", i, header.Name)
+				return
+			}
+			bz, err := io.ReadAll(tarReader)
+			if err != nil {
+				readErr <- fmt.Errorf("failed to read chunk %d: %w", i, err)
+				return
+			}
+			select {
+			case chunks <- io.NopCloser(bytes.NewReader(bz)):
+			case <-done:
+				readErr <- nil
+				return
+			}
+		}
+		readErr <- nil
+	}()
+
+	saved, err := store.Save(snapshot.Height, snapshot.Format, chunks)
+	if err != nil {
+		close(done)
+		if readErr := <-readErr; readErr != nil {
+			return nil, fmt.Errorf("%w, and failed to read the snapshot archive: %v", err, readErr)
+		}
+		return nil, err
+	}
+	if err := <-readErr; err != nil {
+		return nil, deleteInvalidSnapshot(store, saved, err)
+	}
+	if saved.Chunks != snapshot.Chunks || len(snapshot.Metadata.ChunkHashes) != int(saved.Chunks) ||
+		!bytes.Equal(saved.Hash, snapshot.Hash) {
+		return nil, deleteInvalidSnapshot(store, saved, fmt.Errorf("snapshot hash mismatch, expected %X, got %X", snapshot.Hash, saved.Hash))
+	}
+	for i, hash := range saved.Metadata.ChunkHashes {
+		if !bytes.Equal(hash, snapshot.Metadata.ChunkHashes[i]) {
+			return nil, deleteInvalidSnapshot(store, saved, fmt.Errorf("chunk %d hash mismatch", i))
+		}
+	}
+
+	return saved, nil
+}
+
+// deleteInvalidSnapshot deletes a snapshot which failed to load, and returns the loading error.
+func deleteInvalidSnapshot(store *snapshots.Store, snapshot *types.Snapshot, err error) error {
+	if deleteErr := store.Delete(snapshot.Height, snapshot.Format); deleteErr != nil {
+		return fmt.Errorf("%w, and failed to delete the loaded snapshot: %v", err, deleteErr)
+	}
+	return err
+}
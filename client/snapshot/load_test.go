@@ -0,0 +1,96 @@
+package snapshot_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/snapshot"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+)
+
+func setupStore(t *testing.T) *snapshots.Store {
+	store, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	return store
+}
+
+func makeChunks(chunks ...[]byte) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- io.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+	return ch
+}
+
+func TestDumpAndLoadArchive(t *testing.T) {
+	store := setupStore(t)
+	saved, err := store.Save(3, 2, makeChunks([]byte{1, 2, 3}, []byte{4, 5}, []byte{6}))
+	require.NoError(t, err)
+
+	var archive bytes.Buffer
+	require.NoError(t, snapshot.DumpArchive(store, 3, 2, &archive))
+	require.Error(t, snapshot.DumpArchive(store, 4, 2, &bytes.Buffer{}))
+
+	target := setupStore(t)
+	loaded, err := snapshot.LoadArchive(target, bytes.NewReader(archive.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, saved, loaded)
+
+	got, err := target.Get(3, 2)
+	require.NoError(t, err)
+	require.Equal(t, saved, got)
+	_, chunks, err := target.Load(3, 2)
+	require.NoError(t, err)
+	var body []byte
+	for chunk := range chunks {
+		bz, err := io.ReadAll(chunk)
+		require.NoError(t, err)
+		body = append(body, bz...)
+	}
+	require.Equal(t, []byte{1, 2, 3, 4, 5, 6}, body)
+
+	// the snapshot already exists
+	_, err = snapshot.LoadArchive(target, bytes.NewReader(archive.Bytes()))
+	require.Error(t, err)
+
+	// a truncated archive is rejected
+	_, err = snapshot.LoadArchive(setupStore(t), bytes.NewReader(archive.Bytes()[:archive.Len()/2]))
+	require.Error(t, err)
+}
+
+func TestLoadArchiveHashMismatch(t *testing.T) {
+	store := setupStore(t)
+	saved, err := store.Save(3, 2, makeChunks([]byte{1, 2, 3}, []byte{4, 5}))
+	require.NoError(t, err)
+	bz, err := saved.Marshal()
+	require.NoError(t, err)
+
+	// the archived metadata does not match the archived chunks
+	var archive bytes.Buffer
+	gzipWriter := gzip.NewWriter(&archive)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, entry := range []struct {
+		name string
+		body []byte
+	}{{snapshot.SnapshotFileName, bz}, {"0", []byte{1, 2, 3}}, {"1", []byte{4, 6}}} {
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: entry.name, Mode: 0o644, Size: int64(len(entry.body))}))
+		_, err = tarWriter.Write(entry.body)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+
+	target := setupStore(t)
+	_, err = snapshot.LoadArchive(target, &archive)
+	require.Error(t, err)
+	got, err := target.Get(3, 2)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
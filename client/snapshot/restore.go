@@ -0,0 +1,50 @@
+package snapshot
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// RestoreSnapshotCmd returns the command to restore the application state from a local snapshot
+func RestoreSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore <height> <format>",
+		Short: "Restore the application state from a local snapshot",
+		Long: `Restore the application state from a snapshot of the local snapshot store, into an
+empty application database. Only the application state is restored: the Tendermint state
+and block stores are left untouched, and must be bootstrapped to the same height before
+the node is started.
+
+An incremental snapshot is restored on top of the application state at its base height, so a
+chain of incremental snapshots is restored by restoring its base snapshot, then each of the
+incremental snapshots in order.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseHeightAndFormat(args)
+			if err != nil {
+				return err
+			}
+
+			ctx := server.GetServerContextFromCmd(cmd)
+			app, closeDB, err := newApp(ctx, appCreator)
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			manager, err := snapshotManager(app)
+			if err != nil {
+				return err
+			}
+			if err := manager.RestoreLocalSnapshot(height, format); err != nil {
+				return err
+			}
+
+			cmd.Printf("Restored application state at height %d\n", height)
+			return nil
+		},
+	}
+}
@@ -27,6 +27,7 @@ type Factory struct {
 	sequence           uint64
 	gas                uint64
 	timeoutHeight      uint64
+	unordered          bool
 	gasAdjustment      float64
 	chainID            string
 	offline            bool
@@ -37,6 +38,7 @@ type Factory struct {
 	feeGranter         sdk.AccAddress
 	feePayer           sdk.AccAddress
 	gasPrices          sdk.DecCoins
+	feeDenom           string
 	signMode           signing.SignMode
 	simulateAndExecute bool
 }
@@ -53,6 +55,8 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 		signMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
 	case flags.SignModeDirectAux:
 		signMode = signing.SignMode_SIGN_MODE_DIRECT_AUX
+	case flags.SignModeTextual:
+		signMode = signing.SignMode_SIGN_MODE_TEXTUAL
 	case flags.SignModeEIP191:
 		signMode = signing.SignMode_SIGN_MODE_EIP_191
 	}
@@ -62,6 +66,8 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 	gasAdj, _ := flagSet.GetFloat64(flags.FlagGasAdjustment)
 	memo, _ := flagSet.GetString(flags.FlagNote)
 	timeoutHeight, _ := flagSet.GetUint64(flags.FlagTimeoutHeight)
+	unordered, _ := flagSet.GetBool(flags.FlagUnordered)
+	feeDenom, _ := flagSet.GetString(flags.FlagFeeDenom)
 
 	gasStr, _ := flagSet.GetString(flags.FlagGas)
 	gasSetting, _ := flags.ParseGasSetting(gasStr)
@@ -78,8 +84,10 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 		accountNumber:      accNum,
 		sequence:           accSeq,
 		timeoutHeight:      timeoutHeight,
+		unordered:          unordered,
 		gasAdjustment:      gasAdj,
 		memo:               memo,
+		feeDenom:           feeDenom,
 		signMode:           signMode,
 		feeGranter:         clientCtx.FeeGranter,
 		feePayer:           clientCtx.FeePayer,
@@ -108,8 +116,10 @@ func (f Factory) ChainID() string                           { return f.chainID }
 func (f Factory) Memo() string                              { return f.memo }
 func (f Factory) Fees() sdk.Coins                           { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
+func (f Factory) FeeDenom() string                          { return f.feeDenom }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
+func (f Factory) Unordered() bool                           { return f.unordered }
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
 // using the gas from the simulation results
@@ -175,6 +185,13 @@ func (f Factory) WithGasPrices(gasPrices string) Factory {
 	return f
 }
 
+// WithFeeDenom returns a copy of the Factory with an updated denom in which the
+// gas prices are estimated.
+func (f Factory) WithFeeDenom(feeDenom string) Factory {
+	f.feeDenom = feeDenom
+	return f
+}
+
 // WithKeybase returns a copy of the Factory with updated Keybase.
 func (f Factory) WithKeybase(keybase keyring.Keyring) Factory {
 	f.keybase = keybase
@@ -229,6 +246,12 @@ func (f Factory) WithTimeoutHeight(height uint64) Factory {
 	return f
 }
 
+// WithUnordered returns a copy of the Factory with an updated unordered option.
+func (f Factory) WithUnordered(unordered bool) Factory {
+	f.unordered = unordered
+	return f
+}
+
 // WithFeeGranter returns a copy of the Factory with an updated fee granter.
 func (f Factory) WithFeeGranter(fg sdk.AccAddress) Factory {
 	f.feeGranter = fg
@@ -252,6 +275,10 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 		return nil, fmt.Errorf("chain ID required but not specified")
 	}
 
+	if f.unordered && f.timeoutHeight == 0 {
+		return nil, errors.New("timeout height required for unordered transactions")
+	}
+
 	fees := f.fees
 
 	if !f.gasPrices.IsZero() {
@@ -273,6 +300,12 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 
 	tx := f.txConfig.NewTxBuilder()
 
+	// the builders of the tx configs which don't support unordered txs, such
+	// as the amino one, panic on SetUnordered
+	if _, ok := tx.GetTx().(sdk.TxWithUnordered); f.unordered && !ok {
+		return nil, errors.New("unordered transactions are not supported by the tx config")
+	}
+
 	if err := tx.SetMsgs(msgs...); err != nil {
 		return nil, err
 	}
@@ -283,6 +316,7 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 	tx.SetFeeGranter(f.feeGranter)
 	tx.SetFeePayer(f.feePayer)
 	tx.SetTimeoutHeight(f.TimeoutHeight())
+	tx.SetUnordered(f.Unordered())
 
 	return tx, nil
 }
@@ -387,8 +421,11 @@ func (f Factory) getSimPK() (cryptotypes.PubKey, error) {
 
 // Prepare ensures the account defined by ctx.GetFromAddress() exists and
 // if the account number and/or the account sequence number are zero (not set),
-// they will be queried for and set on the provided Factory. A new Factory with
-// the updated fields will be returned.
+// they will be queried for and set on the provided Factory. The sequence of
+// unordered transactions is left to zero. If neither the fees nor the gas prices
+// are set, the gas prices are estimated in the fee denom of the Factory with the
+// GasPricesEstimator of the context, if any. A new Factory with the updated
+// fields will be returned.
 func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 	fc := f
 
@@ -409,10 +446,19 @@ func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 			fc = fc.WithAccountNumber(num)
 		}
 
-		if initSeq == 0 {
+		if initSeq == 0 && !fc.unordered {
 			fc = fc.WithSequence(seq)
 		}
 	}
 
+	if clientCtx.GasPricesEstimator != nil && fc.fees.IsZero() && fc.gasPrices.IsZero() {
+		gasPrices, err := clientCtx.GasPricesEstimator.EstimateGasPrices(clientCtx, fc.feeDenom)
+		if err != nil {
+			return fc, err
+		}
+
+		fc.gasPrices = gasPrices
+	}
+
 	return fc, nil
 }
@@ -19,6 +19,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -158,6 +159,27 @@ func TestBuildUnsignedTx(t *testing.T) {
 	require.Empty(t, sigs)
 }
 
+func TestBuildUnsignedTxUnordered(t *testing.T) {
+	txConfig, _ := newTestTxConfig(t)
+	txf := tx.Factory{}.
+		WithTxConfig(txConfig).
+		WithChainID("test-chain").
+		WithUnordered(true)
+	msg := banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), nil)
+
+	_, err := txf.BuildUnsignedTx(msg)
+	require.Error(t, err)
+
+	builder, err := txf.WithTimeoutHeight(10).BuildUnsignedTx(msg)
+	require.NoError(t, err)
+	require.True(t, builder.GetTx().(sdk.TxWithUnordered).GetUnordered())
+
+	// the amino tx config doesn't support unordered txs
+	amino := codec.NewLegacyAmino()
+	_, err = txf.WithTimeoutHeight(10).WithTxConfig(legacytx.StdTxConfig{Cdc: amino}).BuildUnsignedTx(msg)
+	require.Error(t, err)
+}
+
 func TestSign(t *testing.T) {
 	txConfig, cdc := newTestTxConfig(t)
 	requireT := require.New(t)
@@ -324,3 +346,42 @@ func testSigners(require *require.Assertions, tr signing.Tx, pks ...cryptotypes.
 	}
 	return sigs
 }
+
+// mockGasPricesEstimator is a client.GasPricesEstimator returning fixed gas prices.
+type mockGasPricesEstimator struct {
+	gasPrices sdk.DecCoins
+}
+
+func (m mockGasPricesEstimator) EstimateGasPrices(client.Context, string) (sdk.DecCoins, error) {
+	return m.gasPrices, nil
+}
+
+func TestPrepareGasPrices(t *testing.T) {
+	addr := sdk.AccAddress("from")
+	estimated := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(5, 3)))
+	clientCtx := client.Context{}.
+		WithFromAddress(addr).
+		WithGasPricesEstimator(mockGasPricesEstimator{gasPrices: estimated})
+	txf := tx.Factory{}.
+		WithAccountRetriever(client.TestAccountRetriever{Accounts: map[string]client.TestAccount{
+			addr.String(): {Address: addr, Num: 1, Seq: 1},
+		}}).
+		WithAccountNumber(1).
+		WithSequence(1)
+
+	// the gas prices are estimated when neither the fees nor the gas prices are set
+	prepared, err := txf.Prepare(clientCtx)
+	require.NoError(t, err)
+	require.Equal(t, estimated, prepared.GasPrices())
+
+	// the gas prices set by the user are kept
+	gasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2)))
+	prepared, err = txf.WithGasPrices(gasPrices.String()).Prepare(clientCtx)
+	require.NoError(t, err)
+	require.Equal(t, gasPrices, prepared.GasPrices())
+
+	// no gas prices are estimated when the fees are set
+	prepared, err = txf.WithFees("10stake").Prepare(clientCtx)
+	require.NoError(t, err)
+	require.True(t, prepared.GasPrices().IsZero())
+}
@@ -128,3 +128,22 @@ func NewPruningOptionsFromString(strategy string) PruningOptions {
 		return NewPruningOptions(PruningDefault)
 	}
 }
+
+// AsyncPruningOptions defines how the pruned heights are removed from disk in the background,
+// instead of when committing state.
+type AsyncPruningOptions struct {
+	// Enabled enables the background removal of the pruned heights.
+	Enabled bool
+
+	// BatchSize defines how many heights are removed from disk at once. 0 means all the
+	// pending heights of a set of stores.
+	BatchSize uint64
+
+	// RateLimit defines how many heights are removed from disk per second at most. 0 means
+	// no limit.
+	RateLimit uint64
+
+	// MaxBacklog defines how many heights may wait to be removed from disk. While the backlog
+	// is full, the heights to prune are kept until a later pruning interval. 0 means no limit.
+	MaxBacklog uint64
+}
@@ -9,6 +9,7 @@ import (
 
 	clientflags "github.com/cosmos/cosmos-sdk/client/flags"
 	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -33,6 +34,12 @@ const (
 	// DefaultGRPCMaxSendMsgSize defines the default gRPC max message size in
 	// bytes the server can send.
 	DefaultGRPCMaxSendMsgSize = math.MaxInt32
+
+	// MultiStoreV1 is the IAVL based multistore of store/rootmulti.
+	MultiStoreV1 = "v1"
+
+	// MultiStoreV2 is the SMT based multistore of store/v2alpha1/multi.
+	MultiStoreV2 = "v2"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -46,6 +53,22 @@ type BaseConfig struct {
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
 
+	// PruningAsync enables the removal of the pruned heights from disk in the background,
+	// so that committing state does not wait for the pruning.
+	PruningAsync bool `mapstructure:"pruning-async"`
+	// PruningAsyncBatchSize defines how many heights are removed from disk at once (0 for all).
+	PruningAsyncBatchSize uint64 `mapstructure:"pruning-async-batch-size"`
+	// PruningAsyncRateLimit defines how many heights are removed from disk per second at most
+	// (0 for no limit).
+	PruningAsyncRateLimit uint64 `mapstructure:"pruning-async-rate-limit"`
+	// PruningAsyncMaxBacklog defines how many heights may wait to be removed from disk (0 for
+	// no limit). The heights to prune are kept until a later pruning interval while it is full.
+	PruningAsyncMaxBacklog uint64 `mapstructure:"pruning-async-max-backlog"`
+
+	// StorePruning overrides the pruning options above for the named stores, which
+	// are then pruned independently of the other stores.
+	StorePruning map[string]StorePruningConfig `mapstructure:"store-pruning"`
+
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
 	//
@@ -87,6 +110,17 @@ type BaseConfig struct {
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the Tendermint config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
+
+	// MultiStore defines the multistore storing the application state: v1 for the IAVL
+	// multistore, or v2 for the SMT based multistore of store/v2alpha1.
+	MultiStore string `mapstructure:"multistore"`
+}
+
+// StorePruningConfig defines the pruning options of a store.
+type StorePruningConfig struct {
+	Pruning           string `mapstructure:"pruning"`
+	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
+	PruningInterval   string `mapstructure:"pruning-interval"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -190,6 +224,18 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotCodec sets the compression codec of the state sync snapshot chunks,
+	// one of none, zlib or zstd.
+	SnapshotCodec string `mapstructure:"snapshot-codec"`
+
+	// SnapshotWorkers sets the number of workers compressing the state sync snapshot
+	// chunks. 0 uses the number of CPUs.
+	SnapshotWorkers int `mapstructure:"snapshot-workers"`
+
+	// SnapshotIncrementalInterval sets the interval at which incremental snapshots,
+	// holding the changes since the latest snapshot, are taken. 0 disables them.
+	SnapshotIncrementalInterval uint64 `mapstructure:"snapshot-incremental-interval"`
 }
 
 // Config defines the server's top level configuration
@@ -245,6 +291,10 @@ func DefaultConfig() *Config {
 			IndexEvents:       make([]string, 0),
 			IAVLCacheSize:     781250, // 50 MB
 			AppDBBackend:      "",
+			MultiStore:        MultiStoreV1,
+
+			PruningAsyncBatchSize:  10,
+			PruningAsyncMaxBacklog: 100000,
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -280,8 +330,11 @@ func DefaultConfig() *Config {
 			Address: DefaultGRPCWebAddress,
 		},
 		StateSync: StateSyncConfig{
-			SnapshotInterval:   0,
-			SnapshotKeepRecent: 2,
+			SnapshotInterval:            0,
+			SnapshotKeepRecent:          2,
+			SnapshotCodec:               snapshottypes.CodecZlib,
+			SnapshotWorkers:             0,
+			SnapshotIncrementalInterval: 0,
 		},
 	}
 }
@@ -297,6 +350,16 @@ func GetConfig(v *viper.Viper) Config {
 		}
 	}
 
+	storePruning := make(map[string]StorePruningConfig)
+	for name := range v.GetStringMap("store-pruning") {
+		prefix := "store-pruning." + name + "."
+		storePruning[name] = StorePruningConfig{
+			Pruning:           v.GetString(prefix + "pruning"),
+			PruningKeepRecent: v.GetString(prefix + "pruning-keep-recent"),
+			PruningInterval:   v.GetString(prefix + "pruning-interval"),
+		}
+	}
+
 	return Config{
 		BaseConfig: BaseConfig{
 			MinGasPrices:      v.GetString("minimum-gas-prices"),
@@ -304,12 +367,19 @@ func GetConfig(v *viper.Viper) Config {
 			Pruning:           v.GetString("pruning"),
 			PruningKeepRecent: v.GetString("pruning-keep-recent"),
 			PruningInterval:   v.GetString("pruning-interval"),
+			StorePruning:      storePruning,
 			HaltHeight:        v.GetUint64("halt-height"),
 			HaltTime:          v.GetUint64("halt-time"),
 			IndexEvents:       v.GetStringSlice("index-events"),
 			MinRetainBlocks:   v.GetUint64("min-retain-blocks"),
 			IAVLCacheSize:     v.GetUint64("iavl-cache-size"),
 			AppDBBackend:      v.GetString("app-db-backend"),
+			MultiStore:        v.GetString("multistore"),
+
+			PruningAsync:           v.GetBool("pruning-async"),
+			PruningAsyncBatchSize:  v.GetUint64("pruning-async-batch-size"),
+			PruningAsyncRateLimit:  v.GetUint64("pruning-async-rate-limit"),
+			PruningAsyncMaxBacklog: v.GetUint64("pruning-async-max-backlog"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -353,8 +423,11 @@ func GetConfig(v *viper.Viper) Config {
 			EnableUnsafeCORS: v.GetBool("grpc-web.enable-unsafe-cors"),
 		},
 		StateSync: StateSyncConfig{
-			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
-			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
+			SnapshotInterval:            v.GetUint64("state-sync.snapshot-interval"),
+			SnapshotKeepRecent:          v.GetUint32("state-sync.snapshot-keep-recent"),
+			SnapshotCodec:               v.GetString("state-sync.snapshot-codec"),
+			SnapshotWorkers:             v.GetInt("state-sync.snapshot-workers"),
+			SnapshotIncrementalInterval: v.GetUint64("state-sync.snapshot-incremental-interval"),
 		},
 	}
 }
@@ -369,6 +442,30 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	for name, opts := range c.StorePruning {
+		if opts.Pruning == pruningtypes.PruningOptionEverything && c.StateSync.SnapshotInterval > 0 {
+			return sdkerrors.ErrAppConfig.Wrapf(
+				"cannot enable state sync snapshots with '%s' pruning setting of store %s", pruningtypes.PruningOptionEverything, name,
+			)
+		}
+	}
+	switch c.MultiStore {
+	case "", MultiStoreV1:
+	case MultiStoreV2:
+		if len(c.StorePruning) > 0 || c.PruningAsync || c.StateSync.SnapshotIncrementalInterval > 0 {
+			return sdkerrors.ErrAppConfig.Wrap(
+				"store pruning, background pruning and incremental snapshots are not supported by the v2 multistore",
+			)
+		}
+	default:
+		return sdkerrors.ErrAppConfig.Wrapf("invalid multistore %q; expected %s or %s", c.MultiStore, MultiStoreV1, MultiStoreV2)
+	}
+	if err := snapshottypes.ValidateCodec(c.StateSync.SnapshotCodec); err != nil {
+		return sdkerrors.ErrAppConfig.Wrap(err.Error())
+	}
+	if c.StateSync.SnapshotWorkers < 0 {
+		return sdkerrors.ErrAppConfig.Wrap("state sync snapshot workers cannot be negative")
+	}
 
 	return nil
 }
@@ -58,6 +58,31 @@ func TestIndexEventsWriteRead(t *testing.T) {
 	require.Equal(t, expected, actual, "config value")
 }
 
+func TestStorePruningWriteRead(t *testing.T) {
+	expected := map[string]StorePruningConfig{
+		"bank": {Pruning: "custom", PruningKeepRecent: "1000000", PruningInterval: "10"},
+		"ibc":  {Pruning: "everything", PruningKeepRecent: "0", PruningInterval: "0"},
+	}
+	// Create config with two stores with their own pruning options, and write it to a file.
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.StorePruning = expected
+	WriteConfigFile(confFile, conf)
+
+	// Read that file into viper.
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	err := vpr.ReadInConfig()
+	require.NoError(t, err, "reading config file into viper")
+	// Check that the base pruning options are not overridden by the store ones.
+	require.Equal(t, conf.Pruning, vpr.GetString("pruning"))
+	// Check that it is parsed into the config correctly.
+	cfg, perr := ParseConfig(vpr)
+	require.NoError(t, perr, "parsing config")
+	require.Equal(t, expected, cfg.StorePruning, "config value")
+	require.Equal(t, expected, GetConfig(vpr).StorePruning, "config value")
+}
+
 func TestGlobalLabelsEventsMarshalling(t *testing.T) {
 	expectedIn := `global-labels = [
   ["labelname1", "labelvalue1"],
@@ -128,3 +153,33 @@ func TestSetConfigTemplate(t *testing.T) {
 	actual := setBuffer.String()
 	require.Equal(t, expected, actual, "resulting config strings")
 }
+
+func TestValidateBasicSnapshotCodec(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.StateSync.SnapshotCodec = "zstd"
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.StateSync.SnapshotCodec = "lzma"
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg.StateSync.SnapshotCodec = ""
+	cfg.StateSync.SnapshotWorkers = -1
+	require.Error(t, cfg.ValidateBasic())
+}
+
+func TestValidateBasicMultiStore(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	cfg.MultiStore = MultiStoreV2
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.PruningAsync = true
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg.PruningAsync = false
+	cfg.MultiStore = "v3"
+	require.Error(t, cfg.ValidateBasic())
+}
@@ -32,6 +32,20 @@ pruning = "{{ .BaseConfig.Pruning }}"
 pruning-keep-recent = "{{ .BaseConfig.PruningKeepRecent }}"
 pruning-interval = "{{ .BaseConfig.PruningInterval }}"
 
+# pruning-async removes the pruned heights from disk in the background, so that committing
+# a block does not wait for the pruning.
+pruning-async = {{ .BaseConfig.PruningAsync }}
+
+# These are applied if and only if the pruning is asynchronous.
+# pruning-async-batch-size is the number of heights removed from disk at once (0 for all).
+pruning-async-batch-size = {{ .BaseConfig.PruningAsyncBatchSize }}
+# pruning-async-rate-limit is the maximum number of heights removed from disk per second (0 for no limit).
+pruning-async-rate-limit = {{ .BaseConfig.PruningAsyncRateLimit }}
+# pruning-async-max-backlog is the maximum number of heights waiting to be removed from disk
+# (0 for no limit). While the backlog is full, the heights to prune are kept until a later
+# pruning interval.
+pruning-async-max-backlog = {{ .BaseConfig.PruningAsyncMaxBacklog }}
+
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
@@ -81,6 +95,29 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 # Second fallback (if the types.DBBackend also isn't set), is the db-backend value set in Tendermint's config.toml.
 app-db-backend = "{{ .BaseConfig.AppDBBackend }}"
 
+# MultiStore defines the multistore storing the application state: "v1" for the IAVL multistore,
+# or "v2" for the SMT based multistore, stored in the data/application.v2 badger DB.
+# Switching to v2 requires to migrate the state first, with the migrate-store command.
+# Store pruning, background pruning and incremental snapshots are not supported by v2.
+multistore = "{{ .BaseConfig.MultiStore }}"
+
+# StorePruning overrides the pruning options above for the named stores, which are then
+# pruned independently of the other stores, e.g. to keep more historical states of some
+# stores for queries. Each store accepts the pruning, pruning-keep-recent and
+# pruning-interval options, e.g.
+#
+# [store-pruning.bank]
+# pruning = "custom"
+# pruning-keep-recent = "1000000"
+# pruning-interval = "10"
+{{- range $name, $opts := .BaseConfig.StorePruning }}
+
+[store-pruning.{{ $name }}]
+pruning = "{{ $opts.Pruning }}"
+pruning-keep-recent = "{{ $opts.PruningKeepRecent }}"
+pruning-interval = "{{ $opts.PruningInterval }}"
+{{- end }}
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -231,7 +268,23 @@ enable-unsafe-cors = {{ .GRPCWeb.EnableUnsafeCORS }}
 snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
+# The incremental snapshots are not counted, and are kept with the snapshot they are taken on.
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+# snapshot-codec specifies the compression codec of the snapshot chunks: none, zlib or zstd.
+# The codec isn't part of the snapshot format, and snapshots taken with different codecs differ,
+# so that a syncing node can't fetch the chunks of a snapshot from the providers using another
+# codec: all the state sync providers of a network must use the same codec.
+snapshot-codec = "{{ .StateSync.SnapshotCodec }}"
+
+# snapshot-workers specifies the number of workers compressing the snapshot chunks (0 to use
+# the number of CPUs).
+snapshot-workers = {{ .StateSync.SnapshotWorkers }}
+
+# snapshot-incremental-interval specifies the block interval at which incremental snapshots are
+# taken, holding the changes of the state since the latest snapshot (0 to disable). They are
+# restored locally on top of that snapshot, and are not offered to state sync.
+snapshot-incremental-interval = {{ .StateSync.SnapshotIncrementalInterval }}
 `
 
 var configTemplate *template.Template
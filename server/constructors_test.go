@@ -9,9 +9,9 @@ import (
 	dbm "github.com/tendermint/tm-db"
 )
 
-func Test_openDB(t *testing.T) {
+func Test_OpenDB(t *testing.T) {
 	t.Parallel()
-	_, err := openDB(t.TempDir(), dbm.GoLevelDBBackend)
+	_, err := OpenDB(t.TempDir(), dbm.GoLevelDBBackend)
 	require.NoError(t, err)
 }
 
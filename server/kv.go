@@ -0,0 +1,139 @@
+package server
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// KVEntry is a store entry. The key and value are hex encoded, and the entry is
+// decoded with the KV schema of the store when available.
+type KVEntry struct {
+	Store   string            `json:"store"`
+	Height  int64             `json:"height"`
+	Key     string            `json:"key"`
+	Value   string            `json:"value"`
+	Decoded *schema.DecodedKV `json:"decoded,omitempty"`
+}
+
+// NewKVCmd creates a command to inspect the key/value entries of the application
+// stores. The schemas function returns the KV schemas used to decode the entries,
+// usually the ones registered by the application's modules, and may be nil.
+func NewKVCmd(defaultNodeHome string, schemas func() *schema.Registry) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "kv",
+		Short: "Inspect the key/value entries of the application stores",
+	}
+	cmd.AddCommand(newKVGetCmd(defaultNodeHome, schemas))
+	return cmd
+}
+
+func newKVGetCmd(defaultNodeHome string, schemas func() *schema.Registry) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <store> <hex-key>",
+		Short: "Print a store entry, decoded with the KV schema of the store",
+		Long: `Print, as JSON, the entry of the given key in a store of the application, at the given
+height or the latest height. The entry is decoded with the KV schema registered by the
+module owning the store, when available. The node must not be running.
+`,
+		Example: fmt.Sprintf("$ %s debug kv get mint 00 --height 100", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			key, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid hex key: %w", err)
+			}
+
+			db, err := OpenDB(config.RootDir, GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			var registry *schema.Registry
+			if schemas != nil {
+				registry = schemas()
+			}
+
+			entry, err := GetKVEntry(db, height, args[0], key, registry)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.Marshal(entry)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagHeight, 0, "The height to read the entry at (0 means latest height)")
+
+	return cmd
+}
+
+// GetKVEntry returns the entry of the key in the named store of the application
+// database, at the given height or the latest height if not positive. The entry
+// is decoded with the KV schemas, which may be nil.
+func GetKVEntry(db dbm.DB, height int64, storeName string, key []byte, schemas *schema.Registry) (KVEntry, error) {
+	if height <= 0 {
+		height = rootmulti.NewStore(db, log.NewNopLogger()).LastCommitID().Version
+	}
+
+	info, err := rootmulti.NewStore(db, log.NewNopLogger()).GetCommitInfo(height)
+	if err != nil {
+		return KVEntry{}, fmt.Errorf("failed to get the commit info at height %d: %w", height, err)
+	}
+	if len(storeNames(info, storeName)) == 0 {
+		return KVEntry{}, fmt.Errorf("store %s not found at height %d", storeName, height)
+	}
+
+	stores, err := loadStoresAtVersion(db, []string{storeName}, height)
+	if err != nil {
+		return KVEntry{}, err
+	}
+	value := stores[storeName].Get(key)
+	if value == nil {
+		return KVEntry{}, fmt.Errorf("key %X not found in store %s at height %d", key, storeName, height)
+	}
+
+	entry := KVEntry{
+		Store:  storeName,
+		Height: height,
+		Key:    hex.EncodeToString(key),
+		Value:  hex.EncodeToString(value),
+	}
+	if schemas != nil {
+		decoded, err := schemas.Decode(storeName, key, value)
+		switch {
+		case errors.Is(err, schema.ErrUnknownKey):
+		case err != nil:
+			return KVEntry{}, err
+		default:
+			entry.Decoded = &decoded
+		}
+	}
+
+	return entry, nil
+}
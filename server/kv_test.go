@@ -0,0 +1,66 @@
+package server_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+)
+
+func TestGetKVEntry(t *testing.T) {
+	db := dbm.NewMemDB()
+	mintKey := storetypes.NewKVStoreKey("mint")
+	cms := rootmulti.NewStore(db, log.NewNopLogger())
+	cms.MountStoreWithDB(mintKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	cms.GetKVStore(mintKey).Set([]byte{0, 1}, []byte{1})
+	cms.GetKVStore(mintKey).Set([]byte{1}, []byte{2})
+	cms.Commit()
+	cms.GetKVStore(mintKey).Set([]byte{0, 1}, []byte{3})
+	cms.Commit()
+
+	schemas := schema.NewRegistry(nil)
+	schemas.Register("mint", schema.Entry{
+		Name:   "counter",
+		Prefix: []byte{0},
+		Key:    []schema.KeyField{schema.Field("id", schema.BytesKey)},
+		Value:  schema.BytesValue,
+	})
+
+	entry, err := server.GetKVEntry(db, 0, "mint", []byte{0, 1}, schemas)
+	require.NoError(t, err)
+	require.Equal(t, server.KVEntry{
+		Store:  "mint",
+		Height: 2,
+		Key:    "0001",
+		Value:  "03",
+		Decoded: &schema.DecodedKV{
+			Entry: "counter",
+			Key:   schema.DecodedKey{{Name: "id", Value: "01"}},
+			Value: []byte(`"03"`),
+		},
+	}, entry)
+
+	entry, err = server.GetKVEntry(db, 1, "mint", []byte{0, 1}, nil)
+	require.NoError(t, err)
+	require.Equal(t, server.KVEntry{Store: "mint", Height: 1, Key: "0001", Value: "01"}, entry)
+
+	// keys unknown to the schema are not decoded
+	entry, err = server.GetKVEntry(db, 1, "mint", []byte{1}, schemas)
+	require.NoError(t, err)
+	require.Nil(t, entry.Decoded)
+
+	_, err = server.GetKVEntry(db, 2, "mint", []byte{2}, schemas)
+	require.Error(t, err)
+	_, err = server.GetKVEntry(db, 2, "bank", []byte{1}, schemas)
+	require.Error(t, err)
+	_, err = server.GetKVEntry(db, 3, "mint", []byte{1}, schemas)
+	require.Error(t, err)
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	dbm2 "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/db/badgerdb"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/v2alpha1/multi"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// UseMultiStoreV2 returns whether the application state is stored with the v2 multistore.
+func UseMultiStoreV2(appOpts types.AppOptions) bool {
+	return cast.ToString(appOpts.Get(FlagMultiStore)) == config.MultiStoreV2
+}
+
+// OpenDBV2 opens the DB storing the application state with the v2 multistore, in the data
+// directory of the given home.
+func OpenDBV2(rootDir string) (dbm2.Connection, error) {
+	return badgerdb.NewDB(filepath.Join(rootDir, "data", "application.v2"))
+}
+
+// NewMigrateStoreCmd creates a command migrating the application state from the IAVL
+// multistore to the v2 multistore.
+func NewMigrateStoreCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-store",
+		Short: "Migrate the application state to the v2 multistore",
+		Long: `Migrate the application state at the latest height from the IAVL multistore to the
+v2 multistore, stored in the data/application.v2 DB. The node must not be running, and must
+use the v2 multistore once migrated, i.e. set multistore = "v2" in app.toml.
+
+The application hash of the latest height is unchanged, but the following heights are
+committed with the root hash of the v2 multistore. All the nodes of the network must thus
+migrate at the same height, e.g. the halt height of an upgrade.
+`,
+		Example: fmt.Sprintf("$ %s migrate-store --home ~/.simapp", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			home := serverCtx.Config.RootDir
+
+			db, err := OpenDB(home, GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			db2, err := OpenDBV2(home)
+			if err != nil {
+				return err
+			}
+			defer db2.Close()
+
+			cid, err := MigrateStore(db, db2)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Migrated the application state at height %d and hash %X\n", cid.Version, cid.Hash)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+// MigrateStore migrates the IAVL stores of the application DB at the latest height to the
+// v2 multistore stored in db2, which must be empty, and returns the commit ID of the
+// migrated height. The memory and transient stores are declared when mounted by the app.
+func MigrateStore(db dbm.DB, db2 dbm2.Connection) (storetypes.CommitID, error) {
+	versions, err := db2.Versions()
+	if err != nil {
+		return storetypes.CommitID{}, err
+	}
+	if versions.Count() != 0 {
+		return storetypes.CommitID{}, errors.New("the v2 multistore DB is not empty")
+	}
+
+	rs := rootmulti.NewStore(db, log.NewNopLogger())
+	height := rs.LastCommitID().Version
+	if height == 0 {
+		return storetypes.CommitID{}, errors.New("no application state to migrate")
+	}
+	info, err := rs.GetCommitInfo(height)
+	if err != nil {
+		return storetypes.CommitID{}, fmt.Errorf("failed to get the commit info at height %d: %w", height, err)
+	}
+	for _, storeInfo := range info.StoreInfos {
+		// the memory stores are committed with an empty commit ID
+		if storeInfo.CommitId.Version == 0 {
+			continue
+		}
+		rs.MountStoreWithDB(storetypes.NewKVStoreKey(storeInfo.Name), storetypes.StoreTypeIAVL, nil)
+	}
+	if err := rs.LoadLatestVersion(); err != nil {
+		return storetypes.CommitID{}, err
+	}
+
+	store, err := multi.MigrateFromV1(rs, db2, multi.DefaultStoreConfig())
+	if err != nil {
+		return storetypes.CommitID{}, err
+	}
+	cid := store.LastCommitID()
+	return cid, store.Close()
+}
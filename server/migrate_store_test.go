@@ -0,0 +1,50 @@
+package server_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/v2alpha1/multi"
+)
+
+func TestMigrateStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	db2 := memdb.NewDB()
+	_, err := server.MigrateStore(db, db2)
+	require.Error(t, err)
+
+	mintKey := storetypes.NewKVStoreKey("mint")
+	memKey := storetypes.NewMemoryStoreKey("mem")
+	cms := rootmulti.NewStore(db, log.NewNopLogger())
+	cms.MountStoreWithDB(mintKey, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(memKey, storetypes.StoreTypeMemory, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	cms.GetKVStore(mintKey).Set([]byte{1}, []byte{2})
+	cms.Commit()
+	cms.GetKVStore(mintKey).Set([]byte{1}, []byte{3})
+	lastCommitID := cms.Commit()
+
+	cid, err := server.MigrateStore(db, db2)
+	require.NoError(t, err)
+	require.Equal(t, lastCommitID, cid)
+
+	// the DB can only be migrated once
+	_, err = server.MigrateStore(db, db2)
+	require.Error(t, err)
+
+	// the memory stores are declared by the app
+	cs := multi.NewCompatStore(db2, multi.DefaultStoreConfig())
+	cs.MountStoreWithDB(mintKey, storetypes.StoreTypeIAVL, nil)
+	cs.MountStoreWithDB(memKey, storetypes.StoreTypeMemory, nil)
+	require.NoError(t, cs.LoadLatestVersion())
+	require.Equal(t, lastCommitID, cs.LastCommitID())
+	require.Equal(t, []byte{3}, cs.GetKVStore(mintKey).Get([]byte{1}))
+	require.NoError(t, cs.Close())
+}
@@ -14,7 +14,49 @@ import (
 // PruningOptions. If a pruning strategy is provided, that will be parsed and
 // returned, otherwise, it is assumed custom pruning options are provided.
 func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningOptions, error) {
-	strategy := strings.ToLower(cast.ToString(appOpts.Get(FlagPruning)))
+	return parsePruningOptions(appOpts.Get(FlagPruning), appOpts.Get(FlagPruningKeepRecent), appOpts.Get(FlagPruningInterval))
+}
+
+// GetStorePruningOptionsFromFlags parses the pruning options of the stores set in the
+// store-pruning table of the app config, which override the pruning options of the
+// application for these stores, and returns them by store name.
+func GetStorePruningOptionsFromFlags(appOpts types.AppOptions) (map[string]pruningtypes.PruningOptions, error) {
+	rawOpts := appOpts.Get(FlagStorePruning)
+	if rawOpts == nil {
+		return nil, nil
+	}
+	storesOpts, err := cast.ToStringMapE(rawOpts)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s options: %w", FlagStorePruning, err)
+	}
+
+	opts := make(map[string]pruningtypes.PruningOptions, len(storesOpts))
+	for name, storeOpts := range storesOpts {
+		storeOpts, err := cast.ToStringMapE(storeOpts)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s options of store %s: %w", FlagStorePruning, name, err)
+		}
+		opts[name], err = parsePruningOptions(storeOpts[FlagPruning], storeOpts[FlagPruningKeepRecent], storeOpts[FlagPruningInterval])
+		if err != nil {
+			return nil, fmt.Errorf("store %s: %w", name, err)
+		}
+	}
+
+	return opts, nil
+}
+
+// GetAsyncPruningOptionsFromFlags returns the options of the background pruning.
+func GetAsyncPruningOptionsFromFlags(appOpts types.AppOptions) pruningtypes.AsyncPruningOptions {
+	return pruningtypes.AsyncPruningOptions{
+		Enabled:    cast.ToBool(appOpts.Get(FlagPruningAsync)),
+		BatchSize:  cast.ToUint64(appOpts.Get(FlagPruningAsyncBatchSize)),
+		RateLimit:  cast.ToUint64(appOpts.Get(FlagPruningAsyncRateLimit)),
+		MaxBacklog: cast.ToUint64(appOpts.Get(FlagPruningAsyncMaxBacklog)),
+	}
+}
+
+func parsePruningOptions(strategyOpt, keepRecentOpt, intervalOpt interface{}) (pruningtypes.PruningOptions, error) {
+	strategy := strings.ToLower(cast.ToString(strategyOpt))
 
 	switch strategy {
 	case pruningtypes.PruningOptionDefault, pruningtypes.PruningOptionNothing, pruningtypes.PruningOptionEverything:
@@ -22,8 +64,8 @@ func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningO
 
 	case pruningtypes.PruningOptionCustom:
 		opts := pruningtypes.NewCustomPruningOptions(
-			cast.ToUint64(appOpts.Get(FlagPruningKeepRecent)),
-			cast.ToUint64(appOpts.Get(FlagPruningInterval)),
+			cast.ToUint64(keepRecentOpt),
+			cast.ToUint64(intervalOpt),
 		)
 
 		if err := opts.Validate(); err != nil {
@@ -66,3 +66,46 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestGetStorePruningOptionsFromFlags(t *testing.T) {
+	v := viper.New()
+	opts, err := GetStorePruningOptionsFromFlags(v)
+	require.NoError(t, err)
+	require.Empty(t, opts)
+
+	v.Set(FlagStorePruning+".bank."+FlagPruning, pruningtypes.PruningOptionCustom)
+	v.Set(FlagStorePruning+".bank."+FlagPruningKeepRecent, "1000000")
+	v.Set(FlagStorePruning+".bank."+FlagPruningInterval, "10")
+	v.Set(FlagStorePruning+".ibc."+FlagPruning, pruningtypes.PruningOptionEverything)
+	opts, err = GetStorePruningOptionsFromFlags(v)
+	require.NoError(t, err)
+	require.Equal(t, map[string]pruningtypes.PruningOptions{
+		"bank": pruningtypes.NewCustomPruningOptions(1000000, 10),
+		"ibc":  pruningtypes.NewPruningOptions(pruningtypes.PruningEverything),
+	}, opts)
+
+	v.Set(FlagStorePruning+".ibc."+FlagPruning, "sometimes")
+	_, err = GetStorePruningOptionsFromFlags(v)
+	require.Error(t, err)
+
+	v.Set(FlagStorePruning+".ibc."+FlagPruning, pruningtypes.PruningOptionCustom)
+	v.Set(FlagStorePruning+".ibc."+FlagPruningInterval, "1")
+	_, err = GetStorePruningOptionsFromFlags(v)
+	require.Error(t, err)
+}
+
+func TestGetAsyncPruningOptionsFromFlags(t *testing.T) {
+	v := viper.New()
+	require.Equal(t, pruningtypes.AsyncPruningOptions{}, GetAsyncPruningOptionsFromFlags(v))
+
+	v.Set(FlagPruningAsync, true)
+	v.Set(FlagPruningAsyncBatchSize, 10)
+	v.Set(FlagPruningAsyncRateLimit, "5")
+	v.Set(FlagPruningAsyncMaxBacklog, uint64(1000))
+	require.Equal(t, pruningtypes.AsyncPruningOptions{
+		Enabled:    true,
+		BatchSize:  10,
+		RateLimit:  5,
+		MaxBacklog: 1000,
+	}, GetAsyncPruningOptionsFromFlags(v))
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -26,7 +27,10 @@ application.
 			ctx := GetServerContextFromCmd(cmd)
 			cfg := ctx.Config
 			home := cfg.RootDir
-			db, err := openDB(home, GetAppDBBackend(ctx.Viper))
+			if UseMultiStoreV2(ctx.Viper) {
+				return errors.New("rollback is not supported by the v2 multistore")
+			}
+			db, err := OpenDB(home, GetAppDBBackend(ctx.Viper))
 			if err != nil {
 				return err
 			}
@@ -4,6 +4,7 @@ package server
 
 import (
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"os"
@@ -33,6 +34,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/rosetta"
 	crgserver "github.com/cosmos/cosmos-sdk/server/rosetta/lib/server"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 )
@@ -55,12 +57,23 @@ const (
 	FlagPruning           = "pruning"
 	FlagPruningKeepRecent = "pruning-keep-recent"
 	FlagPruningInterval   = "pruning-interval"
-	FlagIndexEvents       = "index-events"
-	FlagMinRetainBlocks   = "min-retain-blocks"
+	FlagStorePruning      = "store-pruning"
+
+	FlagPruningAsync           = "pruning-async"
+	FlagPruningAsyncBatchSize  = "pruning-async-batch-size"
+	FlagPruningAsyncRateLimit  = "pruning-async-rate-limit"
+	FlagPruningAsyncMaxBacklog = "pruning-async-max-backlog"
+
+	FlagIndexEvents     = "index-events"
+	FlagMinRetainBlocks = "min-retain-blocks"
+	FlagMultiStore      = "multistore"
 
 	// state sync-related flags
-	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
-	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotInterval    = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent  = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotCodec       = "state-sync.snapshot-codec"
+	FlagStateSyncSnapshotWorkers     = "state-sync.snapshot-workers"
+	FlagStateSyncIncrementalInterval = "state-sync.snapshot-incremental-interval"
 
 	// api-related flags
 	FlagAPIEnable             = "api.enable"
@@ -165,8 +178,13 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().String(FlagPruning, pruningtypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
 	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Bool(FlagPruningAsync, false, "Remove the pruned heights from disk in the background")
+	cmd.Flags().Uint64(FlagPruningAsyncBatchSize, 10, "Number of heights removed from disk at once by the background pruning (0 for all)")
+	cmd.Flags().Uint64(FlagPruningAsyncRateLimit, 0, "Maximum number of heights removed from disk per second by the background pruning (0 for no limit)")
+	cmd.Flags().Uint64(FlagPruningAsyncMaxBacklog, 100000, "Maximum number of heights waiting to be removed from disk by the background pruning (0 for no limit)")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune Tendermint blocks")
+	cmd.Flags().String(FlagMultiStore, serverconfig.MultiStoreV1, "Multistore storing the application state (v1|v2); the state must be migrated to switch to v2")
 
 	cmd.Flags().Bool(FlagAPIEnable, false, "Define if the API server should be enabled")
 	cmd.Flags().Bool(FlagAPISwagger, false, "Define if swagger documentation should automatically be registered (Note: the API must also be enabled)")
@@ -186,6 +204,9 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().String(FlagStateSyncSnapshotCodec, snapshottypes.CodecZlib, "State sync snapshot compression codec (none|zlib|zstd)")
+	cmd.Flags().Int(FlagStateSyncSnapshotWorkers, 0, "Number of workers compressing the state sync snapshots (0 to use the number of CPUs)")
+	cmd.Flags().Uint64(FlagStateSyncIncrementalInterval, 0, "Incremental snapshot interval (0 to disable)")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -197,7 +218,7 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 	transport := ctx.Viper.GetString(flagTransport)
 	home := ctx.Viper.GetString(flags.FlagHome)
 
-	db, err := openDB(home, GetAppDBBackend(ctx.Viper))
+	db, err := OpenDB(home, GetAppDBBackend(ctx.Viper))
 	if err != nil {
 		return err
 	}
@@ -230,6 +251,12 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 		if err = svr.Stop(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		if closer, ok := app.(io.Closer); ok {
+			if err = closer.Close(); err != nil {
+				tmos.Exit(err.Error())
+			}
+		}
 	}()
 
 	// Wait for SIGINT or SIGTERM signal
@@ -261,7 +288,7 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		}
 	}
 
-	db, err := openDB(home, GetAppDBBackend(ctx.Viper))
+	db, err := OpenDB(home, GetAppDBBackend(ctx.Viper))
 	if err != nil {
 		return err
 	}
@@ -478,6 +505,12 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			_ = tmNode.Stop()
 		}
 
+		if closer, ok := app.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				ctx.Logger.Error("failed to close app", "err", err)
+			}
+		}
+
 		if cpuProfileCleanup != nil {
 			cpuProfileCleanup()
 		}
@@ -0,0 +1,326 @@
+package server
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+)
+
+const FlagStore = "store"
+
+// Operations of a KVChange.
+const (
+	KVChangeCreate = "create"
+	KVChangeUpdate = "update"
+	KVChangeDelete = "delete"
+)
+
+// StateDiff is the set of key/value changes written to the stores of the
+// application at a given height.
+type StateDiff struct {
+	Height int64       `json:"height"`
+	Stores []StoreDiff `json:"stores"`
+}
+
+// StoreDiff is the set of key/value changes written to a single store.
+type StoreDiff struct {
+	Store   string     `json:"store"`
+	Changes []KVChange `json:"changes"`
+}
+
+// KVChange is a single key/value change. Keys and values are hex encoded, and
+// the change is decoded with the store decoder of the module and the KV schema
+// of the store when available.
+type KVChange struct {
+	Operation string            `json:"operation"`
+	Key       string            `json:"key"`
+	OldValue  string            `json:"old_value,omitempty"`
+	NewValue  string            `json:"new_value,omitempty"`
+	Decoded   string            `json:"decoded,omitempty"`
+	Entry     string            `json:"entry,omitempty"`
+	KeyJSON   schema.DecodedKey `json:"key_json,omitempty"`
+	OldJSON   json.RawMessage   `json:"old_json,omitempty"`
+	NewJSON   json.RawMessage   `json:"new_json,omitempty"`
+}
+
+// NewStateDiffCmd creates a command to print the key/value changes written to
+// each store of the application at a given height. The decoders function returns
+// the store decoders used to decode the changes, by the name of the store they
+// decode, and the schemas function the KV schemas registered by the application's
+// modules. Both may be nil.
+func NewStateDiffCmd(defaultNodeHome string, decoders func() sdk.StoreDecoderRegistry, schemas func() *schema.Registry) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-diff",
+		Short: "Print the key/value changes written to the application stores at a given height",
+		Long: `Print, as JSON, the key/value changes written to each store of the application at a
+given height, by diffing the state at this height against the state at the previous height.
+Both heights must not have been pruned. The node must not be running.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			storeName, _ := cmd.Flags().GetString(FlagStore)
+
+			db, err := OpenDB(config.RootDir, GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			var registry sdk.StoreDecoderRegistry
+			if decoders != nil {
+				registry = decoders()
+			}
+			var schemaRegistry *schema.Registry
+			if schemas != nil {
+				schemaRegistry = schemas()
+			}
+
+			diff, err := GetStateDiff(db, height, storeName, registry, schemaRegistry)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.Marshal(diff)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagHeight, 0, "The height to print the state changes of")
+	cmd.Flags().String(FlagStore, "", "Only print the changes of the given store")
+	_ = cmd.MarkFlagRequired(FlagHeight)
+
+	return cmd
+}
+
+// GetStateDiff returns the key/value changes written to the stores of the
+// application database at the given height. If storeName is not empty, only
+// the changes of that store are returned. The changes are decoded with the
+// store decoders and the KV schemas, which may be nil.
+func GetStateDiff(db dbm.DB, height int64, storeName string, decoders sdk.StoreDecoderRegistry, schemas *schema.Registry) (StateDiff, error) {
+	if height <= 0 {
+		return StateDiff{}, fmt.Errorf("invalid height %d", height)
+	}
+
+	cms := rootmulti.NewStore(db, log.NewNopLogger())
+	newInfo, err := cms.GetCommitInfo(height)
+	if err != nil {
+		return StateDiff{}, fmt.Errorf("failed to get the commit info at height %d: %w", height, err)
+	}
+	oldInfo := &storetypes.CommitInfo{}
+	if height > 1 {
+		if oldInfo, err = cms.GetCommitInfo(height - 1); err != nil {
+			return StateDiff{}, fmt.Errorf("failed to get the commit info at height %d: %w", height-1, err)
+		}
+	}
+
+	newNames, oldNames := storeNames(newInfo, storeName), storeNames(oldInfo, storeName)
+	if storeName != "" && len(newNames) == 0 && len(oldNames) == 0 {
+		return StateDiff{}, fmt.Errorf("store %s not found at height %d", storeName, height)
+	}
+
+	newStores, err := loadStoresAtVersion(db, newNames, height)
+	if err != nil {
+		return StateDiff{}, err
+	}
+	oldStores, err := loadStoresAtVersion(db, oldNames, height-1)
+	if err != nil {
+		return StateDiff{}, err
+	}
+
+	names := make([]string, 0, len(newNames)+len(oldNames))
+	names = append(names, newNames...)
+	for _, name := range oldNames {
+		if _, ok := newStores[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	diff := StateDiff{Height: height, Stores: make([]StoreDiff, 0, len(names))}
+	for _, name := range names {
+		decoder := changeDecoder{storeName: name, decoder: decoders[name], schemas: schemas}
+		changes := diffKVStores(oldStores[name], newStores[name], decoder)
+		if len(changes) > 0 {
+			diff.Stores = append(diff.Stores, StoreDiff{Store: name, Changes: changes})
+		}
+	}
+
+	return diff, nil
+}
+
+// storeNames returns the names of the stores in the commit info, restricted
+// to storeName if it is not empty.
+func storeNames(info *storetypes.CommitInfo, storeName string) []string {
+	var names []string
+	for _, storeInfo := range info.StoreInfos {
+		if storeName == "" || storeInfo.Name == storeName {
+			names = append(names, storeInfo.Name)
+		}
+	}
+	return names
+}
+
+// loadStoresAtVersion loads the named IAVL stores of the application database
+// at the given version.
+func loadStoresAtVersion(db dbm.DB, names []string, version int64) (map[string]storetypes.KVStore, error) {
+	stores := make(map[string]storetypes.KVStore, len(names))
+	if len(names) == 0 {
+		return stores, nil
+	}
+
+	cms := rootmulti.NewStore(db, log.NewNopLogger())
+	keys := make(map[string]storetypes.StoreKey, len(names))
+	for _, name := range names {
+		keys[name] = storetypes.NewKVStoreKey(name)
+		cms.MountStoreWithDB(keys[name], storetypes.StoreTypeIAVL, nil)
+	}
+	if err := cms.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+
+	cacheMS, err := cms.CacheMultiStoreWithVersion(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the stores at height %d: %w", version, err)
+	}
+	for name, key := range keys {
+		stores[name] = cacheMS.GetKVStore(key)
+	}
+
+	return stores, nil
+}
+
+// diffKVStores returns the key/value changes between the two stores, a nil
+// store being considered empty.
+func diffKVStores(oldStore, newStore storetypes.KVStore, decoder changeDecoder) []KVChange {
+	if oldStore == nil {
+		oldStore = dbadapter.Store{DB: dbm.NewMemDB()}
+	}
+	if newStore == nil {
+		newStore = dbadapter.Store{DB: dbm.NewMemDB()}
+	}
+
+	oldIter := oldStore.Iterator(nil, nil)
+	defer oldIter.Close()
+	newIter := newStore.Iterator(nil, nil)
+	defer newIter.Close()
+
+	var changes []KVChange
+	for oldIter.Valid() || newIter.Valid() {
+		var cmp int
+		switch {
+		case !oldIter.Valid():
+			cmp = 1
+		case !newIter.Valid():
+			cmp = -1
+		default:
+			cmp = bytes.Compare(oldIter.Key(), newIter.Key())
+		}
+
+		switch {
+		case cmp < 0:
+			kvA := kv.Pair{Key: oldIter.Key(), Value: oldIter.Value()}
+			changes = append(changes, newKVChange(KVChangeDelete, kvA, kv.Pair{Key: kvA.Key}, decoder))
+			oldIter.Next()
+		case cmp > 0:
+			kvB := kv.Pair{Key: newIter.Key(), Value: newIter.Value()}
+			changes = append(changes, newKVChange(KVChangeCreate, kv.Pair{Key: kvB.Key}, kvB, decoder))
+			newIter.Next()
+		default:
+			kvA := kv.Pair{Key: oldIter.Key(), Value: oldIter.Value()}
+			kvB := kv.Pair{Key: newIter.Key(), Value: newIter.Value()}
+			if !bytes.Equal(kvA.Value, kvB.Value) {
+				changes = append(changes, newKVChange(KVChangeUpdate, kvA, kvB, decoder))
+			}
+			oldIter.Next()
+			newIter.Next()
+		}
+	}
+
+	return changes
+}
+
+// changeDecoder decodes the changes of a store.
+type changeDecoder struct {
+	storeName string
+	decoder   func(kvA, kvB kv.Pair) string
+	schemas   *schema.Registry
+}
+
+func newKVChange(operation string, kvA, kvB kv.Pair, decoder changeDecoder) KVChange {
+	key := kvA.Key
+	if key == nil {
+		key = kvB.Key
+	}
+	change := KVChange{
+		Operation: operation,
+		Key:       hex.EncodeToString(key),
+		OldValue:  hex.EncodeToString(kvA.Value),
+		NewValue:  hex.EncodeToString(kvB.Value),
+		Decoded:   decodeKVChange(kvA, kvB, decoder.decoder),
+	}
+	decodeKVChangeWithSchema(&change, key, kvA.Value, kvB.Value, decoder)
+	return change
+}
+
+// decodeKVChangeWithSchema decodes the key and the values of the change with the
+// KV schema of the store, if any. The change is left undecoded if the key is
+// unknown to the schema or fails to decode.
+func decodeKVChangeWithSchema(change *KVChange, key, oldValue, newValue []byte, decoder changeDecoder) {
+	if decoder.schemas == nil {
+		return
+	}
+	oldKV, err := decoder.schemas.Decode(decoder.storeName, key, oldValue)
+	if err != nil {
+		return
+	}
+	newKV, err := decoder.schemas.Decode(decoder.storeName, key, newValue)
+	if err != nil {
+		return
+	}
+	change.Entry = oldKV.Entry
+	change.KeyJSON = oldKV.Key
+	change.OldJSON = oldKV.Value
+	change.NewJSON = newKV.Value
+}
+
+// decodeKVChange decodes the change with the store decoder, if any. The store
+// decoders panic on the keys they do not know, in which case the change is
+// left undecoded.
+func decodeKVChange(kvA, kvB kv.Pair, decoder func(kvA, kvB kv.Pair) string) (decoded string) {
+	if decoder == nil {
+		return ""
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			decoded = ""
+		}
+	}()
+	return decoder(kvA, kvB)
+}
@@ -0,0 +1,101 @@
+package server_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+)
+
+func TestGetStateDiff(t *testing.T) {
+	db := dbm.NewMemDB()
+	bankKey, stakingKey := storetypes.NewKVStoreKey("bank"), storetypes.NewKVStoreKey("staking")
+	cms := rootmulti.NewStore(db, log.NewNopLogger())
+	cms.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(stakingKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	// height 1
+	cms.GetKVStore(bankKey).Set([]byte("a"), []byte{1})
+	cms.GetKVStore(bankKey).Set([]byte("b"), []byte{2})
+	cms.GetKVStore(stakingKey).Set([]byte("x"), []byte{1})
+	cms.Commit()
+
+	// height 2
+	cms.GetKVStore(bankKey).Set([]byte("a"), []byte{3})
+	cms.GetKVStore(bankKey).Delete([]byte("b"))
+	cms.GetKVStore(bankKey).Set([]byte("c"), []byte{4})
+	cms.Commit()
+
+	decoders := sdk.StoreDecoderRegistry{
+		"bank": func(kvA, kvB kv.Pair) string {
+			if string(kvA.Key) == "c" {
+				panic("unknown key")
+			}
+			return fmt.Sprintf("%v -> %v", kvA.Value, kvB.Value)
+		},
+	}
+
+	diff, err := server.GetStateDiff(db, 2, "", decoders, nil)
+	require.NoError(t, err)
+	require.Equal(t, server.StateDiff{
+		Height: 2,
+		Stores: []server.StoreDiff{
+			{
+				Store: "bank",
+				Changes: []server.KVChange{
+					{Operation: server.KVChangeUpdate, Key: "61", OldValue: "01", NewValue: "03", Decoded: "[1] -> [3]"},
+					{Operation: server.KVChangeDelete, Key: "62", OldValue: "02", Decoded: "[2] -> []"},
+					{Operation: server.KVChangeCreate, Key: "63", NewValue: "04"},
+				},
+			},
+		},
+	}, diff)
+
+	schemas := schema.NewRegistry(nil)
+	schemas.Register("bank", schema.Entry{
+		Name:  "letter",
+		Key:   []schema.KeyField{schema.Field("letter", schema.StringKey)},
+		Value: schema.BytesValue,
+	})
+	diff, err = server.GetStateDiff(db, 2, "bank", nil, schemas)
+	require.NoError(t, err)
+	letter := func(l string) schema.DecodedKey { return schema.DecodedKey{{Name: "letter", Value: l}} }
+	require.Equal(t, []server.KVChange{
+		{Operation: server.KVChangeUpdate, Key: "61", OldValue: "01", NewValue: "03", Entry: "letter", KeyJSON: letter("a"), OldJSON: []byte(`"01"`), NewJSON: []byte(`"03"`)},
+		{Operation: server.KVChangeDelete, Key: "62", OldValue: "02", Entry: "letter", KeyJSON: letter("b"), OldJSON: []byte(`"02"`)},
+		{Operation: server.KVChangeCreate, Key: "63", NewValue: "04", Entry: "letter", KeyJSON: letter("c"), NewJSON: []byte(`"04"`)},
+	}, diff.Stores[0].Changes)
+
+	diff, err = server.GetStateDiff(db, 1, "staking", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, server.StateDiff{
+		Height: 1,
+		Stores: []server.StoreDiff{
+			{
+				Store:   "staking",
+				Changes: []server.KVChange{{Operation: server.KVChangeCreate, Key: "78", NewValue: "01"}},
+			},
+		},
+	}, diff)
+
+	diff, err = server.GetStateDiff(db, 2, "staking", nil, nil)
+	require.NoError(t, err)
+	require.Empty(t, diff.Stores)
+
+	_, err = server.GetStateDiff(db, 2, "gov", nil, nil)
+	require.Error(t, err)
+	_, err = server.GetStateDiff(db, 3, "", nil, nil)
+	require.Error(t, err)
+	_, err = server.GetStateDiff(db, 0, "", nil, nil)
+	require.Error(t, err)
+}
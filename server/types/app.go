@@ -36,7 +36,9 @@ type (
 
 	// Application defines an application interface that wraps abci.Application.
 	// The interface defines the necessary contracts to be implemented in order
-	// to fully bootstrap and start an application.
+	// to fully bootstrap and start an application. An application implementing
+	// io.Closer, e.g. through BaseApp, is closed once the node has stopped, to
+	// release its resources.
 	Application interface {
 		abci.Application
 
@@ -13,8 +13,10 @@ import (
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
@@ -33,8 +35,10 @@ import (
 	capabilitymodulev1 "cosmossdk.io/api/cosmos/capability/module/v1"
 	crisismodulev1 "cosmossdk.io/api/cosmos/crisis/module/v1"
 	distrmodulev1 "cosmossdk.io/api/cosmos/distribution/module/v1"
+	epochingmodulev1 "cosmossdk.io/api/cosmos/epoching/module/v1"
 	evidencemodulev1 "cosmossdk.io/api/cosmos/evidence/module/v1"
 	feegrantmodulev1 "cosmossdk.io/api/cosmos/feegrant/module/v1"
+	feemarketmodulev1 "cosmossdk.io/api/cosmos/feemarket/module/v1"
 	genutilmodulev1 "cosmossdk.io/api/cosmos/genutil/module/v1"
 	govmodulev1 "cosmossdk.io/api/cosmos/gov/module/v1"
 	groupmodulev1 "cosmossdk.io/api/cosmos/group/module/v1"
@@ -76,12 +80,18 @@ var AppConfig = appconfig.Compose(&appv1alpha1.Config{
 					feegrant.ModuleName,
 					nft.ModuleName,
 					group.ModuleName,
+					epochingtypes.ModuleName,
+					feemarkettypes.ModuleName,
 					paramstypes.ModuleName,
 					vestingtypes.ModuleName,
 				},
+				// NOTE: epoching module's endblocker must come before staking so that
+				// the staking msgs executed at the end of an epoch update the
+				// validator set in the same block
 				EndBlockers: []string{
 					crisistypes.ModuleName,
 					govtypes.ModuleName,
+					epochingtypes.ModuleName,
 					stakingtypes.ModuleName,
 					capabilitytypes.ModuleName,
 					authtypes.ModuleName,
@@ -89,6 +99,7 @@ var AppConfig = appconfig.Compose(&appv1alpha1.Config{
 					distrtypes.ModuleName,
 					slashingtypes.ModuleName,
 					minttypes.ModuleName,
+					feemarkettypes.ModuleName,
 					genutiltypes.ModuleName,
 					evidencetypes.ModuleName,
 					authz.ModuleName,
@@ -119,6 +130,8 @@ var AppConfig = appconfig.Compose(&appv1alpha1.Config{
 					{Account: stakingtypes.NotBondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
 					{Account: govtypes.ModuleName, Permissions: []string{authtypes.Burner}},
 					{Account: nft.ModuleName},
+					{Account: feemarkettypes.ModuleName, Permissions: []string{authtypes.Burner}},
+					{Account: epochingtypes.ModuleName},
 				},
 			}),
 		},
@@ -144,8 +157,9 @@ var AppConfig = appconfig.Compose(&appv1alpha1.Config{
 			Config: appconfig.WrapAny(&paramsmodulev1.Module{}),
 		},
 		{
-			Name:   "tx",
-			Config: appconfig.WrapAny(&txmodulev1.Module{}),
+			Name: "tx",
+			// the AnteHandler of SimApp is set in NewSimApp, see setAnteHandler
+			Config: appconfig.WrapAny(&txmodulev1.Module{SkipAnteHandler: true}),
 		},
 		{
 			Name:   genutiltypes.ModuleName,
@@ -188,10 +202,18 @@ var AppConfig = appconfig.Compose(&appv1alpha1.Config{
 			Name:   nft.ModuleName,
 			Config: appconfig.WrapAny(&nftmodulev1.Module{}),
 		},
+		{
+			Name:   epochingtypes.ModuleName,
+			Config: appconfig.WrapAny(&epochingmodulev1.Module{}),
+		},
 		{
 			Name:   feegrant.ModuleName,
 			Config: appconfig.WrapAny(&feegrantmodulev1.Module{}),
 		},
+		{
+			Name:   feemarkettypes.ModuleName,
+			Config: appconfig.WrapAny(&feemarketmodulev1.Module{}),
+		},
 		{
 			Name:   govtypes.ModuleName,
 			Config: appconfig.WrapAny(&govmodulev1.Module{}),
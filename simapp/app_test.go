@@ -12,9 +12,11 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
@@ -24,8 +26,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/capability"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	group "github.com/cosmos/cosmos-sdk/x/group/module"
@@ -33,6 +37,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 )
 
@@ -189,6 +194,8 @@ func TestRunMigrations(t *testing.T) {
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
 					"capability":   capability.AppModule{}.ConsensusVersion(),
+					"epoching":     epoching.AppModule{}.ConsensusVersion(),
+					"feemarket":    feemarket.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -266,3 +273,59 @@ func TestUpgradeStateOnGenesis(t *testing.T) {
 
 	require.NotNil(t, app.UpgradeKeeper.GetVersionSetter())
 }
+
+func TestKVSchemas(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	registry := schema.NewRegistry(app.AppCodec())
+	ModuleBasics.RegisterKVSchemas(registry)
+	appRegistry := schema.NewRegistry(app.AppCodec())
+	app.ModuleManager.RegisterKVSchemas(appRegistry)
+	require.Equal(t, appRegistry.StoreNames(), registry.StoreNames())
+
+	for name := range app.SimulationManager().StoreDecoders {
+		require.Contains(t, registry.StoreNames(), name)
+	}
+
+	// every entry of the genesis state is decoded
+	var decoded int
+	for _, name := range registry.StoreNames() {
+		key := app.GetKey(name)
+		require.NotNil(t, key, name)
+
+		iter := ctx.KVStore(key).Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			_, err := registry.Decode(name, iter.Key(), iter.Value())
+			require.NoError(t, err)
+			decoded++
+		}
+		iter.Close()
+	}
+	require.NotZero(t, decoded)
+}
+
+func TestRejectUnwrappedStakingMsgsOpt(t *testing.T) {
+	for _, reject := range []bool{false, true} {
+		appOpts := simtestutil.AppOptionsMap{
+			flags.FlagHome:                          DefaultNodeHome,
+			epoching.FlagRejectUnwrappedStakingMsgs: reject,
+		}
+		app := NewSimappWithCustomOptions(t, false, SetupOptions{
+			Logger:    log.NewNopLogger(),
+			DB:        dbm.NewMemDB(),
+			EncConfig: MakeTestEncodingConfig(),
+			AppOpts:   appOpts,
+		})
+		ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1})
+
+		msg := &stakingtypes.MsgDelegate{}
+		_, err := app.MsgServiceRouter().Handler(msg)(ctx, msg)
+		require.Error(t, err)
+		if reject {
+			require.ErrorContains(t, err, "must be wrapped in an epoching msg")
+		} else {
+			require.NotContains(t, err.Error(), "must be wrapped in an epoching msg")
+		}
+	}
+}
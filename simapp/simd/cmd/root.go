@@ -4,7 +4,6 @@ import (
 	"errors"
 	"io"
 	"os"
-	"path/filepath"
 
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
@@ -21,20 +20,55 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/cosmos/cosmos-sdk/client/snapshot"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/simapp/params"
-	"github.com/cosmos/cosmos-sdk/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/v2alpha1/multi"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	authzsims "github.com/cosmos/cosmos-sdk/x/authz/simulation"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitysims "github.com/cosmos/cosmos-sdk/x/capability/simulation"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
+	distrsims "github.com/cosmos/cosmos-sdk/x/distribution/simulation"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	evidencekeeper "github.com/cosmos/cosmos-sdk/x/evidence/keeper"
+	evidencesims "github.com/cosmos/cosmos-sdk/x/evidence/simulation"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	feegrantsims "github.com/cosmos/cosmos-sdk/x/feegrant/simulation"
+	feemarketsims "github.com/cosmos/cosmos-sdk/x/feemarket/simulation"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	govsims "github.com/cosmos/cosmos-sdk/x/gov/simulation"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	groupsims "github.com/cosmos/cosmos-sdk/x/group/simulation"
+	mintsims "github.com/cosmos/cosmos-sdk/x/mint/simulation"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	nftsims "github.com/cosmos/cosmos-sdk/x/nft/simulation"
+	slashingsims "github.com/cosmos/cosmos-sdk/x/slashing/simulation"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingsims "github.com/cosmos/cosmos-sdk/x/staking/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -48,6 +82,7 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 		WithLegacyAmino(encodingConfig.Amino).
 		WithInput(os.Stdin).
 		WithAccountRetriever(types.AccountRetriever{}).
+		WithGasPricesEstimator(feemarkettypes.GasPricesEstimator{}).
 		WithHomeDir(simapp.DefaultNodeHome).
 		WithViper("") // In simapp, we don't use any prefix for env variables.
 
@@ -69,6 +104,14 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 				return err
 			}
 
+			// SIGN_MODE_TEXTUAL renders the coins with the metadata queried from the node
+			txConfig := tx.NewTxConfigWithTextual(
+				codec.NewProtoCodec(encodingConfig.InterfaceRegistry),
+				tx.DefaultSignModes,
+				textual.NewGRPCCoinMetadataQueryFn(initClientCtx),
+			)
+			initClientCtx = initClientCtx.WithTxConfig(txConfig)
+
 			if err := client.SetCmdClientContextHandler(initClientCtx, cmd); err != nil {
 				return err
 			}
@@ -166,11 +209,17 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		AddGenesisAccountCmd(simapp.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		NewTestnetCmd(simapp.ModuleBasics, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
 		config.Cmd(),
 	)
 
 	a := appCreator{encodingConfig}
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(
+		server.NewStateDiffCmd(simapp.DefaultNodeHome, a.storeDecoders, a.kvSchemas),
+		server.NewKVCmd(simapp.DefaultNodeHome, a.kvSchemas),
+	)
+	rootCmd.AddCommand(debugCmd)
+	rootCmd.AddCommand(snapshot.Cmd(a.newApp))
 	server.AddCommands(rootCmd, simapp.DefaultNodeHome, a.newApp, a.appExport, addModuleInitFlags)
 
 	// add keybase, auxiliary RPC, query, and tx child commands
@@ -187,6 +236,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	epoching.AddModuleInitFlags(startCmd)
 }
 
 func queryCommand() *cobra.Command {
@@ -262,12 +312,12 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		panic(err)
 	}
 
-	snapshotDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots")
-	snapshotDB, err := dbm.NewDB("metadata", server.GetAppDBBackend(appOpts), snapshotDir)
+	storePruningOpts, err := server.GetStorePruningOptionsFromFlags(appOpts)
 	if err != nil {
 		panic(err)
 	}
-	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+
+	snapshotStore, err := server.GetSnapshotStore(appOpts)
 	if err != nil {
 		panic(err)
 	}
@@ -276,12 +326,24 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval)),
 		cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent)),
 	)
+	snapshotOptions.Codec = cast.ToString(appOpts.Get(server.FlagStateSyncSnapshotCodec))
+	snapshotOptions.Workers = cast.ToInt(appOpts.Get(server.FlagStateSyncSnapshotWorkers))
+	snapshotOptions.IncrementalInterval = cast.ToUint64(appOpts.Get(server.FlagStateSyncIncrementalInterval))
+
+	var baseappOptions []func(*baseapp.BaseApp)
+	if server.UseMultiStoreV2(appOpts) {
+		db2, err := server.OpenDBV2(cast.ToString(appOpts.Get(flags.FlagHome)))
+		if err != nil {
+			panic(err)
+		}
+		// the multistore must be set before the options configuring it
+		baseappOptions = append(baseappOptions, baseapp.SetMultiStoreV2(db2, multi.DefaultStoreConfig()))
+	}
 
-	return simapp.NewSimApp(
-		logger, db, traceStore, true,
-		a.encCfg,
-		appOpts,
+	baseappOptions = append(baseappOptions,
 		baseapp.SetPruning(pruningOpts),
+		baseapp.SetStorePruning(storePruningOpts),
+		baseapp.SetAsyncPruning(server.GetAsyncPruningOptionsFromFlags(appOpts)),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(server.FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(server.FlagHaltHeight))),
 		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(server.FlagHaltTime))),
@@ -291,6 +353,51 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents))),
 		baseapp.SetSnapshot(snapshotStore, snapshotOptions),
 	)
+
+	if traceStore != nil {
+		// decode the traced store entries with the key/value schemas of the modules
+		traceStore = tracekv.NewDecodingWriter(traceStore, a.kvSchemas().DecodeJSON)
+	}
+
+	return simapp.NewSimApp(
+		logger, db, traceStore, true,
+		a.encCfg,
+		appOpts,
+		baseappOptions...,
+	)
+}
+
+// storeDecoders returns the store decoders of the simapp modules, by the name
+// of the store they decode.
+func (a appCreator) storeDecoders() sdk.StoreDecoderRegistry {
+	cdc := a.encCfg.Codec
+	accountKeeper := authkeeper.NewAccountKeeper(
+		cdc, storetypes.NewKVStoreKey(types.StoreKey), types.ProtoBaseAccount, nil, sdk.GetConfig().GetBech32AccountAddrPrefix(), "",
+	)
+	evidenceKeeper := evidencekeeper.NewKeeper(cdc, storetypes.NewKVStoreKey(evidencetypes.StoreKey), nil, nil)
+
+	return sdk.StoreDecoderRegistry{
+		types.StoreKey:           authsims.NewDecodeStore(accountKeeper),
+		authzkeeper.StoreKey:     authzsims.NewDecodeStore(cdc),
+		capabilitytypes.StoreKey: capabilitysims.NewDecodeStore(cdc),
+		distrtypes.StoreKey:      distrsims.NewDecodeStore(cdc),
+		evidencetypes.StoreKey:   evidencesims.NewDecodeStore(evidenceKeeper),
+		feegrant.StoreKey:        feegrantsims.NewDecodeStore(cdc),
+		feemarkettypes.StoreKey:  feemarketsims.NewDecodeStore(cdc),
+		govtypes.StoreKey:        govsims.NewDecodeStore(cdc),
+		group.StoreKey:           groupsims.NewDecodeStore(cdc),
+		minttypes.StoreKey:       mintsims.NewDecodeStore(cdc),
+		nftkeeper.StoreKey:       nftsims.NewDecodeStore(cdc),
+		slashingtypes.StoreKey:   slashingsims.NewDecodeStore(cdc),
+		stakingtypes.StoreKey:    stakingsims.NewDecodeStore(cdc),
+	}
+}
+
+// kvSchemas returns the key/value schemas registered by the simapp modules.
+func (a appCreator) kvSchemas() *schema.Registry {
+	registry := schema.NewRegistry(a.encCfg.Codec)
+	simapp.ModuleBasics.RegisterKVSchemas(registry)
+	return registry
 }
 
 // appExport creates a new simapp (optionally at a given height)
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+)
+
+func TestStoreDecoders(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	app := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, encCfg, simtestutil.EmptyAppOptions{})
+
+	// the decoders of the modules are registered by the name of the store they decode
+	decoders := appCreator{encCfg}.storeDecoders()
+	for name := range decoders {
+		require.NotNil(t, app.GetKey(name), name)
+	}
+	for name := range app.SimulationManager().StoreDecoders {
+		require.Contains(t, decoders, name)
+	}
+}
@@ -31,6 +31,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -256,6 +257,18 @@ func CheckBalance(t *testing.T, app *SimApp, addr sdk.AccAddress, balances sdk.C
 	require.True(t, balances.IsEqual(app.BankKeeper.GetAllBalances(ctxCheck, addr)))
 }
 
+// SetEpochLength commits a block setting the length of the x/epoching epochs,
+// e.g. to 1 so that the staking msgs wrapped in epoching msgs are executed at
+// the end of the block in which they are delivered.
+func SetEpochLength(t *testing.T, app *SimApp, epochLength int64) {
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	err := app.EpochingKeeper.SetParams(app.BaseApp.NewContext(false, header), epochingtypes.NewParams(epochLength))
+	require.NoError(t, err)
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+}
+
 // SignCheckDeliver checks a generated signed transaction and simulates a
 // block commitment with the given transaction. A test assertion is made using
 // the parameter 'expPass' against the result. A corresponding result is
@@ -4,6 +4,8 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
@@ -31,6 +33,8 @@ func (app SimApp) RegisterUpgradeHandlers() {
 			Added: []string{
 				group.ModuleName,
 				nft.ModuleName,
+				epochingtypes.ModuleName,
+				feemarkettypes.ModuleName,
 			},
 		}
 
@@ -0,0 +1,121 @@
+package snapshots
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Codec identifiers, written as the first byte of the chunks of FormatCompressedChunks snapshots.
+// Do not change them without new snapshot format (must be uniform across nodes)
+const (
+	codecIDNone byte = 0
+	codecIDZlib byte = 1
+	codecIDZstd byte = 2
+)
+
+var (
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+	zstdErr     error
+)
+
+// zstdCodec returns the zstd encoder and decoder, which are safe for concurrent use
+// with EncodeAll and DecodeAll.
+func zstdCodec() (*zstd.Encoder, *zstd.Decoder, error) {
+	zstdOnce.Do(func() {
+		zstdEncoder, zstdErr = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		if zstdErr != nil {
+			return
+		}
+		zstdDecoder, zstdErr = zstd.NewReader(nil,
+			zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(snapshotChunkSize))
+	})
+	return zstdEncoder, zstdDecoder, zstdErr
+}
+
+// compressChunk compresses a segment of the snapshot item stream into a chunk, prefixed
+// with the identifier of the codec.
+func compressChunk(codec string, segment []byte) ([]byte, error) {
+	switch codec {
+	case types.CodecNone:
+		return append([]byte{codecIDNone}, segment...), nil
+
+	case "", types.CodecZlib:
+		var buf bytes.Buffer
+		buf.WriteByte(codecIDZlib)
+		zWriter, err := zlib.NewWriterLevel(&buf, snapshotCompressionLevel)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "zlib failure")
+		}
+		if _, err := zWriter.Write(segment); err != nil {
+			return nil, sdkerrors.Wrap(err, "zlib failure")
+		}
+		if err := zWriter.Close(); err != nil {
+			return nil, sdkerrors.Wrap(err, "zlib failure")
+		}
+		return buf.Bytes(), nil
+
+	case types.CodecZstd:
+		encoder, _, err := zstdCodec()
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "zstd failure")
+		}
+		return encoder.EncodeAll(segment, []byte{codecIDZstd}), nil
+
+	default:
+		return nil, types.ValidateCodec(codec)
+	}
+}
+
+// decompressChunk decompresses a chunk written by compressChunk, with the codec it is prefixed with.
+func decompressChunk(chunk []byte) ([]byte, error) {
+	if len(chunk) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalidChunk, "empty snapshot chunk")
+	}
+	codecID, data := chunk[0], chunk[1:]
+	switch codecID {
+	case codecIDNone:
+		if uint64(len(data)) > snapshotChunkSize {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidChunk, "chunk exceeds %v bytes", snapshotChunkSize)
+		}
+		return data, nil
+
+	case codecIDZlib:
+		zReader, err := zlib.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "zlib failure")
+		}
+		defer zReader.Close()
+		// guard against chunks decompressing to more than a segment
+		segment, err := io.ReadAll(io.LimitReader(zReader, int64(snapshotChunkSize)+1))
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "zlib failure")
+		}
+		if uint64(len(segment)) > snapshotChunkSize {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidChunk, "chunk exceeds %v bytes", snapshotChunkSize)
+		}
+		return segment, nil
+
+	case codecIDZstd:
+		_, decoder, err := zstdCodec()
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "zstd failure")
+		}
+		segment, err := decoder.DecodeAll(data, nil)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "zstd failure")
+		}
+		return segment, nil
+
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrInvalidChunk, "unknown snapshot chunk codec %d", codecID)
+	}
+}
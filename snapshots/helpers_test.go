@@ -94,6 +94,31 @@ func snapshotItems(items [][]byte) [][]byte {
 	return chunks
 }
 
+// compressedSnapshotItems serialize a array of bytes as SnapshotItem_ExtensionPayload, and return
+// the chunks of the FormatCompressedChunks format, compressed with zlib.
+func compressedSnapshotItems(items [][]byte) [][]byte {
+	// copy the same parameters from the code
+	snapshotChunkSize := int(10e6)
+
+	var stream bytes.Buffer
+	protoWriter := protoio.NewDelimitedWriter(&stream)
+	for _, item := range items {
+		_ = snapshottypes.WriteExtensionItem(protoWriter, item)
+	}
+
+	var chunks [][]byte
+	for segment := stream.Next(snapshotChunkSize); len(segment) > 0; segment = stream.Next(snapshotChunkSize) {
+		var chunk bytes.Buffer
+		chunk.WriteByte(1) // zlib codec
+		zWriter, _ := zlib.NewWriterLevel(&chunk, 7)
+		_, _ = zWriter.Write(segment)
+		_ = zWriter.Close()
+		chunks = append(chunks, chunk.Bytes())
+	}
+
+	return chunks
+}
+
 type mockSnapshotter struct {
 	items            [][]byte
 	prunedHeights    map[int64]struct{}
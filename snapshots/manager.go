@@ -183,13 +183,77 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 	return m.store.Save(height, types.CurrentFormat, ch)
 }
 
+// CreateIncremental creates an incremental snapshot of the changes of the state since the height of
+// the latest snapshot, which it can be restored on top of, and returns its metadata. Taking it on
+// top of the latest snapshot only lets the pruning keep the chain of snapshots it depends on.
+func (m *Manager) CreateIncremental(baseHeight, height uint64) (*types.Snapshot, error) {
+	if m == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "no snapshot store configured")
+	}
+	multistore, ok := m.multistore.(types.IncrementalSnapshotter)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "the multistore does not support incremental snapshots")
+	}
+
+	err := m.begin(opSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	defer m.end()
+
+	latest, err := m.store.GetLatest()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to examine latest snapshot")
+	}
+	if latest != nil && latest.Height >= height {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrConflict,
+			"a more recent snapshot already exists at height %v", latest.Height)
+	}
+	hasBase, err := m.hasSnapshot(baseHeight)
+	if err != nil {
+		return nil, err
+	}
+	if !hasBase {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no snapshot at base height %v", baseHeight)
+	}
+	if latest.Height != baseHeight {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrConflict,
+			"base height %v is not the height of the latest snapshot %v", baseHeight, latest.Height)
+	}
+
+	ch := make(chan io.ReadCloser)
+	go func() {
+		streamWriter := NewParallelStreamWriter(ch, m.opts.Codec, m.opts.Workers)
+		if err := multistore.SnapshotIncremental(baseHeight, height, streamWriter); err != nil {
+			streamWriter.CloseWithError(err)
+			return
+		}
+		if err := streamWriter.Close(); err != nil {
+			streamWriter.CloseWithError(err)
+		}
+	}()
+
+	return m.store.Save(height, types.FormatIncremental, ch)
+}
+
+// hasSnapshot returns whether a snapshot of any format exists at the given height.
+func (m *Manager) hasSnapshot(height uint64) (bool, error) {
+	snapshots, err := m.store.List()
+	if err != nil {
+		return false, err
+	}
+	for _, snapshot := range snapshots {
+		if snapshot.Height == height {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
 // the produced chunks are written to the channel.
 func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
-	streamWriter := NewStreamWriter(ch)
-	if streamWriter == nil {
-		return
-	}
+	streamWriter := NewParallelStreamWriter(ch, m.opts.Codec, m.opts.Workers)
 	defer func() {
 		if err := streamWriter.Close(); err != nil {
 			streamWriter.CloseWithError(err)
@@ -242,14 +306,37 @@ func (m *Manager) LoadChunk(height uint64, format uint32, chunk uint32) ([]byte,
 	return io.ReadAll(reader)
 }
 
-// Prune prunes snapshots, if no other operations are in progress.
+// Prune prunes snapshots, if no other operations are in progress. The given number of most recent
+// full snapshots are retained, together with the incremental snapshots taken on top of them. The
+// changes recorded for the incremental snapshots are pruned up to the oldest snapshot left, which
+// is a full snapshot, since incremental snapshots are taken on top of the latest snapshot.
 func (m *Manager) Prune(retain uint32) (uint64, error) {
 	err := m.begin(opPrune)
 	if err != nil {
 		return 0, err
 	}
 	defer m.end()
-	return m.store.Prune(retain)
+	pruned, err := m.store.Prune(retain)
+	if err != nil {
+		return 0, err
+	}
+
+	multistore, ok := m.multistore.(types.IncrementalSnapshotter)
+	if !ok {
+		return pruned, nil
+	}
+	snapshots, err := m.store.List()
+	if err != nil {
+		return 0, err
+	}
+	if len(snapshots) > 0 {
+		// snapshots are listed from the most recent
+		err = multistore.PruneSnapshotChanges(snapshots[len(snapshots)-1].Height)
+		if err != nil {
+			return 0, sdkerrors.Wrap(err, "failed to prune snapshot changes")
+		}
+	}
+	return pruned, nil
 }
 
 // Restore begins an async snapshot restoration, mirroring ABCI OfferSnapshot. Chunks must be fed
@@ -266,8 +353,9 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
-	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	// check multistore supported format preemptive, incremental snapshots can only be
+	// restored locally on top of their base
+	if !types.IsFormatSupported(snapshot.Format) || snapshot.Format == types.FormatIncremental {
 		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
 	if snapshot.Height == 0 {
@@ -305,7 +393,7 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 
 // restoreSnapshot do the heavy work of snapshot restoration after preliminary checks on request have passed.
 func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
-	streamReader, err := NewStreamReader(chChunks)
+	streamReader, err := newStreamReader(snapshot.Format, chChunks, m.opts.Workers)
 	if err != nil {
 		return err
 	}
@@ -391,6 +479,39 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	return false, nil
 }
 
+// RestoreLocalSnapshot restores the app state from a snapshot of the local snapshot store,
+// without going through the ABCI state sync. An incremental snapshot is restored on top of the
+// current state, which must be the state at its base height, e.g. restored from the base snapshot.
+func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
+	if m == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "no snapshot store configured")
+	}
+
+	snapshot, chChunks, err := m.store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot at height %v format %v", height, format)
+	}
+	defer DrainChunks(chChunks)
+
+	if !types.IsFormatSupported(snapshot.Format) {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	err = m.beginLocked(opRestore)
+	if err != nil {
+		return err
+	}
+	defer m.endLocked()
+
+	return m.restoreSnapshot(*snapshot, chChunks)
+}
+
 // sortedExtensionNames sort extension names for deterministic iteration.
 func (m *Manager) sortedExtensionNames() []string {
 	names := make([]string, 0, len(m.extensions))
@@ -418,11 +539,14 @@ func (m *Manager) SnapshotIfApplicable(height int64) {
 	if m == nil {
 		return
 	}
-	if !m.shouldTakeSnapshot(height) {
+	switch {
+	case m.shouldTakeSnapshot(height):
+		m.snapshot(height)
+	case m.shouldTakeIncrementalSnapshot(height):
+		m.incrementalSnapshot(height)
+	default:
 		m.logger.Debug("snapshot is skipped", "height", height)
-		return
 	}
-	m.snapshot(height)
 }
 
 // shouldTakeSnapshot returns true is snapshot should be taken at height.
@@ -430,6 +554,11 @@ func (m *Manager) shouldTakeSnapshot(height int64) bool {
 	return m.opts.Interval > 0 && uint64(height)%m.opts.Interval == 0
 }
 
+// shouldTakeIncrementalSnapshot returns true is an incremental snapshot should be taken at height.
+func (m *Manager) shouldTakeIncrementalSnapshot(height int64) bool {
+	return m.opts.IncrementalInterval > 0 && uint64(height)%m.opts.IncrementalInterval == 0
+}
+
 func (m *Manager) snapshot(height int64) {
 	m.logger.Info("creating state snapshot", "height", height)
 
@@ -445,7 +574,35 @@ func (m *Manager) snapshot(height int64) {
 	}
 
 	m.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format)
+	m.prune()
+}
+
+// incrementalSnapshot takes an incremental snapshot on top of the latest snapshot, if any.
+func (m *Manager) incrementalSnapshot(height int64) {
+	latest, err := m.store.GetLatest()
+	if err != nil {
+		m.logger.Error("failed to examine latest snapshot", "err", err)
+		return
+	}
+	if latest == nil {
+		m.logger.Debug("incremental snapshot is skipped, no base snapshot", "height", height)
+		return
+	}
+
+	m.logger.Info("creating incremental state snapshot", "height", height, "base", latest.Height)
+
+	snapshot, err := m.CreateIncremental(latest.Height, uint64(height))
+	if err != nil {
+		m.logger.Error("failed to create incremental state snapshot", "height", height, "err", err)
+		return
+	}
+
+	m.logger.Info("completed incremental state snapshot", "height", height, "format", snapshot.Format)
+	m.prune()
+}
 
+// prune prunes the snapshots according to the keep-recent option, which counts the full snapshots.
+func (m *Manager) prune() {
 	if m.opts.KeepRecent > 0 {
 		m.logger.Debug("pruning state snapshots")
 
@@ -2,14 +2,20 @@ package snapshots_test
 
 import (
 	"errors"
+	"io"
 	"testing"
 
+	protoio "github.com/gogo/protobuf/io"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var opts = types.NewSnapshotOptions(1500, 2)
@@ -68,7 +74,7 @@ func TestManager_Take(t *testing.T) {
 		items:         items,
 		prunedHeights: make(map[int64]struct{}),
 	}
-	expectChunks := snapshotItems(items)
+	expectChunks := compressedSnapshotItems(items)
 	manager := snapshots.NewManager(store, opts, snapshotter, nil, log.NewNopLogger())
 
 	// nil manager should return error
@@ -91,7 +97,7 @@ func TestManager_Take(t *testing.T) {
 		Height: 5,
 		Format: snapshotter.SnapshotFormat(),
 		Chunks: 1,
-		Hash:   []uint8{0xcd, 0x17, 0x9e, 0x7f, 0x28, 0xb6, 0x82, 0x90, 0xc7, 0x25, 0xf3, 0x42, 0xac, 0x65, 0x73, 0x50, 0xaa, 0xa0, 0x10, 0x5c, 0x40, 0x8c, 0xd5, 0x1, 0xed, 0x82, 0xb5, 0xca, 0x8b, 0xe0, 0x83, 0xa2},
+		Hash:   hash(expectChunks),
 		Metadata: types.Metadata{
 			ChunkHashes: checksums(expectChunks),
 		},
@@ -155,13 +161,13 @@ func TestManager_Restore(t *testing.T) {
 	require.ErrorIs(t, err, types.ErrUnknownFormat)
 
 	// Restore errors on no chunks
-	err = manager.Restore(types.Snapshot{Height: 3, Format: types.CurrentFormat, Hash: []byte{1, 2, 3}})
+	err = manager.Restore(types.Snapshot{Height: 3, Format: types.FormatZlibStream, Hash: []byte{1, 2, 3}})
 	require.Error(t, err)
 
 	// Restore errors on chunk and chunkhashes mismatch
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
-		Format:   types.CurrentFormat,
+		Format:   types.FormatZlibStream,
 		Hash:     []byte{1, 2, 3},
 		Chunks:   4,
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
@@ -171,7 +177,7 @@ func TestManager_Restore(t *testing.T) {
 	// Starting a restore works
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
-		Format:   types.CurrentFormat,
+		Format:   types.FormatZlibStream,
 		Hash:     []byte{1, 2, 3},
 		Chunks:   1,
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
@@ -208,7 +214,7 @@ func TestManager_Restore(t *testing.T) {
 	// Starting a new restore should fail now, because the target already has contents.
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
-		Format:   types.CurrentFormat,
+		Format:   types.FormatZlibStream,
 		Hash:     []byte{1, 2, 3},
 		Chunks:   3,
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
@@ -221,10 +227,155 @@ func TestManager_Restore(t *testing.T) {
 	target.items = nil
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
-		Format:   types.CurrentFormat,
+		Format:   types.FormatZlibStream,
 		Hash:     []byte{1, 2, 3},
 		Chunks:   1,
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
 	})
 	require.NoError(t, err)
 }
+
+func TestManager_RestoreLocalSnapshot(t *testing.T) {
+	store := setupStore(t)
+	items := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+	source := &mockSnapshotter{
+		items:         items,
+		prunedHeights: make(map[int64]struct{}),
+	}
+	snapshot, err := snapshots.NewManager(store, opts, source, nil, log.NewNopLogger()).Create(5)
+	require.NoError(t, err)
+
+	target := &mockSnapshotter{
+		prunedHeights: make(map[int64]struct{}),
+	}
+	manager := snapshots.NewManager(store, opts, target, nil, log.NewNopLogger())
+
+	// nil manager should return error
+	err = (*snapshots.Manager)(nil).RestoreLocalSnapshot(snapshot.Height, snapshot.Format)
+	require.Error(t, err)
+
+	// restoring a snapshot which is not in the store should error
+	err = manager.RestoreLocalSnapshot(4, snapshot.Format)
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	err = manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	assert.Equal(t, items, target.items)
+
+	// the restore operation has ended, restoring again fails because the target already has contents
+	err = manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, sdkerrors.ErrConflict)
+}
+
+// newIncrementalMultiStore returns a multistore recording the changes for incremental snapshots.
+func newIncrementalMultiStore() *rootmulti.Store {
+	multistore := rootmulti.NewStore(db.NewMemDB(), log.NewNopLogger())
+	multistore.MountStoreWithDB(storetypes.NewKVStoreKey("store"), storetypes.StoreTypeIAVL, nil)
+	multistore.EnableIncrementalSnapshots()
+	if err := multistore.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+	return multistore
+}
+
+func TestManager_Incremental(t *testing.T) {
+	snapshotStore, err := snapshots.NewStore(db.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	source := newIncrementalMultiStore()
+	kvStore := source.GetKVStore(source.StoreKeysByName()["store"])
+	for i := byte(1); i <= 3; i++ {
+		kvStore.Set([]byte{i}, []byte{i})
+		source.Commit()
+	}
+	manager := snapshots.NewManager(snapshotStore, opts, source, nil, log.NewNopLogger())
+
+	// incremental snapshots are taken on top of an existing snapshot
+	_, err = manager.CreateIncremental(1, 3)
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+	base, err := manager.Create(1)
+	require.NoError(t, err)
+	_, err = manager.CreateIncremental(1, 1)
+	require.ErrorIs(t, err, sdkerrors.ErrConflict)
+	snapshot, err := manager.CreateIncremental(1, 3)
+	require.NoError(t, err)
+	require.Equal(t, types.FormatIncremental, snapshot.Format)
+
+	// incremental snapshots can not be restored by state sync
+	err = manager.Restore(*snapshot)
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+
+	// restore the base snapshot, then the incremental snapshot on top of it
+	target := newIncrementalMultiStore()
+	manager = snapshots.NewManager(snapshotStore, opts, target, nil, log.NewNopLogger())
+	err = manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format)
+	require.ErrorIs(t, err, sdkerrors.ErrLogic)
+	require.NoError(t, manager.RestoreLocalSnapshot(base.Height, base.Format))
+	require.NoError(t, manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+	require.Equal(t, source.LastCommitID(), target.LastCommitID())
+
+	// incremental snapshots are only taken on top of the latest snapshot
+	manager = snapshots.NewManager(snapshotStore, opts, source, nil, log.NewNopLogger())
+	kvStore.Set([]byte{4}, []byte{4})
+	source.Commit()
+	_, err = manager.CreateIncremental(1, 4)
+	require.ErrorIs(t, err, sdkerrors.ErrConflict)
+
+	// the incremental snapshots are kept with the full snapshot they depend on
+	_, err = manager.Prune(1)
+	require.NoError(t, err)
+	require.NoError(t, source.SnapshotIncremental(1, 3, protoio.NewDelimitedWriter(io.Discard)))
+
+	// pruning the snapshots prunes the changes up to the oldest snapshot left
+	_, err = manager.Create(4)
+	require.NoError(t, err)
+	_, err = manager.Prune(1)
+	require.NoError(t, err)
+	list, err := manager.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.EqualValues(t, 4, list[0].Height)
+	err = source.SnapshotIncremental(1, 3, protoio.NewDelimitedWriter(io.Discard))
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+}
+
+func TestManager_PruneIncremental(t *testing.T) {
+	snapshotStore, err := snapshots.NewStore(db.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	source := newIncrementalMultiStore()
+	kvStore := source.GetKVStore(source.StoreKeysByName()["store"])
+	manager := snapshots.NewManager(snapshotStore, opts, source, nil, log.NewNopLogger())
+
+	// a full snapshot followed by two incremental snapshots, each on top of the previous one
+	var chain []*types.Snapshot
+	for i := byte(1); i <= 3; i++ {
+		kvStore.Set([]byte{i}, []byte{i})
+		source.Commit()
+
+		var snapshot *types.Snapshot
+		if i == 1 {
+			snapshot, err = manager.Create(uint64(i))
+		} else {
+			snapshot, err = manager.CreateIncremental(uint64(i-1), uint64(i))
+		}
+		require.NoError(t, err)
+		chain = append(chain, snapshot)
+	}
+
+	// keeping the most recent snapshot keeps the whole chain, as the incremental snapshots
+	// are not counted
+	pruned, err := manager.Prune(1)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, pruned)
+
+	target := newIncrementalMultiStore()
+	manager = snapshots.NewManager(snapshotStore, opts, target, nil, log.NewNopLogger())
+	for _, snapshot := range chain {
+		require.NoError(t, manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+	}
+	require.Equal(t, source.LastCommitID(), target.LastCommitID())
+}
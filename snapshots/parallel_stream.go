@@ -0,0 +1,310 @@
+package snapshots
+
+import (
+	"bytes"
+	"io"
+	"runtime"
+	"sync"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// chunkResult is the result of the compression or decompression of a chunk by a worker.
+type chunkResult struct {
+	data []byte
+	err  error
+}
+
+// ParallelStreamWriter set up a stream pipeline to serialize snapshot items in the
+// FormatCompressedChunks format, compressing the chunks in parallel:
+// Exported Items -> delimited Protobuf -> fixed-size segments -> compression workers -> chan io.ReadCloser
+//
+// The segments are cut at fixed offsets of the item stream and compressed independently, so
+// the chunks only depend on the items and the codec, not on the number of workers.
+type ParallelStreamWriter struct {
+	ch          chan<- io.ReadCloser
+	codec       string
+	protoWriter protoio.WriteCloser
+	segment     []byte
+
+	sem     chan struct{}         // bounds the number of segments held by the workers
+	pending chan chan chunkResult // results of the workers, in the order of the segments
+	done    chan struct{}         // closed once all the chunks have been sent
+	closed  bool
+
+	mtx sync.Mutex
+	err error // first error of the pipeline
+}
+
+// NewParallelStreamWriter set up a stream pipeline compressing the chunks with the given codec
+// over the given number of workers, or the number of CPUs if 0.
+func NewParallelStreamWriter(ch chan<- io.ReadCloser, codec string, workers int) *ParallelStreamWriter {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	sw := &ParallelStreamWriter{
+		ch:      ch,
+		codec:   codec,
+		sem:     make(chan struct{}, workers),
+		pending: make(chan chan chunkResult, workers),
+		done:    make(chan struct{}),
+	}
+	sw.protoWriter = protoio.NewDelimitedWriter(segmentWriter{sw})
+	go sw.emit()
+	return sw
+}
+
+// WriteMsg implements protoio.Write interface
+func (sw *ParallelStreamWriter) WriteMsg(msg proto.Message) error {
+	return sw.protoWriter.WriteMsg(msg)
+}
+
+// Close implements io.Closer interface
+// It flushes the last segment and waits for all the chunks to be sent.
+func (sw *ParallelStreamWriter) Close() error {
+	if sw.closed {
+		return nil
+	}
+	if len(sw.segment) > 0 {
+		sw.flush()
+	}
+	sw.close()
+	return sw.getErr()
+}
+
+// CloseWithError discards the segments not sent yet, and passes the error to the reader of the chunks.
+func (sw *ParallelStreamWriter) CloseWithError(err error) {
+	if sw.closed {
+		return
+	}
+	sw.setErr(err)
+	sw.close()
+}
+
+// close waits for the workers and closes the chunk channel, after sending a chunk failing
+// with the pipeline error if any.
+func (sw *ParallelStreamWriter) close() {
+	sw.closed = true
+	close(sw.pending)
+	<-sw.done
+	if err := sw.getErr(); err != nil {
+		pr, pw := io.Pipe()
+		_ = pw.CloseWithError(err) // CloseWithError always returns nil
+		sw.ch <- pr
+	}
+	close(sw.ch)
+}
+
+// write appends data to the current segment, flushing each complete segment.
+func (sw *ParallelStreamWriter) write(data []byte) (int, error) {
+	if sw.closed {
+		return 0, io.ErrClosedPipe
+	}
+	if err := sw.getErr(); err != nil {
+		return 0, err
+	}
+	n := len(data)
+	for len(data) > 0 {
+		if sw.segment == nil {
+			sw.segment = make([]byte, 0, snapshotChunkSize)
+		}
+		size := int(snapshotChunkSize) - len(sw.segment)
+		if size > len(data) {
+			size = len(data)
+		}
+		sw.segment = append(sw.segment, data[:size]...)
+		data = data[size:]
+		if uint64(len(sw.segment)) == snapshotChunkSize {
+			sw.flush()
+		}
+	}
+	return n, nil
+}
+
+// flush hands the current segment to a worker, blocking while all the workers are busy.
+func (sw *ParallelStreamWriter) flush() {
+	segment := sw.segment
+	sw.segment = nil
+	sw.sem <- struct{}{}
+	result := make(chan chunkResult, 1)
+	sw.pending <- result
+	go func() {
+		chunk, err := compressChunk(sw.codec, segment)
+		result <- chunkResult{data: chunk, err: err}
+	}()
+}
+
+// emit sends the compressed chunks to the chunk channel, in order.
+func (sw *ParallelStreamWriter) emit() {
+	defer close(sw.done)
+	for result := range sw.pending {
+		res := <-result
+		<-sw.sem
+		if res.err != nil {
+			sw.setErr(res.err)
+		}
+		if sw.getErr() != nil {
+			continue
+		}
+		sw.ch <- io.NopCloser(bytes.NewReader(res.data))
+	}
+}
+
+func (sw *ParallelStreamWriter) getErr() error {
+	sw.mtx.Lock()
+	defer sw.mtx.Unlock()
+	return sw.err
+}
+
+func (sw *ParallelStreamWriter) setErr(err error) {
+	sw.mtx.Lock()
+	defer sw.mtx.Unlock()
+	if sw.err == nil {
+		sw.err = err
+	}
+}
+
+// segmentWriter is the io.Writer the delimited Protobuf writer writes to.
+type segmentWriter struct {
+	sw *ParallelStreamWriter
+}
+
+func (w segmentWriter) Write(data []byte) (int, error) {
+	return w.sw.write(data)
+}
+
+// ParallelStreamReader set up a restore stream pipeline for FormatCompressedChunks snapshots,
+// decompressing the chunks in parallel:
+// chan io.ReadCloser -> decompression workers -> segments -> delimited Protobuf -> ExportNode
+type ParallelStreamReader struct {
+	chunks      <-chan io.ReadCloser
+	protoReader protoio.ReadCloser
+	segment     []byte
+	err         error
+
+	sem     chan struct{}         // bounds the number of chunks held by the workers
+	pending chan chan chunkResult // results of the workers, in the order of the chunks
+	quit    chan struct{}         // closed when the reader is closed
+	done    chan struct{}         // closed once all the chunks have been consumed
+	closed  bool
+}
+
+// NewParallelStreamReader set up a restore stream pipeline decompressing the chunks over the
+// given number of workers, or the number of CPUs if 0.
+func NewParallelStreamReader(chunks <-chan io.ReadCloser, workers int) *ParallelStreamReader {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	sr := &ParallelStreamReader{
+		chunks:  chunks,
+		sem:     make(chan struct{}, workers),
+		pending: make(chan chan chunkResult, workers),
+		quit:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	sr.protoReader = protoio.NewDelimitedReader(segmentReader{sr}, snapshotMaxItemSize)
+	go sr.dispatch()
+	return sr
+}
+
+// ReadMsg implements protoio.Reader interface
+func (sr *ParallelStreamReader) ReadMsg(msg proto.Message) error {
+	return sr.protoReader.ReadMsg(msg)
+}
+
+// Close implements io.Closer interface
+// It drains and closes the remaining chunks.
+func (sr *ParallelStreamReader) Close() error {
+	if sr.closed {
+		return nil
+	}
+	sr.closed = true
+	close(sr.quit)
+	for result := range sr.pending {
+		<-result
+		<-sr.sem
+	}
+	<-sr.done
+	return nil
+}
+
+// dispatch hands the chunks to the workers, blocking while all the workers are busy.
+func (sr *ParallelStreamReader) dispatch() {
+	defer close(sr.done)
+	defer close(sr.pending)
+	defer DrainChunks(sr.chunks)
+	for chunk := range sr.chunks {
+		select {
+		case sr.sem <- struct{}{}:
+		case <-sr.quit:
+			_ = chunk.Close()
+			return
+		}
+		result := make(chan chunkResult, 1)
+		sr.pending <- result
+		go func(chunk io.ReadCloser) {
+			result <- decompressChunkReader(chunk)
+		}(chunk)
+	}
+}
+
+// decompressChunkReader reads, closes and decompresses a chunk.
+func decompressChunkReader(chunk io.ReadCloser) chunkResult {
+	bz, err := io.ReadAll(chunk)
+	if closeErr := chunk.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return chunkResult{err: err}
+	}
+	segment, err := decompressChunk(bz)
+	return chunkResult{data: segment, err: err}
+}
+
+// read reads the decompressed segments, in order.
+func (sr *ParallelStreamReader) read(p []byte) (int, error) {
+	for len(sr.segment) == 0 {
+		if sr.err != nil {
+			return 0, sr.err
+		}
+		result, ok := <-sr.pending
+		if !ok {
+			return 0, io.EOF
+		}
+		res := <-result
+		<-sr.sem
+		if res.err != nil {
+			sr.err = res.err
+			return 0, sr.err
+		}
+		sr.segment = res.data
+	}
+	n := copy(p, sr.segment)
+	sr.segment = sr.segment[n:]
+	return n, nil
+}
+
+// segmentReader is the io.Reader the delimited Protobuf reader reads from.
+type segmentReader struct {
+	sr *ParallelStreamReader
+}
+
+func (r segmentReader) Read(p []byte) (int, error) {
+	return r.sr.read(p)
+}
+
+// newStreamReader sets up the restore stream pipeline of the given snapshot format.
+func newStreamReader(format uint32, chunks <-chan io.ReadCloser, workers int) (protoio.ReadCloser, error) {
+	switch format {
+	case types.FormatZlibStream:
+		return NewStreamReader(chunks)
+	case types.FormatCompressedChunks, types.FormatIncremental:
+		return NewParallelStreamReader(chunks, workers), nil
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v", format)
+	}
+}
@@ -0,0 +1,117 @@
+package snapshots_test
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+// writeParallelStream writes the items as SnapshotItem_ExtensionPayload with a ParallelStreamWriter,
+// and returns the chunks.
+func writeParallelStream(items [][]byte, codec string, workers int) [][]byte {
+	ch := make(chan io.ReadCloser)
+	go func() {
+		streamWriter := snapshots.NewParallelStreamWriter(ch, codec, workers)
+		for _, item := range items {
+			if err := snapshottypes.WriteExtensionItem(streamWriter, item); err != nil {
+				streamWriter.CloseWithError(err)
+				return
+			}
+		}
+		_ = streamWriter.Close()
+	}()
+	return readChunks(ch)
+}
+
+// readParallelStream reads the SnapshotItem_ExtensionPayload items of the chunks with a ParallelStreamReader.
+func readParallelStream(chunks [][]byte, workers int) ([][]byte, error) {
+	streamReader := snapshots.NewParallelStreamReader(makeChunks(chunks), workers)
+	defer streamReader.Close()
+
+	var items [][]byte
+	for {
+		item := &snapshottypes.SnapshotItem{}
+		err := streamReader.ReadMsg(item)
+		if err == io.EOF {
+			return items, nil
+		} else if err != nil {
+			return nil, err
+		}
+		items = append(items, item.GetExtensionPayload().Payload)
+	}
+}
+
+func TestParallelStream(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	items := make([][]byte, 5)
+	for i := range items {
+		// the items span multiple chunks
+		items[i] = make([]byte, 4e6)
+		r.Read(items[i][:2e6])
+	}
+
+	for _, codec := range []string{"", snapshottypes.CodecNone, snapshottypes.CodecZlib, snapshottypes.CodecZstd} {
+		t.Run(codec, func(t *testing.T) {
+			chunks := writeParallelStream(items, codec, 1)
+			require.Len(t, chunks, 3)
+
+			// the chunks do not depend on the number of workers
+			require.Equal(t, chunks, writeParallelStream(items, codec, 4))
+
+			for _, workers := range []int{1, 4} {
+				restored, err := readParallelStream(chunks, workers)
+				require.NoError(t, err)
+				require.Equal(t, items, restored)
+			}
+		})
+	}
+
+	// the codec is part of the chunks
+	require.NotEqual(t, writeParallelStream(items, snapshottypes.CodecZlib, 1), writeParallelStream(items, snapshottypes.CodecZstd, 1))
+}
+
+func TestParallelStreamWriter_Error(t *testing.T) {
+	// unsupported codec
+	chunks := make(chan io.ReadCloser)
+	closeErr := make(chan error, 1)
+	go func() {
+		streamWriter := snapshots.NewParallelStreamWriter(chunks, "lzma", 2)
+		_ = snapshottypes.WriteExtensionItem(streamWriter, []byte{1, 2, 3})
+		closeErr <- streamWriter.Close()
+	}()
+	_, err := io.ReadAll(snapshots.NewChunkReader(chunks))
+	require.Error(t, err)
+	require.Error(t, <-closeErr)
+
+	// closed with error
+	chunks = make(chan io.ReadCloser)
+	go func() {
+		streamWriter := snapshots.NewParallelStreamWriter(chunks, snapshottypes.CodecZlib, 2)
+		_ = snapshottypes.WriteExtensionItem(streamWriter, make([]byte, 12e6))
+		streamWriter.CloseWithError(errors.New("failed"))
+		// closing again is a no-op
+		closeErr <- streamWriter.Close()
+	}()
+	_, err = io.ReadAll(snapshots.NewChunkReader(chunks))
+	require.EqualError(t, err, "failed")
+	require.NoError(t, <-closeErr)
+}
+
+func TestParallelStreamReader_Error(t *testing.T) {
+	chunks := writeParallelStream([][]byte{{1, 2, 3}}, snapshottypes.CodecZlib, 1)
+
+	for name, chunk := range map[string][]byte{
+		"empty chunk":   {},
+		"unknown codec": append([]byte{9}, chunks[0][1:]...),
+		"corrupt chunk": append([]byte{chunks[0][0]}, chunks[0][2:]...),
+	} {
+		_, err := readParallelStream([][]byte{chunk}, 2)
+		require.Error(t, err, name)
+	}
+}
@@ -179,7 +179,10 @@ func (s *Store) loadChunkFile(height uint64, format uint32, chunk uint32) (io.Re
 	return os.Open(path)
 }
 
-// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained.
+// Prune removes old snapshots. The given number of most recent heights holding a full snapshot,
+// i.e. of any format but FormatIncremental, are retained. As every incremental snapshot is taken
+// on top of the snapshot preceding it, the incremental snapshots more recent than the oldest
+// retained full snapshot are retained too, so that their chain can still be restored.
 func (s *Store) Prune(retain uint32) (uint64, error) {
 	iter, err := s.db.ReverseIterator(encodeKey(0, 0), encodeKey(uint64(math.MaxUint64), math.MaxUint32))
 	if err != nil {
@@ -190,13 +193,17 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 	pruned := uint64(0)
 	prunedHeights := make(map[uint64]bool)
 	skip := make(map[uint64]bool)
+	retained := make(map[uint64]bool)
 	for ; iter.Valid(); iter.Next() {
 		height, format, err := decodeKey(iter.Key())
 		if err != nil {
 			return 0, sdkerrors.Wrap(err, "failed to prune snapshots")
 		}
-		if skip[height] || uint32(len(skip)) < retain {
+		if skip[height] || uint32(len(retained)) < retain {
 			skip[height] = true
+			if format != types.FormatIncremental {
+				retained[height] = true
+			}
 			continue
 		}
 		err = s.Delete(height, format)
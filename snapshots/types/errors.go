@@ -16,4 +16,7 @@ var (
 
 	// ErrInvalidSnapshotVersion is returned when the snapshot version is invalid
 	ErrInvalidSnapshotVersion = errors.New("invalid snapshot version")
+
+	// ErrInvalidChunk is returned when a snapshot chunk cannot be decompressed.
+	ErrInvalidChunk = errors.New("invalid snapshot chunk")
 )
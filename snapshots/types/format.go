@@ -1,6 +1,28 @@
 package types
 
-// CurrentFormat is the currently used format for snapshots. Snapshots using the same format
-// must be identical across all nodes for a given height, so this must be bumped when the binary
-// snapshot output changes.
-const CurrentFormat uint32 = 2
+const (
+	// FormatZlibStream is the format of snapshots whose item stream is compressed as a single
+	// zlib stream, which is then split into chunks.
+	FormatZlibStream uint32 = 2
+
+	// FormatCompressedChunks is the format of snapshots whose item stream is split into
+	// fixed-size segments, each compressed independently into a chunk prefixed with the
+	// compression codec, which allows chunks to be compressed and decompressed in parallel.
+	FormatCompressedChunks uint32 = 3
+
+	// FormatIncremental is the format of incremental snapshots, holding the changes of the state
+	// since the height of a previous snapshot, in the chunks of FormatCompressedChunks. They are
+	// restored locally on top of the state at that height, and not offered to state sync.
+	FormatIncremental uint32 = 4
+)
+
+// CurrentFormat is the currently used format for snapshots, which must be bumped when the binary
+// snapshot output changes. The compression codec of the chunks is chosen by each node through
+// SnapshotOptions.Codec and isn't part of the format, so snapshots of the same format and height
+// are only identical across the nodes using the same codec.
+const CurrentFormat = FormatCompressedChunks
+
+// IsFormatSupported returns whether snapshots of the given format can be restored.
+func IsFormatSupported(format uint32) bool {
+	return format == FormatZlibStream || format == FormatCompressedChunks || format == FormatIncremental
+}
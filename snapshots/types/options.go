@@ -1,22 +1,57 @@
 package types
 
+import "fmt"
+
 // SnapshotOptions defines the snapshot strategy used when determining which
 // heights are snapshotted for state sync.
 type SnapshotOptions struct {
 	// Interval defines at which heights the snapshot is taken.
 	Interval uint64
 
-	// KeepRecent defines how many snapshots to keep in heights.
+	// KeepRecent defines how many snapshots to keep in heights. The incremental snapshots
+	// are not counted, and are kept as long as the full snapshot they depend on.
 	KeepRecent uint32
+
+	// Codec defines the compression codec of the snapshot chunks, CodecZlib if empty.
+	// Snapshots taken with different codecs have different hashes, so the nodes of a
+	// network should use the same codec for their snapshots to be shared.
+	Codec string
+
+	// Workers defines the number of workers compressing the snapshot chunks, the number
+	// of CPUs if 0.
+	Workers int
+
+	// IncrementalInterval defines at which heights an incremental snapshot is taken, holding the
+	// changes of the state since the latest snapshot. Heights at which a full snapshot is taken
+	// are skipped. Incremental snapshots are disabled if 0.
+	IncrementalInterval uint64
 }
 
 // SnapshotIntervalOff represents the snapshot interval, at which
 // no snapshots are taken.
 const SnapshotIntervalOff uint64 = 0
 
+// Compression codecs of the snapshot chunks.
+const (
+	CodecNone = "none"
+	CodecZlib = "zlib"
+	CodecZstd = "zstd"
+)
+
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
 	return SnapshotOptions{
 		Interval:   interval,
 		KeepRecent: keepRecent,
 	}
 }
+
+// ValidateCodec returns an error if the codec is not a supported compression codec.
+// The empty codec stands for the default one.
+func ValidateCodec(codec string) error {
+	switch codec {
+	case "", CodecNone, CodecZlib, CodecZstd:
+		return nil
+	default:
+		return fmt.Errorf("unsupported snapshot codec %q, expected one of %s, %s or %s", codec, CodecNone, CodecZlib, CodecZstd)
+	}
+}
@@ -158,6 +158,9 @@ type SnapshotItem struct {
 	//	*SnapshotItem_ExtensionPayload
 	//	*SnapshotItem_KV
 	//	*SnapshotItem_Schema
+	//	*SnapshotItem_Base
+	//	*SnapshotItem_Height
+	//	*SnapshotItem_KVChange
 	Item isSnapshotItem_Item `protobuf_oneof:"item"`
 }
 
@@ -218,6 +221,15 @@ type SnapshotItem_KV struct {
 type SnapshotItem_Schema struct {
 	Schema *SnapshotSchema `protobuf:"bytes,6,opt,name=schema,proto3,oneof" json:"schema,omitempty"`
 }
+type SnapshotItem_Base struct {
+	Base *SnapshotBaseItem `protobuf:"bytes,7,opt,name=base,proto3,oneof" json:"base,omitempty"`
+}
+type SnapshotItem_Height struct {
+	Height *SnapshotHeightItem `protobuf:"bytes,8,opt,name=height,proto3,oneof" json:"height,omitempty"`
+}
+type SnapshotItem_KVChange struct {
+	KVChange *SnapshotKVChangeItem `protobuf:"bytes,9,opt,name=kv_change,json=kvChange,proto3,oneof" json:"kv_change,omitempty"`
+}
 
 func (*SnapshotItem_Store) isSnapshotItem_Item()            {}
 func (*SnapshotItem_IAVL) isSnapshotItem_Item()             {}
@@ -225,6 +237,9 @@ func (*SnapshotItem_Extension) isSnapshotItem_Item()        {}
 func (*SnapshotItem_ExtensionPayload) isSnapshotItem_Item() {}
 func (*SnapshotItem_KV) isSnapshotItem_Item()               {}
 func (*SnapshotItem_Schema) isSnapshotItem_Item()           {}
+func (*SnapshotItem_Base) isSnapshotItem_Item()             {}
+func (*SnapshotItem_Height) isSnapshotItem_Item()           {}
+func (*SnapshotItem_KVChange) isSnapshotItem_Item()         {}
 
 func (m *SnapshotItem) GetItem() isSnapshotItem_Item {
 	if m != nil {
@@ -275,6 +290,27 @@ func (m *SnapshotItem) GetSchema() *SnapshotSchema {
 	return nil
 }
 
+func (m *SnapshotItem) GetBase() *SnapshotBaseItem {
+	if x, ok := m.GetItem().(*SnapshotItem_Base); ok {
+		return x.Base
+	}
+	return nil
+}
+
+func (m *SnapshotItem) GetHeight() *SnapshotHeightItem {
+	if x, ok := m.GetItem().(*SnapshotItem_Height); ok {
+		return x.Height
+	}
+	return nil
+}
+
+func (m *SnapshotItem) GetKVChange() *SnapshotKVChangeItem {
+	if x, ok := m.GetItem().(*SnapshotItem_KVChange); ok {
+		return x.KVChange
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -284,6 +320,9 @@ func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 		(*SnapshotItem_ExtensionPayload)(nil),
 		(*SnapshotItem_KV)(nil),
 		(*SnapshotItem_Schema)(nil),
+		(*SnapshotItem_Base)(nil),
+		(*SnapshotItem_Height)(nil),
+		(*SnapshotItem_KVChange)(nil),
 	}
 }
 
@@ -611,6 +650,184 @@ func (m *SnapshotSchema) GetKeys() [][]byte {
 	return nil
 }
 
+// SnapshotBaseItem is the first item of an incremental snapshot, whose changes apply on top
+// of the state at the base height.
+//
+// Since: cosmos-sdk 0.47
+type SnapshotBaseItem struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// app_hash is the app hash of the state at the base height.
+	AppHash []byte `protobuf:"bytes,2,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"`
+}
+
+func (m *SnapshotBaseItem) Reset()         { *m = SnapshotBaseItem{} }
+func (m *SnapshotBaseItem) String() string { return proto.CompactTextString(m) }
+func (*SnapshotBaseItem) ProtoMessage()    {}
+func (*SnapshotBaseItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{9}
+}
+func (m *SnapshotBaseItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotBaseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotBaseItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotBaseItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotBaseItem.Merge(m, src)
+}
+func (m *SnapshotBaseItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotBaseItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotBaseItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotBaseItem proto.InternalMessageInfo
+
+func (m *SnapshotBaseItem) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SnapshotBaseItem) GetAppHash() []byte {
+	if m != nil {
+		return m.AppHash
+	}
+	return nil
+}
+
+// SnapshotHeightItem starts the changes of a height of an incremental snapshot, which follow
+// as SnapshotStoreItem and SnapshotKVChangeItem items.
+//
+// Since: cosmos-sdk 0.47
+type SnapshotHeightItem struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// app_hash is the app hash of the state once the changes of the height are committed.
+	AppHash []byte `protobuf:"bytes,2,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"`
+}
+
+func (m *SnapshotHeightItem) Reset()         { *m = SnapshotHeightItem{} }
+func (m *SnapshotHeightItem) String() string { return proto.CompactTextString(m) }
+func (*SnapshotHeightItem) ProtoMessage()    {}
+func (*SnapshotHeightItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{10}
+}
+func (m *SnapshotHeightItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotHeightItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotHeightItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotHeightItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotHeightItem.Merge(m, src)
+}
+func (m *SnapshotHeightItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotHeightItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotHeightItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotHeightItem proto.InternalMessageInfo
+
+func (m *SnapshotHeightItem) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SnapshotHeightItem) GetAppHash() []byte {
+	if m != nil {
+		return m.AppHash
+	}
+	return nil
+}
+
+// SnapshotKVChangeItem is a key/value pair written to, or deleted from, the current store of
+// an incremental snapshot.
+//
+// Since: cosmos-sdk 0.47
+type SnapshotKVChangeItem struct {
+	Key    []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value  []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Delete bool   `protobuf:"varint,3,opt,name=delete,proto3" json:"delete,omitempty"`
+}
+
+func (m *SnapshotKVChangeItem) Reset()         { *m = SnapshotKVChangeItem{} }
+func (m *SnapshotKVChangeItem) String() string { return proto.CompactTextString(m) }
+func (*SnapshotKVChangeItem) ProtoMessage()    {}
+func (*SnapshotKVChangeItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{11}
+}
+func (m *SnapshotKVChangeItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotKVChangeItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotKVChangeItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotKVChangeItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotKVChangeItem.Merge(m, src)
+}
+func (m *SnapshotKVChangeItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotKVChangeItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotKVChangeItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotKVChangeItem proto.InternalMessageInfo
+
+func (m *SnapshotKVChangeItem) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *SnapshotKVChangeItem) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *SnapshotKVChangeItem) GetDelete() bool {
+	if m != nil {
+		return m.Delete
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Snapshot)(nil), "cosmos.base.snapshots.v1beta1.Snapshot")
 	proto.RegisterType((*Metadata)(nil), "cosmos.base.snapshots.v1beta1.Metadata")
@@ -621,6 +838,9 @@ func init() {
 	proto.RegisterType((*SnapshotExtensionPayload)(nil), "cosmos.base.snapshots.v1beta1.SnapshotExtensionPayload")
 	proto.RegisterType((*SnapshotKVItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotKVItem")
 	proto.RegisterType((*SnapshotSchema)(nil), "cosmos.base.snapshots.v1beta1.SnapshotSchema")
+	proto.RegisterType((*SnapshotBaseItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotBaseItem")
+	proto.RegisterType((*SnapshotHeightItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotHeightItem")
+	proto.RegisterType((*SnapshotKVChangeItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotKVChangeItem")
 }
 
 func init() {
@@ -628,44 +848,51 @@ func init() {
 }
 
 var fileDescriptor_dd7a3c9b0a19e1ee = []byte{
-	// 581 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xb5, 0x13, 0xc7, 0xa4, 0x63, 0x83, 0xda, 0x55, 0x41, 0x16, 0x12, 0x6e, 0xb0, 0x90, 0x9a,
-	0x43, 0x6b, 0xd3, 0x50, 0x09, 0xae, 0x04, 0x41, 0x5d, 0x15, 0x04, 0xda, 0xa0, 0x1c, 0xb8, 0x54,
-	0x9b, 0x64, 0x1b, 0x47, 0x8e, 0xb3, 0x51, 0x76, 0x63, 0x91, 0x23, 0x7f, 0xc0, 0xaf, 0xf0, 0x17,
-	0x3d, 0xf6, 0xc8, 0x29, 0x42, 0xc9, 0x8f, 0xa0, 0x5d, 0xdb, 0x69, 0x29, 0x2d, 0xa4, 0xa7, 0xcc,
-	0x4c, 0xde, 0x7b, 0x9e, 0x9d, 0xb7, 0xb3, 0xb0, 0xd7, 0x65, 0x3c, 0x61, 0x3c, 0xe8, 0x10, 0x4e,
-	0x03, 0x3e, 0x22, 0x63, 0x1e, 0x31, 0xc1, 0x83, 0xf4, 0xa0, 0x43, 0x05, 0x39, 0x58, 0x55, 0xfc,
-	0xf1, 0x84, 0x09, 0x86, 0x9e, 0x64, 0x68, 0x5f, 0xa2, 0xfd, 0x15, 0xda, 0xcf, 0xd1, 0x8f, 0xb7,
-	0xfb, 0xac, 0xcf, 0x14, 0x32, 0x90, 0x51, 0x46, 0xf2, 0x7e, 0xe8, 0x50, 0x6d, 0xe5, 0x58, 0xf4,
-	0x08, 0xcc, 0x88, 0x0e, 0xfa, 0x91, 0x70, 0xf4, 0x9a, 0x5e, 0x37, 0x70, 0x9e, 0xc9, 0xfa, 0x19,
-	0x9b, 0x24, 0x44, 0x38, 0xa5, 0x9a, 0x5e, 0xbf, 0x8f, 0xf3, 0x4c, 0xd6, 0xbb, 0xd1, 0x74, 0x14,
-	0x73, 0xa7, 0x9c, 0xd5, 0xb3, 0x0c, 0x21, 0x30, 0x22, 0xc2, 0x23, 0xc7, 0xa8, 0xe9, 0x75, 0x1b,
-	0xab, 0x18, 0x1d, 0x43, 0x35, 0xa1, 0x82, 0xf4, 0x88, 0x20, 0x4e, 0xa5, 0xa6, 0xd7, 0xad, 0xc6,
-	0xae, 0xff, 0xcf, 0x86, 0xfd, 0x0f, 0x39, 0xbc, 0x69, 0x9c, 0xcf, 0x77, 0x34, 0xbc, 0xa2, 0x7b,
-	0xfb, 0x50, 0x2d, 0xfe, 0x43, 0x4f, 0xc1, 0x56, 0x1f, 0x3d, 0x95, 0x1f, 0xa1, 0xdc, 0xd1, 0x6b,
-	0xe5, 0xba, 0x8d, 0x2d, 0x55, 0x0b, 0x55, 0xc9, 0xfb, 0x66, 0x80, 0x5d, 0x1c, 0xf1, 0x58, 0xd0,
-	0x04, 0x85, 0x50, 0xe1, 0x82, 0x4d, 0xa8, 0x3a, 0xa5, 0xd5, 0x78, 0xfe, 0x9f, 0x3e, 0x0a, 0x6e,
-	0x4b, 0x72, 0xa4, 0x40, 0xa8, 0xe1, 0x4c, 0x00, 0x7d, 0x04, 0x63, 0x40, 0xd2, 0xa1, 0x1a, 0x8b,
-	0xd5, 0x08, 0xd6, 0x14, 0x3a, 0x7e, 0xdd, 0x7e, 0x2f, 0x75, 0x9a, 0xd5, 0xc5, 0x7c, 0xc7, 0x90,
-	0x59, 0xa8, 0x61, 0x25, 0x84, 0x3e, 0xc3, 0x06, 0xfd, 0x2a, 0xe8, 0x88, 0x0f, 0xd8, 0x48, 0x0d,
-	0xd5, 0x6a, 0x1c, 0xae, 0xa9, 0xfa, 0xb6, 0xe0, 0xc9, 0xd9, 0x84, 0x1a, 0xbe, 0x14, 0x42, 0x67,
-	0xb0, 0xb5, 0x4a, 0x4e, 0xc7, 0x64, 0x36, 0x64, 0xa4, 0xa7, 0xcc, 0xb1, 0x1a, 0x2f, 0xef, 0xaa,
-	0xfe, 0x29, 0xa3, 0x87, 0x1a, 0xde, 0xa4, 0xd7, 0x6a, 0xe8, 0x08, 0x4a, 0x71, 0x9a, 0xbb, 0xbb,
-	0xbf, 0xa6, 0xf0, 0x49, 0x5b, 0x8d, 0xc2, 0x5c, 0xcc, 0x77, 0x4a, 0x27, 0xed, 0x50, 0xc3, 0xa5,
-	0x38, 0x45, 0x47, 0x60, 0xf2, 0x6e, 0x44, 0x13, 0xe2, 0x98, 0x77, 0x12, 0x6b, 0x29, 0x52, 0xa8,
-	0xe1, 0x9c, 0xde, 0x34, 0xc1, 0x18, 0x08, 0x9a, 0x78, 0xbb, 0xb0, 0xf5, 0x97, 0x8d, 0xf2, 0x9a,
-	0x8e, 0x48, 0x92, 0x5d, 0x83, 0x0d, 0xac, 0x62, 0x6f, 0x08, 0x9b, 0xd7, 0x6d, 0x42, 0x9b, 0x50,
-	0x8e, 0xe9, 0x4c, 0xc1, 0x6c, 0x2c, 0x43, 0xb4, 0x0d, 0x95, 0x94, 0x0c, 0xa7, 0x54, 0x19, 0x6f,
-	0xe3, 0x2c, 0x41, 0x0e, 0xdc, 0x4b, 0xe9, 0x64, 0x65, 0x5d, 0x19, 0x17, 0xe9, 0x95, 0xc5, 0x92,
-	0x53, 0xaf, 0x14, 0x8b, 0xe5, 0xbd, 0x81, 0x87, 0x37, 0xda, 0x77, 0x53, 0x6b, 0xb7, 0x6d, 0xa1,
-	0x77, 0x08, 0xce, 0x6d, 0x2e, 0xc9, 0x96, 0x0a, 0xbf, 0xb3, 0xf6, 0x8b, 0xd4, 0x7b, 0x05, 0x0f,
-	0xfe, 0xb4, 0x60, 0xdd, 0x63, 0x7a, 0xcf, 0x2e, 0x99, 0xd9, 0xbc, 0x65, 0xb7, 0x31, 0x9d, 0x15,
-	0xcb, 0xa7, 0xe2, 0xe6, 0xbb, 0xf3, 0x85, 0xab, 0x5f, 0x2c, 0x5c, 0xfd, 0xd7, 0xc2, 0xd5, 0xbf,
-	0x2f, 0x5d, 0xed, 0x62, 0xe9, 0x6a, 0x3f, 0x97, 0xae, 0xf6, 0x65, 0xaf, 0x3f, 0x10, 0xd1, 0xb4,
-	0xe3, 0x77, 0x59, 0x12, 0xe4, 0x0f, 0x5c, 0xf6, 0xb3, 0xcf, 0x7b, 0xf1, 0x95, 0x67, 0x4e, 0xcc,
-	0xc6, 0x94, 0x77, 0x4c, 0xf5, 0x4e, 0xbd, 0xf8, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x70, 0xb0, 0xd0,
-	0xad, 0x0c, 0x05, 0x00, 0x00,
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x6e, 0xda, 0x4c,
+	0x10, 0xb7, 0xc1, 0x38, 0x66, 0xf0, 0x17, 0x91, 0x55, 0xbe, 0xc8, 0xdf, 0x27, 0x15, 0xa8, 0x55,
+	0x29, 0x1c, 0x12, 0xd3, 0x90, 0x48, 0xed, 0xb5, 0x44, 0x69, 0x1c, 0xa5, 0x55, 0xab, 0x4d, 0xc5,
+	0xa1, 0x97, 0x74, 0x81, 0x0d, 0x46, 0xc6, 0xd8, 0x62, 0x37, 0x56, 0x79, 0x8b, 0xbe, 0x4a, 0xdf,
+	0x22, 0xc7, 0x1c, 0x7b, 0x42, 0x15, 0x79, 0x88, 0x5e, 0xab, 0x5d, 0xdb, 0x34, 0x7f, 0x5b, 0xa7,
+	0x27, 0x66, 0x86, 0xf9, 0xfd, 0x3c, 0x3b, 0xf3, 0xdb, 0x59, 0xd8, 0xea, 0x87, 0x2c, 0x08, 0x59,
+	0xab, 0x47, 0x18, 0x6d, 0xb1, 0x09, 0x89, 0x98, 0x17, 0x72, 0xd6, 0x8a, 0x77, 0x7a, 0x94, 0x93,
+	0x9d, 0x65, 0xc4, 0x89, 0xa6, 0x21, 0x0f, 0xd1, 0x93, 0x24, 0xdb, 0x11, 0xd9, 0xce, 0x32, 0xdb,
+	0x49, 0xb3, 0xff, 0x5f, 0x1f, 0x86, 0xc3, 0x50, 0x66, 0xb6, 0x84, 0x95, 0x80, 0xec, 0xaf, 0x2a,
+	0x18, 0x27, 0x69, 0x2e, 0xda, 0x00, 0xdd, 0xa3, 0xa3, 0xa1, 0xc7, 0x2d, 0xb5, 0xa1, 0x36, 0x35,
+	0x9c, 0x7a, 0x22, 0x7e, 0x16, 0x4e, 0x03, 0xc2, 0xad, 0x42, 0x43, 0x6d, 0xfe, 0x83, 0x53, 0x4f,
+	0xc4, 0xfb, 0xde, 0xf9, 0xc4, 0x67, 0x56, 0x31, 0x89, 0x27, 0x1e, 0x42, 0xa0, 0x79, 0x84, 0x79,
+	0x96, 0xd6, 0x50, 0x9b, 0x26, 0x96, 0x36, 0x3a, 0x02, 0x23, 0xa0, 0x9c, 0x0c, 0x08, 0x27, 0x56,
+	0xa9, 0xa1, 0x36, 0x2b, 0xed, 0x4d, 0xe7, 0xb7, 0x05, 0x3b, 0x6f, 0xd3, 0xf4, 0x8e, 0x76, 0x31,
+	0xaf, 0x2b, 0x78, 0x09, 0xb7, 0xb7, 0xc1, 0xc8, 0xfe, 0x43, 0x4f, 0xc1, 0x94, 0x1f, 0x3d, 0x15,
+	0x1f, 0xa1, 0xcc, 0x52, 0x1b, 0xc5, 0xa6, 0x89, 0x2b, 0x32, 0xe6, 0xca, 0x90, 0xfd, 0xa3, 0x04,
+	0x66, 0x76, 0xc4, 0x23, 0x4e, 0x03, 0xe4, 0x42, 0x89, 0xf1, 0x70, 0x4a, 0xe5, 0x29, 0x2b, 0xed,
+	0xe7, 0x7f, 0xa8, 0x23, 0xc3, 0x9e, 0x08, 0x8c, 0x20, 0x70, 0x15, 0x9c, 0x10, 0xa0, 0x77, 0xa0,
+	0x8d, 0x48, 0x3c, 0x96, 0x6d, 0xa9, 0xb4, 0x5b, 0x39, 0x89, 0x8e, 0x5e, 0x75, 0xdf, 0x08, 0x9e,
+	0x8e, 0xb1, 0x98, 0xd7, 0x35, 0xe1, 0xb9, 0x0a, 0x96, 0x44, 0xe8, 0x03, 0x94, 0xe9, 0x67, 0x4e,
+	0x27, 0x6c, 0x14, 0x4e, 0x64, 0x53, 0x2b, 0xed, 0xbd, 0x9c, 0xac, 0x07, 0x19, 0x4e, 0xf4, 0xc6,
+	0x55, 0xf0, 0x2f, 0x22, 0x74, 0x06, 0x6b, 0x4b, 0xe7, 0x34, 0x22, 0xb3, 0x71, 0x48, 0x06, 0x72,
+	0x38, 0x95, 0xf6, 0x8b, 0xc7, 0xb2, 0xbf, 0x4f, 0xe0, 0xae, 0x82, 0xab, 0xf4, 0x56, 0x0c, 0x1d,
+	0x42, 0xc1, 0x8f, 0xd3, 0xe9, 0x6e, 0xe7, 0x24, 0x3e, 0xee, 0xca, 0x56, 0xe8, 0x8b, 0x79, 0xbd,
+	0x70, 0xdc, 0x75, 0x15, 0x5c, 0xf0, 0x63, 0x74, 0x08, 0x3a, 0xeb, 0x7b, 0x34, 0x20, 0x96, 0xfe,
+	0x28, 0xb2, 0x13, 0x09, 0x72, 0x15, 0x9c, 0xc2, 0xd1, 0x01, 0x68, 0x02, 0x62, 0xad, 0x3c, 0x6a,
+	0x40, 0x1d, 0xc2, 0xb2, 0x41, 0x4b, 0x38, 0x3a, 0x5e, 0x5e, 0x0c, 0x43, 0x12, 0xed, 0xe4, 0x24,
+	0x72, 0x25, 0x28, 0xa5, 0xca, 0x6e, 0xd3, 0x27, 0x28, 0xfb, 0xf1, 0x69, 0xdf, 0x23, 0x93, 0x21,
+	0xb5, 0xca, 0x92, 0x6f, 0x37, 0x77, 0xb3, 0xf6, 0x25, 0x4c, 0xb6, 0xcc, 0x5c, 0xcc, 0xeb, 0x46,
+	0x16, 0x71, 0x15, 0x6c, 0xf8, 0x71, 0x62, 0x77, 0x74, 0xd0, 0x46, 0x9c, 0x06, 0xf6, 0x26, 0xac,
+	0xdd, 0x11, 0xaf, 0xb8, 0x9c, 0x13, 0x12, 0x24, 0xe2, 0x2f, 0x63, 0x69, 0xdb, 0x63, 0xa8, 0xde,
+	0x16, 0x27, 0xaa, 0x42, 0xd1, 0xa7, 0x33, 0x99, 0x66, 0x62, 0x61, 0xa2, 0x75, 0x28, 0xc5, 0x64,
+	0x7c, 0x4e, 0xa5, 0xdc, 0x4d, 0x9c, 0x38, 0xc8, 0x82, 0x95, 0x98, 0x4e, 0x97, 0x82, 0x2d, 0xe2,
+	0xcc, 0xbd, 0xb6, 0x4e, 0x84, 0xd6, 0x4a, 0x59, 0x03, 0xec, 0x7d, 0xf8, 0xf7, 0x5e, 0xd1, 0xde,
+	0x57, 0xda, 0x43, 0xbb, 0xc7, 0xde, 0x03, 0xeb, 0x21, 0x6d, 0x8a, 0x92, 0x32, 0x95, 0x27, 0xe5,
+	0x67, 0xae, 0xfd, 0x12, 0x56, 0x6f, 0x0a, 0x2f, 0xef, 0x31, 0xed, 0x67, 0xb0, 0x7a, 0x53, 0x65,
+	0xa2, 0x5a, 0x9f, 0xce, 0xb2, 0x95, 0x23, 0x6d, 0xfb, 0x00, 0xaa, 0xb7, 0x45, 0xf4, 0xe0, 0x56,
+	0xfd, 0x0f, 0x0c, 0x12, 0x45, 0x72, 0x71, 0xa5, 0x9f, 0x5a, 0x21, 0x51, 0x24, 0x96, 0x96, 0x7d,
+	0x08, 0xe8, 0xae, 0x84, 0xfe, 0x86, 0xa8, 0x0b, 0xeb, 0xf7, 0x69, 0x27, 0xf7, 0x70, 0x37, 0x40,
+	0x1f, 0xd0, 0x31, 0xe5, 0x54, 0xce, 0xd6, 0xc0, 0xa9, 0xd7, 0x79, 0x7d, 0xb1, 0xa8, 0xa9, 0x97,
+	0x8b, 0x9a, 0xfa, 0x7d, 0x51, 0x53, 0xbf, 0x5c, 0xd5, 0x94, 0xcb, 0xab, 0x9a, 0xf2, 0xed, 0xaa,
+	0xa6, 0x7c, 0xdc, 0x1a, 0x8e, 0xb8, 0x77, 0xde, 0x73, 0xfa, 0x61, 0xd0, 0x4a, 0x9f, 0xaf, 0xe4,
+	0x67, 0x9b, 0x0d, 0xfc, 0x6b, 0x8f, 0x18, 0x9f, 0x45, 0x94, 0xf5, 0x74, 0xf9, 0x0a, 0xed, 0xfe,
+	0x1c, 0x00, 0x68, 0x5c, 0x65, 0x7f, 0xea, 0x06, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -913,6 +1140,69 @@ func (m *SnapshotItem_Schema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *SnapshotItem_Base) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotItem_Base) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Base != nil {
+		{
+			size, err := m.Base.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSnapshot(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SnapshotItem_Height) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotItem_Height) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Height != nil {
+		{
+			size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSnapshot(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SnapshotItem_KVChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotItem_KVChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.KVChange != nil {
+		{
+			size, err := m.KVChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSnapshot(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *SnapshotStoreItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1124,79 +1414,196 @@ func (m *SnapshotSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSnapshot(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSnapshot(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SnapshotBaseItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Snapshot) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SnapshotBaseItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotBaseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovSnapshot(uint64(m.Height))
-	}
-	if m.Format != 0 {
-		n += 1 + sovSnapshot(uint64(m.Format))
-	}
-	if m.Chunks != 0 {
-		n += 1 + sovSnapshot(uint64(m.Chunks))
+	if len(m.AppHash) > 0 {
+		i -= len(m.AppHash)
+		copy(dAtA[i:], m.AppHash)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.AppHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovSnapshot(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovSnapshot(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Metadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ChunkHashes) > 0 {
-		for _, b := range m.ChunkHashes {
-			l = len(b)
-			n += 1 + l + sovSnapshot(uint64(l))
-		}
+func (m *SnapshotHeightItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SnapshotItem) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Item != nil {
-		n += m.Item.Size()
-	}
-	return n
+func (m *SnapshotHeightItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SnapshotItem_Store) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SnapshotHeightItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Store != nil {
-		l = m.Store.Size()
-		n += 1 + l + sovSnapshot(uint64(l))
+	if len(m.AppHash) > 0 {
+		i -= len(m.AppHash)
+		copy(dAtA[i:], m.AppHash)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.AppHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Height != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotKVChangeItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotKVChangeItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotKVChangeItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Delete {
+		i--
+		if m.Delete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSnapshot(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSnapshot(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Snapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovSnapshot(uint64(m.Height))
+	}
+	if m.Format != 0 {
+		n += 1 + sovSnapshot(uint64(m.Format))
+	}
+	if m.Chunks != 0 {
+		n += 1 + sovSnapshot(uint64(m.Chunks))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovSnapshot(uint64(l))
+	return n
+}
+
+func (m *Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChunkHashes) > 0 {
+		for _, b := range m.ChunkHashes {
+			l = len(b)
+			n += 1 + l + sovSnapshot(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SnapshotItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Item != nil {
+		n += m.Item.Size()
+	}
+	return n
+}
+
+func (m *SnapshotItem_Store) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Store != nil {
+		l = m.Store.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
 }
 func (m *SnapshotItem_IAVL) Size() (n int) {
 	if m == nil {
@@ -1258,6 +1665,42 @@ func (m *SnapshotItem_Schema) Size() (n int) {
 	}
 	return n
 }
+func (m *SnapshotItem_Base) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Base != nil {
+		l = m.Base.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
+func (m *SnapshotItem_Height) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != nil {
+		l = m.Height.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
+func (m *SnapshotItem_KVChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KVChange != nil {
+		l = m.KVChange.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
 func (m *SnapshotStoreItem) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1355,6 +1798,58 @@ func (m *SnapshotSchema) Size() (n int) {
 	return n
 }
 
+func (m *SnapshotBaseItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovSnapshot(uint64(m.Height))
+	}
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
+
+func (m *SnapshotHeightItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovSnapshot(uint64(m.Height))
+	}
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
+
+func (m *SnapshotKVChangeItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if m.Delete {
+		n += 2
+	}
+	return n
+}
+
 func sovSnapshot(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1856,61 +2351,11 @@ func (m *SnapshotItem) Unmarshal(dAtA []byte) error {
 			}
 			m.Item = &SnapshotItem_Schema{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSnapshot(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSnapshot
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SnapshotStoreItem) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSnapshot
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SnapshotStoreItem: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SnapshotStoreItem: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSnapshot
@@ -1920,25 +2365,180 @@ func (m *SnapshotStoreItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSnapshot
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSnapshot
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
+			v := &SnapshotBaseItem{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &SnapshotItem_Base{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SnapshotHeightItem{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &SnapshotItem_Height{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KVChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SnapshotKVChangeItem{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &SnapshotItem_KVChange{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotStoreItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotStoreItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotStoreItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
 			if err != nil {
@@ -2500,6 +3100,350 @@ func (m *SnapshotSchema) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SnapshotBaseItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotBaseItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotBaseItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotHeightItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotHeightItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotHeightItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotKVChangeItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotKVChangeItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotKVChangeItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delete = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSnapshot(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
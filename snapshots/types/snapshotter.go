@@ -28,6 +28,25 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// IncrementalSnapshotter is a Snapshotter which can also take incremental snapshots, holding the
+// changes of the state between the height of a previous snapshot and the snapshot height.
+// Incremental snapshots are restored with the FormatIncremental format, on top of the state at
+// the base height.
+type IncrementalSnapshotter interface {
+	Snapshotter
+
+	// EnableIncrementalSnapshots makes the snapshotter record the changes of the state at each
+	// commit, which incremental snapshots are made of.
+	EnableIncrementalSnapshots()
+
+	// SnapshotIncremental writes the changes of the state of the heights after baseHeight,
+	// up to height, into the protobuf writer.
+	SnapshotIncremental(baseHeight, height uint64, protoWriter protoio.Writer) error
+
+	// PruneSnapshotChanges deletes the changes recorded for the heights up to the given height.
+	PruneSnapshotChanges(height uint64) error
+}
+
 // ExtensionSnapshotter is an extension Snapshotter that is appended to the snapshot stream.
 // ExtensionSnapshotter has an unique name and manages it's own internal formats.
 type ExtensionSnapshotter interface {
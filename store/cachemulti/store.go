@@ -13,10 +13,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
-// storeNameCtxKey is the TraceContext metadata key that identifies
-// the store which emitted a given trace.
-const storeNameCtxKey = "store_name"
-
 //----------------------------------------
 // Store
 
@@ -57,7 +53,7 @@ func NewFromKVStore(
 	for key, store := range stores {
 		if cms.TracingEnabled() {
 			tctx := cms.traceContext.Clone().Merge(types.TraceContext{
-				storeNameCtxKey: key.Name(),
+				tracekv.StoreNameCtxKey: key.Name(),
 			})
 
 			store = tracekv.NewStore(store.(types.KVStore), cms.traceWriter, tctx)
@@ -0,0 +1,345 @@
+package rootmulti
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"sort"
+
+	protoio "github.com/gogo/protobuf/io"
+	dbm "github.com/tendermint/tm-db"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// changesKeyPrefix is the prefix of the changes of the IAVL stores recorded at each commit for the
+// incremental snapshots, keyed by big-endian version: s/changes/<version>
+const changesKeyPrefix = "s/changes/"
+
+var _ snapshottypes.IncrementalSnapshotter = (*Store)(nil)
+
+// EnableIncrementalSnapshots implements snapshottypes.IncrementalSnapshotter. The changes written
+// to the IAVL stores are recorded in order at each commit, so that replaying them height by height
+// rebuilds the exact same IAVL trees, including node versions, hence the same app hashes.
+//
+// NOTE: Changes which bypass the KVStores returned by the store, such as store upgrades, are not
+// recorded, so incremental snapshots cannot span them.
+func (rs *Store) EnableIncrementalSnapshots() {
+	if rs.changes == nil {
+		rs.changes = types.NewMemoryListener()
+	}
+}
+
+// recordChanges wraps an IAVL store so that the changes written to it are recorded, if incremental
+// snapshots are enabled. Unlike the listeners added with AddListeners, it only wraps the stores of
+// the root store and is not passed on to the branches, so that only the changes which reach the IAVL
+// stores are recorded, and not those of the branches which are discarded, such as the ones of
+// CheckTx or of a failed tx.
+func (rs *Store) recordChanges(key types.StoreKey, store types.KVStore) types.KVStore {
+	if rs.changes == nil || rs.storesParams[key].typ != types.StoreTypeIAVL {
+		return store
+	}
+	return listenkv.NewStore(store, key, []types.WriteListener{rs.changes})
+}
+
+// SnapshotIncremental implements snapshottypes.IncrementalSnapshotter. The snapshot starts with a
+// SnapshotBaseItem, followed for each height by a SnapshotHeightItem, then for each store written
+// at that height by a SnapshotStoreItem and its SnapshotKVChangeItem items, in the order they were
+// written. The changes are only recorded once incremental snapshots are enabled, but do not depend
+// on the pruning of the stores.
+func (rs *Store) SnapshotIncremental(baseHeight, height uint64, protoWriter protoio.Writer) error {
+	if baseHeight == 0 || baseHeight >= height {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "invalid base height %v for incremental snapshot at height %v", baseHeight, height)
+	}
+	if height > uint64(getLatestVersion(rs.db)) {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
+	}
+
+	baseInfo, err := getCommitInfo(rs.db, int64(baseHeight))
+	if err != nil {
+		return err
+	}
+	err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Base{
+			Base: &snapshottypes.SnapshotBaseItem{
+				Height:  baseHeight,
+				AppHash: baseInfo.Hash(),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for version := baseHeight + 1; version <= height; version++ {
+		cInfo, err := getCommitInfo(rs.db, int64(version))
+		if err != nil {
+			return err
+		}
+		if !sameStores(baseInfo, cInfo) {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic,
+				"the stores at height %v differ from the stores at base height %v", version, baseHeight)
+		}
+		changes, err := getChanges(rs.db, int64(version))
+		if err != nil {
+			return err
+		}
+
+		err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_Height{
+				Height: &snapshottypes.SnapshotHeightItem{
+					Height:  version,
+					AppHash: cInfo.Hash(),
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		// The stores are independent trees, so their changes are grouped by store, keeping
+		// the order of the changes of each store.
+		changesByStore := make(map[string][]*types.StoreKVPair)
+		for _, change := range changes {
+			changesByStore[change.StoreKey] = append(changesByStore[change.StoreKey], change)
+		}
+		names := make([]string, 0, len(changesByStore))
+		for name := range changesByStore {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+				Item: &snapshottypes.SnapshotItem_Store{
+					Store: &snapshottypes.SnapshotStoreItem{
+						Name: name,
+					},
+				},
+			})
+			if err != nil {
+				return err
+			}
+			for _, change := range changesByStore[name] {
+				err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+					Item: &snapshottypes.SnapshotItem_KVChange{
+						KVChange: &snapshottypes.SnapshotKVChangeItem{
+							Key:    change.Key,
+							Value:  change.Value,
+							Delete: change.Delete,
+						},
+					},
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// restoreIncremental replays the changes of an incremental snapshot on top of the current state,
+// which must be the state at the base height, committing each height and checking its app hash.
+// It returns the first item following the changes.
+func (rs *Store) restoreIncremental(height uint64, protoReader protoio.Reader) (snapshottypes.SnapshotItem, error) {
+	snapshotItem := snapshottypes.SnapshotItem{}
+	if err := protoReader.ReadMsg(&snapshotItem); err != nil {
+		return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "invalid protobuf message")
+	}
+	base := snapshotItem.GetBase()
+	if base == nil {
+		return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "incremental snapshot does not start with a base item")
+	}
+	lastCommitID := rs.LastCommitID()
+	if uint64(lastCommitID.Version) != base.Height {
+		return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic,
+			"incremental snapshot applies on top of height %v, but the latest height is %v", base.Height, lastCommitID.Version)
+	}
+	if !bytes.Equal(lastCommitID.Hash, base.AppHash) {
+		return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic,
+			"app hash %X at height %v does not match base app hash %X", lastCommitID.Hash, base.Height, base.AppHash)
+	}
+
+	version := base.Height // height whose changes are being replayed, or the base height
+	var (
+		appHash []byte // app hash expected once the changes of version are committed
+		store   types.KVStore
+	)
+	// commit commits the changes of the height being replayed, if any.
+	commit := func() error {
+		if appHash == nil {
+			return nil
+		}
+		commitID := rs.Commit()
+		if !bytes.Equal(commitID.Hash, appHash) {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic,
+				"app hash %X at height %v does not match snapshot app hash %X", commitID.Hash, version, appHash)
+		}
+		appHash = nil
+		return nil
+	}
+
+loop:
+	for {
+		snapshotItem = snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+
+		switch item := snapshotItem.Item.(type) {
+		case *snapshottypes.SnapshotItem_Height:
+			if err := commit(); err != nil {
+				return snapshottypes.SnapshotItem{}, err
+			}
+			if item.Height.Height != version+1 {
+				return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic,
+					"expected changes of height %v, got height %v", version+1, item.Height.Height)
+			}
+			version = item.Height.Height
+			appHash = item.Height.AppHash
+			if appHash == nil {
+				appHash = []byte{}
+			}
+			store = nil
+
+		case *snapshottypes.SnapshotItem_Store:
+			if appHash == nil {
+				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "received store item before height item")
+			}
+			key := rs.keysByName[item.Store.Name]
+			if key == nil || rs.storesParams[key].typ != types.StoreTypeIAVL {
+				return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot replay changes into non-IAVL store %q", item.Store.Name)
+			}
+			// changes are written through GetKVStore, so that they are recorded in turn
+			store = rs.GetKVStore(key)
+
+		case *snapshottypes.SnapshotItem_KVChange:
+			if store == nil {
+				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "received change item before store item")
+			}
+			// Protobuf does not differentiate between []byte{} as nil, but IAVL does not allow
+			// nil keys nor nil values, so we can always set them to empty.
+			key, value := item.KVChange.Key, item.KVChange.Value
+			if key == nil {
+				key = []byte{}
+			}
+			if item.KVChange.Delete {
+				store.Delete(key)
+			} else {
+				if value == nil {
+					value = []byte{}
+				}
+				store.Set(key, value)
+			}
+
+		default:
+			break loop
+		}
+	}
+
+	if err := commit(); err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+	if version != height {
+		return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic,
+			"incremental snapshot ends at height %v, expected %v", version, height)
+	}
+	return snapshotItem, nil
+}
+
+// PruneSnapshotChanges implements snapshottypes.IncrementalSnapshotter.
+func (rs *Store) PruneSnapshotChanges(height uint64) error {
+	iter, err := rs.db.Iterator([]byte(changesKeyPrefix), types.PrefixEndBytes([]byte(changesKeyPrefix)))
+	if err != nil {
+		return err
+	}
+	last := changesKey(height)
+	var keys [][]byte
+	for ; iter.Valid() && bytes.Compare(iter.Key(), last) <= 0; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
+
+// sameStores returns whether both commit infos hold the same stores.
+func sameStores(a, b *types.CommitInfo) bool {
+	if len(a.StoreInfos) != len(b.StoreInfos) {
+		return false
+	}
+	for i := range a.StoreInfos {
+		if a.StoreInfos[i].Name != b.StoreInfos[i].Name {
+			return false
+		}
+	}
+	return true
+}
+
+func changesKey(version uint64) []byte {
+	key := make([]byte, len(changesKeyPrefix)+8)
+	copy(key, changesKeyPrefix)
+	binary.BigEndian.PutUint64(key[len(changesKeyPrefix):], version)
+	return key
+}
+
+// flushChanges records the changes committed at the given version, in the order they were written.
+func flushChanges(db dbm.DB, version int64, changes []*types.StoreKVPair) {
+	buf := &bytes.Buffer{}
+	protoWriter := protoio.NewDelimitedWriter(buf)
+	for _, change := range changes {
+		if err := protoWriter.WriteMsg(change); err != nil {
+			panic(err)
+		}
+	}
+	// the value must not be nil, even without changes
+	if err := db.Set(changesKey(uint64(version)), append([]byte{}, buf.Bytes()...)); err != nil {
+		panic(err)
+	}
+}
+
+// getChanges returns the changes recorded at the given version.
+func getChanges(db dbm.DB, version int64) ([]*types.StoreKVPair, error) {
+	key := changesKey(uint64(version))
+	ok, err := db.Has(key)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound,
+			"no changes recorded at height %v, incremental snapshots must be enabled before it is committed", version)
+	}
+	bz, err := db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []*types.StoreKVPair
+	protoReader := protoio.NewDelimitedReader(bytes.NewReader(bz), len(bz))
+	for {
+		change := &types.StoreKVPair{}
+		err := protoReader.ReadMsg(change)
+		if err == io.EOF {
+			return changes, nil
+		} else if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to read changes at height %v", version)
+		}
+		changes = append(changes, change)
+	}
+}
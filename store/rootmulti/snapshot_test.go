@@ -1,6 +1,7 @@
 package rootmulti_test
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
@@ -10,6 +11,7 @@ import (
 	"math/rand"
 	"testing"
 
+	protoio "github.com/gogo/protobuf/io"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -18,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/iavl"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 )
@@ -308,3 +311,120 @@ func BenchmarkMultistoreSnapshotRestore100K(b *testing.B) {
 func BenchmarkMultistoreSnapshotRestore1M(b *testing.B) {
 	benchmarkMultistoreSnapshotRestore(b, 10, 100000)
 }
+
+// commitIncrementalData commits heights of changes through the listened KVStores of a store
+// created by newMultiStoreWithMixedMounts.
+func commitIncrementalData(store *rootmulti.Store) {
+	iavl1 := store.GetKVStore(store.StoreKeysByName()["iavl1"])
+	iavl2 := store.GetKVStore(store.StoreKeysByName()["iavl2"])
+	trans1 := store.GetKVStore(store.StoreKeysByName()["trans1"])
+
+	iavl1.Set([]byte{0}, []byte{0})
+	trans1.Set([]byte("x1"), []byte{91})
+	store.Commit()
+
+	// the shape of the IAVL trees depends on the order of the changes
+	for i := byte(0); i < 20; i++ {
+		iavl1.Set([]byte{20 - i}, []byte{i})
+	}
+	iavl1.Delete([]byte{3})
+	iavl1.Set([]byte{7}, []byte{77})
+	iavl2.Set([]byte("B"), []byte{102})
+	store.Commit()
+
+	// a height without changes
+	store.Commit()
+
+	cacheStore := store.CacheMultiStore()
+	cacheStore.GetKVStore(store.StoreKeysByName()["iavl2"]).Set([]byte("C"), []byte{103})
+	cacheStore.GetKVStore(store.StoreKeysByName()["iavl1"]).Delete([]byte{1})
+	// only the changes which reach the IAVL stores are recorded, once, and not those of the
+	// discarded branches
+	nested := cacheStore.CacheMultiStore()
+	nested.GetKVStore(store.StoreKeysByName()["iavl2"]).Set([]byte("D"), []byte{104})
+	nested.Write()
+	cacheStore.CacheMultiStore().GetKVStore(store.StoreKeysByName()["iavl1"]).Set([]byte("ghost"), []byte{1})
+	store.CacheMultiStore().GetKVStore(store.StoreKeysByName()["iavl2"]).Set([]byte("ghost"), []byte{1})
+	cacheStore.Write()
+	store.Commit()
+}
+
+// snapshotIncremental returns the delimited items of an incremental snapshot.
+func snapshotIncremental(t *testing.T, store *rootmulti.Store, baseHeight, height uint64) []byte {
+	buf := &bytes.Buffer{}
+	require.NoError(t, store.SnapshotIncremental(baseHeight, height, protoio.NewDelimitedWriter(buf)))
+	return buf.Bytes()
+}
+
+func newItemReader(items []byte) protoio.Reader {
+	return protoio.NewDelimitedReader(bytes.NewReader(items), len(items))
+}
+
+func TestMultistoreIncrementalSnapshotRestore(t *testing.T) {
+	source := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	source.EnableIncrementalSnapshots()
+	commitIncrementalData(source)
+	require.EqualValues(t, 4, source.LastCommitID().Version)
+
+	// restore the base snapshot at height 1
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	target.EnableIncrementalSnapshots()
+	buf := &bytes.Buffer{}
+	require.NoError(t, source.Snapshot(1, protoio.NewDelimitedWriter(buf)))
+	_, err := target.Restore(1, snapshottypes.CurrentFormat, newItemReader(buf.Bytes()))
+	require.NoError(t, err)
+
+	// replay the changes up to height 3, then up to height 4
+	for _, heights := range [][2]uint64{{1, 3}, {3, 4}} {
+		nextItem, err := target.Restore(heights[1], snapshottypes.FormatIncremental, newItemReader(snapshotIncremental(t, source, heights[0], heights[1])))
+		require.NoError(t, err)
+		require.Nil(t, nextItem.Item)
+
+		sourceInfo, err := source.GetCommitInfo(int64(heights[1]))
+		require.NoError(t, err)
+		require.Equal(t, sourceInfo.CommitID(), target.LastCommitID())
+	}
+	for _, name := range []string{"iavl1", "iavl2", "iavl3"} {
+		assertStoresEqual(t, source.GetStoreByName(name).(types.CommitKVStore), target.GetStoreByName(name).(types.CommitKVStore),
+			"store %q not equal", name)
+	}
+
+	// the replayed changes are recorded in turn
+	require.Equal(t, snapshotIncremental(t, source, 2, 4), snapshotIncremental(t, target, 2, 4))
+
+	// restoring again fails, since the target is not at the base height anymore
+	_, err = target.Restore(4, snapshottypes.FormatIncremental, newItemReader(snapshotIncremental(t, source, 3, 4)))
+	require.Error(t, err)
+}
+
+func TestMultistoreIncrementalSnapshot_Errors(t *testing.T) {
+	store := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	commitIncrementalData(store)
+	store.EnableIncrementalSnapshots()
+	store.GetKVStore(store.StoreKeysByName()["iavl1"]).Set([]byte("a"), []byte{1})
+	store.Commit()
+
+	testcases := map[string]struct {
+		baseHeight uint64
+		height     uint64
+	}{
+		"0 base height":       {0, 5},
+		"base height above":   {5, 4},
+		"future height":       {4, 6},
+		"changes not enabled": {3, 5},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := store.SnapshotIncremental(tc.baseHeight, tc.height, protoio.NewDelimitedWriter(&bytes.Buffer{}))
+			require.Error(t, err)
+		})
+	}
+
+	require.NoError(t, store.SnapshotIncremental(4, 5, protoio.NewDelimitedWriter(&bytes.Buffer{})))
+
+	// the changes are pruned up to the given height
+	require.NoError(t, store.PruneSnapshotChanges(5))
+	err := store.SnapshotIncremental(4, 5, protoio.NewDelimitedWriter(&bytes.Buffer{}))
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+}
@@ -33,6 +33,9 @@ import (
 const (
 	latestVersionKey = "s/latest"
 	commitInfoKeyFmt = "s/%d" // s/<version>
+
+	// snapshotPrefetchSize is the number of nodes exported ahead of the snapshot writer for each store
+	snapshotPrefetchSize = 256
 )
 
 // Store is composed of many CommitStores. Name contrasts with
@@ -43,6 +46,15 @@ type Store struct {
 	logger         log.Logger
 	lastCommitInfo *types.CommitInfo
 	pruningManager *pruning.Manager
+	// storePruningManagers holds the pruning managers of the stores with their own pruning
+	// options, by store name.
+	storePruningManagers map[string]*pruning.Manager
+	snapshotInterval     uint64
+	asyncPruning         pruningtypes.AsyncPruningOptions
+	asyncPruner          *asyncPruner
+	// commitMtx serializes the commits and the removal of versions by the background pruning.
+	commitMtx sync.Mutex
+
 	iavlCacheSize  int
 	storesParams   map[types.StoreKey]storeParams
 	stores         map[types.StoreKey]types.CommitKVStore
@@ -58,6 +70,10 @@ type Store struct {
 	interBlockCache types.MultiStorePersistentCache
 
 	listeners map[types.StoreKey][]types.WriteListener
+
+	// changes records the changes written to the IAVL stores since the last commit, which are
+	// persisted at each commit for the incremental snapshots. It is nil unless they are enabled.
+	changes *types.MemoryListener
 }
 
 var (
@@ -80,6 +96,8 @@ func NewStore(db dbm.DB, logger log.Logger) *Store {
 		listeners:      make(map[types.StoreKey][]types.WriteListener),
 		removalMap:     make(map[types.StoreKey]bool),
 		pruningManager: pruning.NewManager(db, logger),
+
+		storePruningManagers: make(map[string]*pruning.Manager),
 	}
 }
 
@@ -98,7 +116,11 @@ func (rs *Store) SetPruning(pruningOpts pruningtypes.PruningOptions) {
 // SetSnapshotInterval sets the interval at which the snapshots are taken.
 // It is used by the store to determine which heights to retain until after the snapshot is complete.
 func (rs *Store) SetSnapshotInterval(snapshotInterval uint64) {
-	rs.pruningManager.SetSnapshotInterval(snapshotInterval)
+	rs.snapshotInterval = snapshotInterval
+	_, managers := rs.pruningManagers()
+	for _, manager := range managers {
+		manager.SetSnapshotInterval(snapshotInterval)
+	}
 }
 
 func (rs *Store) SetIAVLCacheSize(cacheSize int) {
@@ -183,6 +205,8 @@ func (rs *Store) LoadVersion(ver int64) error {
 }
 
 func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
+	rs.stopAsyncPruning()
+
 	infos := make(map[string]types.StoreInfo)
 
 	cInfo := &types.CommitInfo{}
@@ -272,6 +296,13 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 	if err := rs.pruningManager.LoadPruningHeights(rs.db); err != nil {
 		return err
 	}
+	for name, manager := range rs.storePruningManagers {
+		if err := manager.LoadPruningHeights(dbm.NewPrefixDB(rs.db, []byte(storePruningPrefix+name+"/"))); err != nil {
+			return err
+		}
+	}
+
+	rs.startAsyncPruning()
 
 	return nil
 }
@@ -320,7 +351,10 @@ func moveKVStoreData(oldDB types.KVStore, newDB types.KVStore) error {
 // If other strategy, this height is persisted until it is
 // less than <current height> - KeepRecent and <current height> % Interval == 0
 func (rs *Store) PruneSnapshotHeight(height int64) {
-	rs.pruningManager.HandleHeightSnapshot(height)
+	_, managers := rs.pruningManagers()
+	for _, manager := range managers {
+		manager.HandleHeightSnapshot(height)
+	}
 }
 
 // SetInterBlockCache sets the Store's internal inter-block (persistent) cache.
@@ -398,6 +432,12 @@ func (rs *Store) LastCommitID() types.CommitID {
 	return rs.lastCommitInfo.CommitID()
 }
 
+// GetCommitInfo returns the commit info of the given version (height), as
+// stored on disk.
+func (rs *Store) GetCommitInfo(ver int64) (*types.CommitInfo, error) {
+	return getCommitInfo(rs.db, ver)
+}
+
 // Commit implements Committer/CommitStore.
 func (rs *Store) Commit() types.CommitID {
 	var previousHeight, version int64
@@ -415,7 +455,11 @@ func (rs *Store) Commit() types.CommitID {
 		version = previousHeight + 1
 	}
 
+	rs.commitMtx.Lock()
 	rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap)
+	if rs.changes != nil {
+		flushChanges(rs.db, version, rs.changes.PopStateCache())
+	}
 	defer rs.flushMetadata(rs.db, version, rs.lastCommitInfo)
 
 	// remove remnants of removed stores
@@ -428,6 +472,7 @@ func (rs *Store) Commit() types.CommitID {
 	}
 	// reset the removalMap
 	rs.removalMap = make(map[types.StoreKey]bool)
+	rs.commitMtx.Unlock()
 
 	if err := rs.handlePruning(version); err != nil {
 		panic(err)
@@ -459,7 +504,7 @@ func (rs *Store) CacheWrapWithListeners(_ types.StoreKey, _ []types.WriteListene
 func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range rs.stores {
-		stores[k] = v
+		stores[k] = rs.recordChanges(k, v)
 	}
 	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.getTracingContext(), rs.listeners)
 }
@@ -477,6 +522,15 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 			// it to get the underlying IAVL store.
 			store = rs.GetCommitKVStore(key)
 
+			// The stores pruned at this version report their earliest queryable
+			// version when accessed.
+			if !store.(*iavl.Store).VersionExists(version) {
+				if earliest := rs.EarliestVersion(key.Name()); version < earliest {
+					cachedStores[key] = prunedStore{name: key.Name(), version: version, earliest: earliest}
+					continue
+				}
+			}
+
 			// Attempt to lazy-load an already saved IAVL store version. If the
 			// version does not exist or is pruned, an error should be returned.
 			iavlStore, err := store.(*iavl.Store).GetImmutable(version)
@@ -520,10 +574,13 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	if s == nil {
 		panic(fmt.Sprintf("store does not exist for key: %s", key.Name()))
 	}
-	store := s.(types.KVStore)
+	store := rs.recordChanges(key, s.(types.KVStore))
 
 	if rs.TracingEnabled() {
-		store = tracekv.NewStore(store, rs.traceWriter, rs.getTracingContext())
+		tctx := rs.getTracingContext().Merge(types.TraceContext{
+			tracekv.StoreNameCtxKey: key.Name(),
+		})
+		store = tracekv.NewStore(store, rs.traceWriter, tctx)
 	}
 	if rs.ListeningEnabled(key) {
 		store = listenkv.NewStore(store, key, rs.listeners[key])
@@ -532,18 +589,44 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	return store
 }
 
+// handlePruning prunes the stores at the given version, each set of stores sharing
+// pruning options being pruned independently. With the background pruning, the heights
+// to prune are queued instead.
 func (rs *Store) handlePruning(version int64) error {
-	rs.pruningManager.HandleHeight(version - 1) // we should never prune the current version.
-	if !rs.pruningManager.ShouldPruneAtHeight(version) {
-		return nil
+	names, managers := rs.pruningManagers()
+	for i, manager := range managers {
+		manager.HandleHeight(version - 1) // we should never prune the current version.
+		if !manager.ShouldPruneAtHeight(version) {
+			continue
+		}
+		var err error
+		if rs.asyncPruner != nil {
+			err = rs.asyncPruner.queue(names[i], manager)
+		} else {
+			err = rs.pruneAtHeight(names[i], manager, version)
+		}
+		if err != nil {
+			return err
+		}
 	}
-	rs.logger.Info("prune start", "height", version)
-	defer rs.logger.Info("prune end", "height", version)
-	return rs.pruneStores()
+	return nil
 }
 
-func (rs *Store) pruneStores() error {
-	pruningHeights, err := rs.pruningManager.GetFlushAndResetPruningHeights()
+func (rs *Store) pruneAtHeight(storeName string, manager *pruning.Manager, version int64) error {
+	logger := rs.logger
+	if storeName != "" {
+		logger = logger.With("store", storeName)
+	}
+	logger.Info("prune start", "height", version)
+	defer logger.Info("prune end", "height", version)
+	return rs.pruneStores(storeName, manager)
+}
+
+// pruneStores prunes the heights of the pruning manager from the IAVL stores it handles:
+// the named store if it has its own pruning options, or all the other stores if storeName
+// is empty.
+func (rs *Store) pruneStores(storeName string, manager *pruning.Manager) error {
+	pruningHeights, err := manager.GetFlushAndResetPruningHeights()
 	if err != nil {
 		return err
 	}
@@ -555,6 +638,16 @@ func (rs *Store) pruneStores() error {
 
 	rs.logger.Debug("pruning heights", "heights", pruningHeights)
 
+	return rs.deleteVersions(storeName, pruningHeights)
+}
+
+// deleteVersions removes the given versions of the IAVL stores handled by the named pruning
+// manager, the root one if storeName is empty. The versions are removed one at a time, each
+// locking its store against the commits, so that a commit waits at most for the removal of a
+// single version.
+func (rs *Store) deleteVersions(storeName string, heights []int64) error {
+	rs.commitMtx.Lock()
+	var keys []types.StoreKey
 	for key, store := range rs.stores {
 		// If the store is wrapped with an inter-block cache, we must first unwrap
 		// it to get the underlying IAVL store.
@@ -562,20 +655,58 @@ func (rs *Store) pruneStores() error {
 			continue
 		}
 
-		store = rs.GetCommitKVStore(key)
-
-		err := store.(*iavl.Store).DeleteVersions(pruningHeights...)
-		if err == nil {
+		if _, ok := rs.storePruningManagers[key.Name()]; ok {
+			if key.Name() != storeName {
+				continue
+			}
+		} else if storeName != "" && key.Name() != storeName {
 			continue
 		}
+		keys = append(keys, key)
+	}
+	rs.commitMtx.Unlock()
 
-		if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
+	for _, key := range keys {
+		if err := rs.deleteStoreVersions(key, heights); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+func (rs *Store) deleteStoreVersions(key types.StoreKey, heights []int64) error {
+	heights = append([]int64(nil), heights...)
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+
+	for _, height := range heights {
+		if removed, err := rs.deleteStoreVersion(key, height); err != nil || removed {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteStoreVersion removes a version of an IAVL store, and returns whether the store was
+// removed since.
+func (rs *Store) deleteStoreVersion(key types.StoreKey, height int64) (bool, error) {
+	rs.commitMtx.Lock()
+	defer rs.commitMtx.Unlock()
+
+	if _, ok := rs.stores[key]; !ok {
+		return true, nil
+	}
+	store := rs.GetCommitKVStore(key)
+
+	err := store.(*iavl.Store).DeleteVersions(height)
+	if err != nil {
+		if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
+			return false, err
+		}
+	}
+
+	return false, rs.setEarliestVersion(key.Name(), height+1)
+}
+
 // getStoreByName performs a lookup of a StoreKey given a store name typically
 // provided in a path. The StoreKey is then used to perform a lookup and return
 // a Store. If the Store is wrapped in an inter-block cache, it will be unwrapped
@@ -610,6 +741,13 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "store %s (type %T) doesn't support queries", storeName, store), false)
 	}
 
+	if iavlStore, ok := store.(*iavl.Store); ok && req.Height > 0 && !iavlStore.VersionExists(req.Height) {
+		if earliest := rs.EarliestVersion(storeName); req.Height < earliest {
+			pruned := prunedStore{name: storeName, version: req.Height, earliest: earliest}
+			return sdkerrors.QueryResult(pruned.err(), false)
+		}
+	}
+
 	// trim the path and make the query
 	req.Path = subpath
 	res := queryable.Query(req)
@@ -719,13 +857,24 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
 	// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
 	// are demarcated by new SnapshotStore items.
-	for _, store := range stores {
+	//
+	// The stores are traversed concurrently, each by its own goroutine buffering up to
+	// snapshotPrefetchSize nodes ahead of the writer, which writes them in order.
+	quit := make(chan struct{})
+	defer close(quit)
+	exports := make([]chan *iavltree.ExportNode, len(stores))
+	for i, store := range stores {
 		exporter, err := store.Export(int64(height))
 		if err != nil {
 			return err
 		}
 		defer exporter.Close()
-		err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+		exports[i] = make(chan *iavltree.ExportNode, snapshotPrefetchSize)
+		go prefetchExport(exporter, exports[i], quit)
+	}
+
+	for i, store := range stores {
+		err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
 			Item: &snapshottypes.SnapshotItem_Store{
 				Store: &snapshottypes.SnapshotStoreItem{
 					Name: store.name,
@@ -736,13 +885,7 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 			return err
 		}
 
-		for {
-			node, err := exporter.Next()
-			if err == iavltree.ExportDone {
-				break
-			} else if err != nil {
-				return err
-			}
+		for node := range exports[i] {
 			err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
 				Item: &snapshottypes.SnapshotItem_IAVL{
 					IAVL: &snapshottypes.SnapshotIAVLItem{
@@ -757,17 +900,42 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 				return err
 			}
 		}
-		exporter.Close()
 	}
 
 	return nil
 }
 
+// prefetchExport sends the nodes of the exporter to the channel, closing it once all the
+// nodes have been sent, unless quit is closed first.
+func prefetchExport(exporter *iavltree.Exporter, nodes chan<- *iavltree.ExportNode, quit <-chan struct{}) {
+	defer close(nodes)
+	for {
+		// Next only fails with ExportDone
+		node, err := exporter.Next()
+		if err != nil {
+			return
+		}
+		select {
+		case nodes <- node:
+		case <-quit:
+			return
+		}
+	}
+}
+
 // Restore implements snapshottypes.Snapshotter.
 // returns next snapshot item and error.
 func (rs *Store) Restore(
 	height uint64, format uint32, protoReader protoio.Reader,
 ) (snapshottypes.SnapshotItem, error) {
+	// the background pruning must not remove versions while the stores are being restored
+	rs.stopAsyncPruning()
+	defer rs.startAsyncPruning()
+
+	if format == snapshottypes.FormatIncremental {
+		return rs.restoreIncremental(height, protoReader)
+	}
+
 	// Import nodes into stores. The first item is expected to be a SnapshotItem containing
 	// a SnapshotStoreItem, telling us which store to import into. The following items will contain
 	// SnapshotNodeItem (i.e. ExportNode) until we reach the next SnapshotStoreItem or EOF.
@@ -941,12 +1109,17 @@ func (rs *Store) RollbackToVersion(target int64) int64 {
 	if target >= current {
 		return current
 	}
-	for ; current > target; current-- {
-		rs.pruningManager.HandleHeight(current)
-	}
-	if err := rs.pruneStores(); err != nil {
-		panic(err)
+	rs.stopAsyncPruning()
+	names, managers := rs.pruningManagers()
+	for i, manager := range managers {
+		for version := current; version > target; version-- {
+			manager.HandleHeight(version)
+		}
+		if err := rs.pruneStores(names[i], manager); err != nil {
+			panic(err)
+		}
 	}
+	current = target
 
 	// update latest height
 	bz, err := gogotypes.StdInt64Marshal(current)
@@ -0,0 +1,266 @@
+package rootmulti
+
+import (
+	"encoding/binary"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/pruning"
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+const (
+	// asyncPruningPrefix prefixes the heights waiting to be removed by the background pruning,
+	// by pruning manager: s/asyncpruning/<store>, the root manager using the empty name.
+	asyncPruningPrefix = "s/asyncpruning/"
+
+	// asyncPruningRetryDelay is the delay before removing again heights whose removal failed.
+	asyncPruningRetryDelay = 10 * time.Second
+)
+
+var _ types.AsyncPruner = (*Store)(nil)
+
+// SetAsyncPruning sets the options of the background pruning. When enabled, the heights to
+// prune are queued at each pruning interval and removed from disk by a background worker,
+// so that Commit does not wait for the pruning. It must be called before loading a version.
+func (rs *Store) SetAsyncPruning(opts pruningtypes.AsyncPruningOptions) {
+	rs.stopAsyncPruning()
+	rs.asyncPruning = opts
+}
+
+// startAsyncPruning starts the background pruning if enabled and not running yet, with the
+// heights left to remove before the node stopped.
+func (rs *Store) startAsyncPruning() {
+	if !rs.asyncPruning.Enabled || rs.asyncPruner != nil {
+		return
+	}
+	rs.asyncPruner = newAsyncPruner(rs, rs.asyncPruning)
+	go rs.asyncPruner.run()
+}
+
+// stopAsyncPruning stops the background pruning, if running, waiting for the removal in
+// progress to complete. The heights left to remove are kept on disk.
+func (rs *Store) stopAsyncPruning() {
+	if rs.asyncPruner == nil {
+		return
+	}
+	rs.asyncPruner.stop()
+	rs.asyncPruner = nil
+}
+
+// asyncPruner removes the pruned heights of the stores in the background. The heights are
+// queued by pruning manager, and persisted until removed.
+type asyncPruner struct {
+	rs   *Store
+	opts pruningtypes.AsyncPruningOptions
+
+	mtx     sync.Mutex
+	pending map[string][]int64 // by pruning manager name
+	backlog uint64
+
+	wake chan struct{}
+	quit chan struct{}
+	done chan struct{}
+}
+
+func newAsyncPruner(rs *Store, opts pruningtypes.AsyncPruningOptions) *asyncPruner {
+	p := &asyncPruner{
+		rs:      rs,
+		opts:    opts,
+		pending: make(map[string][]int64),
+		wake:    make(chan struct{}, 1),
+		quit:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	it, err := rs.db.Iterator([]byte(asyncPruningPrefix), types.PrefixEndBytes([]byte(asyncPruningPrefix)))
+	if err != nil {
+		panic(err)
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		name := string(it.Key()[len(asyncPruningPrefix):])
+		heights := bytesToHeights(it.Value())
+		if len(heights) > 0 {
+			p.pending[name] = heights
+			p.backlog += uint64(len(heights))
+		}
+	}
+	if err := it.Error(); err != nil {
+		panic(err)
+	}
+	p.updateMetrics()
+	if p.backlog > 0 {
+		p.wake <- struct{}{}
+	}
+
+	return p
+}
+
+// queue queues the heights to prune of the named pruning manager, unless the backlog is full,
+// in which case they are kept by the manager until a later pruning interval.
+func (p *asyncPruner) queue(name string, manager *pruning.Manager) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.opts.MaxBacklog > 0 && p.backlog >= p.opts.MaxBacklog {
+		p.rs.logger.Debug("pruning deferred; backlog is full", "store", name, "backlog", p.backlog)
+		return nil
+	}
+
+	heights, err := manager.GetFlushAndResetPruningHeights()
+	if err != nil {
+		return err
+	}
+	if len(heights) == 0 {
+		return nil
+	}
+
+	pending := append(p.pending[name], heights...)
+	sort.Slice(pending, func(i, j int) bool { return pending[i] < pending[j] })
+	if err := p.setPending(name, pending); err != nil {
+		return err
+	}
+	p.backlog += uint64(len(heights))
+	p.updateMetrics()
+
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// next returns the next batch of heights to remove, the lowest ones of the first pruning
+// manager with pending heights.
+func (p *asyncPruner) next() (string, []int64, bool) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if len(p.pending) == 0 {
+		return "", nil, false
+	}
+	names := make([]string, 0, len(p.pending))
+	for name := range p.pending {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	heights := p.pending[names[0]]
+	if p.opts.BatchSize > 0 && uint64(len(heights)) > p.opts.BatchSize {
+		heights = heights[:p.opts.BatchSize]
+	}
+	return names[0], append([]int64(nil), heights...), true
+}
+
+// remove removes the heights of a batch from the pending ones. Heights may have been queued
+// since the batch was taken, e.g. snapshot heights lower than the ones of the batch.
+func (p *asyncPruner) remove(name string, heights []int64) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	removed := make(map[int64]bool, len(heights))
+	for _, h := range heights {
+		removed[h] = true
+	}
+	pending := make([]int64, 0, len(p.pending[name]))
+	for _, h := range p.pending[name] {
+		if !removed[h] {
+			pending = append(pending, h)
+		}
+	}
+	p.backlog -= uint64(len(p.pending[name]) - len(pending))
+	if err := p.setPending(name, pending); err != nil {
+		return err
+	}
+	p.updateMetrics()
+	return nil
+}
+
+func (p *asyncPruner) setPending(name string, heights []int64) error {
+	key := []byte(asyncPruningPrefix + name)
+	if len(heights) == 0 {
+		delete(p.pending, name)
+		return p.rs.db.DeleteSync(key)
+	}
+	p.pending[name] = heights
+	return p.rs.db.SetSync(key, heightsToBytes(heights))
+}
+
+func (p *asyncPruner) updateMetrics() {
+	telemetry.SetGauge(float32(p.backlog), "store", "pruning", "pending_heights")
+}
+
+func (p *asyncPruner) run() {
+	defer close(p.done)
+
+	for {
+		name, heights, ok := p.next()
+		if !ok {
+			select {
+			case <-p.wake:
+				continue
+			case <-p.quit:
+				return
+			}
+		}
+
+		delay := p.prune(name, heights)
+		if delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-p.quit:
+				return
+			}
+		}
+	}
+}
+
+// prune removes a batch of heights, and returns the delay to wait before the next batch.
+func (p *asyncPruner) prune(name string, heights []int64) time.Duration {
+	logger := p.rs.logger
+	if name != "" {
+		logger = logger.With("store", name)
+	}
+	logger.Debug("pruning heights", "heights", heights)
+
+	start := time.Now()
+	if err := p.rs.deleteVersions(name, heights); err != nil {
+		logger.Error("failed to prune heights; retrying later", "heights", heights, "err", err)
+		return asyncPruningRetryDelay
+	}
+	telemetry.MeasureSince(start, "store", "pruning", "batch")
+
+	if err := p.remove(name, heights); err != nil {
+		logger.Error("failed to remove pruned heights from the backlog", "err", err)
+	}
+
+	if p.opts.RateLimit == 0 {
+		return 0
+	}
+	return time.Duration(len(heights))*time.Second/time.Duration(p.opts.RateLimit) - time.Since(start)
+}
+
+func (p *asyncPruner) stop() {
+	close(p.quit)
+	<-p.done
+}
+
+func heightsToBytes(heights []int64) []byte {
+	bz := make([]byte, 8*len(heights))
+	for i, h := range heights {
+		binary.BigEndian.PutUint64(bz[8*i:], uint64(h))
+	}
+	return bz
+}
+
+func bytesToHeights(bz []byte) []int64 {
+	heights := make([]int64, len(bz)/8)
+	for i := range heights {
+		heights[i] = int64(binary.BigEndian.Uint64(bz[8*i:]))
+	}
+	return heights
+}
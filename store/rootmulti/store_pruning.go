@@ -0,0 +1,160 @@
+package rootmulti
+
+import (
+	"encoding/binary"
+	"io"
+	"sort"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/pruning"
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// storePruningPrefix prefixes the pruning state of the stores with their own pruning options.
+	storePruningPrefix = "s/pruning/" // s/pruning/<store>/
+
+	// earliestVersionPrefix prefixes the earliest version of the pruned stores.
+	earliestVersionPrefix = "s/earliest/" // s/earliest/<store>
+)
+
+var _ types.StorePruner = (*Store)(nil)
+
+// SetStorePruning sets the pruning options of the named store, overriding the ones set with
+// SetPruning. The store is then pruned independently of the other stores, e.g. to keep more
+// historical versions of some stores.
+func (rs *Store) SetStorePruning(storeName string, opts pruningtypes.PruningOptions) {
+	manager, ok := rs.storePruningManagers[storeName]
+	if !ok {
+		prefix := []byte(storePruningPrefix + storeName + "/")
+		manager = pruning.NewManager(dbm.NewPrefixDB(rs.db, prefix), rs.logger.With("store", storeName))
+		manager.SetSnapshotInterval(rs.snapshotInterval)
+		rs.storePruningManagers[storeName] = manager
+	}
+	manager.SetOptions(opts)
+}
+
+// GetStorePruning returns the pruning options of the named store.
+func (rs *Store) GetStorePruning(storeName string) pruningtypes.PruningOptions {
+	return rs.getPruningManager(storeName).GetOptions()
+}
+
+// EarliestVersion returns the earliest version of the named store from which all the versions
+// are kept, i.e. its earliest queryable version. It is 1 if no version of the store was pruned.
+// Older versions may still exist, e.g. the ones kept for state sync snapshots.
+func (rs *Store) EarliestVersion(storeName string) int64 {
+	bz, err := rs.db.Get([]byte(earliestVersionPrefix + storeName))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) != 8 {
+		return 1
+	}
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+func (rs *Store) setEarliestVersion(storeName string, version int64) error {
+	if version <= rs.EarliestVersion(storeName) {
+		return nil
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(version))
+	return rs.db.Set([]byte(earliestVersionPrefix+storeName), bz)
+}
+
+// getPruningManager returns the pruning manager of the named store, which is the root one
+// unless the store has its own pruning options.
+func (rs *Store) getPruningManager(storeName string) *pruning.Manager {
+	if manager, ok := rs.storePruningManagers[storeName]; ok {
+		return manager
+	}
+	return rs.pruningManager
+}
+
+// pruningManagers returns the root pruning manager, under the empty name, followed by the
+// pruning managers of the stores with their own pruning options, sorted by store name.
+func (rs *Store) pruningManagers() ([]string, []*pruning.Manager) {
+	names := make([]string, 0, len(rs.storePruningManagers))
+	for name := range rs.storePruningManagers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	managers := make([]*pruning.Manager, 0, len(names)+1)
+	managers = append(managers, rs.pruningManager)
+	for _, name := range names {
+		managers = append(managers, rs.storePruningManagers[name])
+	}
+	return append([]string{""}, names...), managers
+}
+
+// prunedStore stands for a store whose requested version was pruned: it panics, with an error
+// reporting the earliest queryable version of the store, when accessed.
+type prunedStore struct {
+	name     string
+	version  int64
+	earliest int64
+}
+
+var _ types.KVStore = prunedStore{}
+
+func (s prunedStore) err() error {
+	return sdkerrors.Wrapf(
+		sdkerrors.ErrInvalidHeight,
+		"version %d of store %s is pruned; earliest queryable height is %d", s.version, s.name, s.earliest,
+	)
+}
+
+// GetStoreType implements Store.
+func (s prunedStore) GetStoreType() types.StoreType {
+	return types.StoreTypeIAVL
+}
+
+// CacheWrap implements CacheWrapper. The cache only panics when accessed.
+func (s prunedStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (s prunedStore) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	return s.CacheWrap()
+}
+
+// CacheWrapWithListeners implements CacheWrapper.
+func (s prunedStore) CacheWrapWithListeners(_ types.StoreKey, _ []types.WriteListener) types.CacheWrap {
+	return s.CacheWrap()
+}
+
+// Get implements KVStore.
+func (s prunedStore) Get(_ []byte) []byte {
+	panic(s.err())
+}
+
+// Has implements KVStore.
+func (s prunedStore) Has(_ []byte) bool {
+	panic(s.err())
+}
+
+// Set implements KVStore.
+func (s prunedStore) Set(_, _ []byte) {
+	panic(s.err())
+}
+
+// Delete implements KVStore.
+func (s prunedStore) Delete(_ []byte) {
+	panic(s.err())
+}
+
+// Iterator implements KVStore.
+func (s prunedStore) Iterator(_, _ []byte) types.Iterator {
+	panic(s.err())
+}
+
+// ReverseIterator implements KVStore.
+func (s prunedStore) ReverseIterator(_, _ []byte) types.Iterator {
+	panic(s.err())
+}
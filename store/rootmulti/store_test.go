@@ -607,6 +607,131 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 	}
 }
 
+func TestMultiStore_StorePruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	newStore := func() *Store {
+		ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1))
+		ms.SetStorePruning("store2", pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+		ms.SetStorePruning("store3", pruningtypes.NewCustomPruningOptions(5, 1))
+		require.NoError(t, ms.LoadLatestVersion())
+		return ms
+	}
+	ms := newStore()
+	require.Equal(t, pruningtypes.NewCustomPruningOptions(2, 1), ms.GetStorePruning("store1"))
+	require.Equal(t, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing), ms.GetStorePruning("store2"))
+
+	k, v := []byte("wind"), []byte("blows")
+	for i := 0; i < 10; i++ {
+		for _, key := range []types.StoreKey{testStoreKey1, testStoreKey2, testStoreKey3} {
+			ms.GetKVStore(key).Set(k, v)
+		}
+		ms.Commit()
+	}
+
+	// each store keeps the versions of its own pruning options
+	for version, expected := range map[int64][3]bool{
+		4:  {false, true, false},
+		6:  {false, true, true},
+		8:  {true, true, true},
+		10: {true, true, true},
+	} {
+		for i, key := range []types.StoreKey{testStoreKey1, testStoreKey2, testStoreKey3} {
+			exists := ms.GetCommitKVStore(key).(*iavl.Store).VersionExists(version)
+			require.Equal(t, expected[i], exists, "store %s, version %d", key.Name(), version)
+		}
+	}
+	require.Equal(t, int64(8), ms.EarliestVersion("store1"))
+	require.Equal(t, int64(1), ms.EarliestVersion("store2"))
+	require.Equal(t, int64(5), ms.EarliestVersion("store3"))
+
+	// the pruned stores report their earliest queryable version when accessed
+	cms, err := ms.CacheMultiStoreWithVersion(6)
+	require.NoError(t, err)
+	require.PanicsWithError(t, "version 6 of store store1 is pruned; earliest queryable height is 8: invalid height", func() {
+		cms.GetKVStore(testStoreKey1).Get(k)
+	})
+	require.Equal(t, v, cms.GetKVStore(testStoreKey2).Get(k))
+	require.Equal(t, v, cms.GetKVStore(testStoreKey3).Get(k))
+
+	qres := ms.Query(abci.RequestQuery{Path: "/store1/key", Data: k, Height: 6})
+	require.EqualValues(t, sdkerrors.ErrInvalidHeight.ABCICode(), qres.Code)
+	require.Contains(t, qres.Log, "earliest queryable height is 8")
+	qres = ms.Query(abci.RequestQuery{Path: "/store2/key", Data: k, Height: 6})
+	require.EqualValues(t, 0, qres.Code)
+	require.Equal(t, v, qres.Value)
+
+	// the pruning state of each store survives restarts
+	ms = newStore()
+	for i := 0; i < 5; i++ {
+		ms.Commit()
+	}
+	require.Equal(t, int64(13), ms.EarliestVersion("store1"))
+	require.Equal(t, int64(1), ms.EarliestVersion("store2"))
+	require.Equal(t, int64(10), ms.EarliestVersion("store3"))
+	require.False(t, ms.GetCommitKVStore(testStoreKey3).(*iavl.Store).VersionExists(9))
+	require.True(t, ms.GetCommitKVStore(testStoreKey2).(*iavl.Store).VersionExists(1))
+}
+
+func TestMultiStore_AsyncPruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	newStore := func(opts pruningtypes.AsyncPruningOptions) *Store {
+		ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 10))
+		ms.SetAsyncPruning(opts)
+		require.NoError(t, ms.LoadLatestVersion())
+		return ms
+	}
+	versionExists := func(ms *Store, version int64) bool {
+		return ms.GetCommitKVStore(testStoreKey1).(*iavl.Store).VersionExists(version)
+	}
+	backlog := func(ms *Store) uint64 {
+		ms.asyncPruner.mtx.Lock()
+		defer ms.asyncPruner.mtx.Unlock()
+		return ms.asyncPruner.backlog
+	}
+
+	// one height per second: the first batch is removed, the others are pending
+	ms := newStore(pruningtypes.AsyncPruningOptions{Enabled: true, BatchSize: 1, RateLimit: 1, MaxBacklog: 1})
+	for i := 0; i < 10; i++ {
+		ms.Commit()
+	}
+	require.Eventually(t, func() bool { return !versionExists(ms, 1) }, 5*time.Second, 10*time.Millisecond)
+	require.True(t, versionExists(ms, 7))
+	require.Equal(t, int64(2), ms.EarliestVersion("store1"))
+
+	// the backlog is full: the heights are kept by the pruning manager
+	for i := 0; i < 10; i++ {
+		ms.Commit()
+	}
+	require.LessOrEqual(t, backlog(ms), uint64(6))
+	require.True(t, versionExists(ms, 17))
+
+	// the pending heights are removed after a restart
+	ms.stopAsyncPruning()
+	bz, err := db.Get([]byte(asyncPruningPrefix))
+	require.NoError(t, err)
+	require.NotEmpty(t, bz)
+
+	ms = newStore(pruningtypes.AsyncPruningOptions{Enabled: true, BatchSize: 3})
+	require.Eventually(t, func() bool { return backlog(ms) == 0 }, 5*time.Second, 10*time.Millisecond)
+	for v := int64(1); v <= 7; v++ {
+		require.False(t, versionExists(ms, v), "version %d", v)
+	}
+	require.Equal(t, int64(8), ms.EarliestVersion("store1"))
+	bz, err = db.Get([]byte(asyncPruningPrefix))
+	require.NoError(t, err)
+	require.Nil(t, bz)
+
+	// the heights deferred while the backlog was full are queued at the next interval
+	for i := 0; i < 10; i++ {
+		ms.Commit()
+	}
+	require.Eventually(t, func() bool { return backlog(ms) == 0 && !versionExists(ms, 27) }, 5*time.Second, 10*time.Millisecond)
+	for v := int64(8); v <= 27; v++ {
+		require.False(t, versionExists(ms, v), "version %d", v)
+	}
+	require.True(t, versionExists(ms, 28))
+}
+
 func TestSetInitialVersion(t *testing.T) {
 	db := dbm.NewMemDB()
 	multi := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
@@ -796,6 +921,19 @@ func TestTraceConcurrency(t *testing.T) {
 	stopW <- struct{}{}
 }
 
+func TestTraceStoreName(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, multi.LoadLatestVersion())
+
+	b := &bytes.Buffer{}
+	multi.SetTracer(b)
+	multi.SetTracingContext(types.TraceContext{"blockHeight": 64})
+
+	multi.GetKVStore(multi.keysByName["store1"]).Set([]byte{1}, []byte{1})
+	require.Equal(t, `{"operation":"write","key":"AQ==","value":"AQ==","metadata":{"blockHeight":64,"store_name":"store1"}}`+"\n", b.String())
+}
+
 func TestCommitOrdered(t *testing.T) {
 	var db dbm.DB = dbm.NewMemDB()
 	multi := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
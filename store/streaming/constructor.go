@@ -9,9 +9,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/plugin"
 	"github.com/cosmos/cosmos-sdk/store/types"
 
 	"github.com/spf13/cast"
+	"github.com/tendermint/tendermint/libs/log"
 )
 
 // ServiceConstructor is used to construct a streaming service
@@ -23,6 +25,7 @@ type ServiceType int
 const (
 	Unknown ServiceType = iota
 	File
+	Plugin
 	// add more in the future
 )
 
@@ -31,6 +34,8 @@ func ServiceTypeFromString(name string) ServiceType {
 	switch strings.ToLower(name) {
 	case "file", "f":
 		return File
+	case "plugin", "p":
+		return Plugin
 	default:
 		return Unknown
 	}
@@ -41,6 +46,8 @@ func (sst ServiceType) String() string {
 	switch sst {
 	case File:
 		return "file"
+	case Plugin:
+		return "plugin"
 	default:
 		return "unknown"
 	}
@@ -48,7 +55,8 @@ func (sst ServiceType) String() string {
 
 // ServiceConstructorLookupTable is a mapping of streaming.ServiceTypes to streaming.ServiceConstructors
 var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
-	File: NewFileStreamingService,
+	File:   NewFileStreamingService,
+	Plugin: NewPluginStreamingService,
 }
 
 // NewServiceConstructor returns the streaming.ServiceConstructor corresponding to the provided name
@@ -70,11 +78,28 @@ func NewFileStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey,
 	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller)
 }
 
+// NewPluginStreamingService is the streaming.ServiceConstructor function for creating a plugin.StreamingService
+func NewPluginStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, _ codec.BinaryCodec) (baseapp.StreamingService, error) {
+	pluginPath := cast.ToString(opts.Get("streamers.plugin.path"))
+	pluginArgs := cast.ToStringSlice(opts.Get("streamers.plugin.args"))
+	pluginTimeout := cast.ToDuration(opts.Get("streamers.plugin.timeout"))
+	return plugin.NewStreamingService(pluginPath, pluginArgs, keys, pluginTimeout, log.NewNopLogger())
+}
+
 // LoadStreamingServices is a function for loading StreamingServices onto the BaseApp using the provided AppOptions, codec, and keys
 // It returns the WaitGroup and quit channel used to synchronize with the streaming services and any error that occurs during the setup
 func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions, appCodec codec.BinaryCodec, keys map[string]*types.KVStoreKey) ([]baseapp.StreamingService, *sync.WaitGroup, error) {
 	// waitgroup and quit channel for optional shutdown coordination of the streaming service(s)
 	wg := new(sync.WaitGroup)
+	// configure whether Commit waits for the streaming service(s) to process the ListenCommit hook
+	commitSync := cast.ToBool(appOpts.Get("streamers.commit_sync"))
+	haltOnError := cast.ToBool(appOpts.Get("streamers.halt_on_error"))
+	// in the background, a failing block is only known once later blocks have been committed
+	if haltOnError && !commitSync {
+		return nil, nil, fmt.Errorf("streamers.halt_on_error requires streamers.commit_sync")
+	}
+	bApp.SetStreamingCommitSync(commitSync)
+	bApp.SetStreamingHaltOnError(haltOnError)
 	// configure state listening capabilities using AppOptions
 	streamers := cast.ToStringSlice(appOpts.Get("store.streamers"))
 	activeStreamers := make([]baseapp.StreamingService, 0, len(streamers))
@@ -116,6 +141,10 @@ func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions
 			}
 			return nil, nil, err
 		}
+		// report the plugin restarts through the BaseApp's logger
+		if pluginService, ok := streamingService.(*plugin.StreamingService); ok {
+			pluginService.SetLogger(bApp.Logger())
+		}
 		// register the streaming service with the BaseApp
 		bApp.SetStreamingService(streamingService)
 		// kick off the background streaming service loop
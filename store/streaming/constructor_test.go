@@ -49,6 +49,18 @@ func TestStreamingServiceConstructor(t *testing.T) {
 	}
 }
 
+func TestPluginStreamingServiceConstructor(t *testing.T) {
+	constructor, err := streaming.NewServiceConstructor("plugin")
+	require.Nil(t, err)
+
+	// the plugin binary is required
+	_, err = constructor(mockOptions, mockKeys, testMarshaller)
+	require.Error(t, err)
+
+	_, err = constructor(pluginAppOptions{path: "/non/existent/plugin"}, mockKeys, testMarshaller)
+	require.Error(t, err)
+}
+
 func TestLoadStreamingServices(t *testing.T) {
 	db := dbm.NewMemDB()
 	encCdc := simapp.MakeTestEncodingConfig()
@@ -82,10 +94,22 @@ func TestLoadStreamingServices(t *testing.T) {
 			require.Equal(t, tc.activeStreamersLen, len(activeStreamers))
 		})
 	}
+
+	// halting on errors requires Commit to wait for the streaming services
+	appOpts := streamingAppOptions{keys: []string{"*"}, haltOnError: true}
+	_, _, err := streaming.LoadStreamingServices(bApp, appOpts, encCdc.Codec, keys)
+	require.Error(t, err)
+
+	appOpts.commitSync = true
+	activeStreamers, _, err := streaming.LoadStreamingServices(bApp, appOpts, encCdc.Codec, keys)
+	require.NoError(t, err)
+	require.Len(t, activeStreamers, 1)
 }
 
 type streamingAppOptions struct {
-	keys []string
+	keys        []string
+	commitSync  bool
+	haltOnError bool
 }
 
 func (ao streamingAppOptions) Get(o string) interface{} {
@@ -94,7 +118,22 @@ func (ao streamingAppOptions) Get(o string) interface{} {
 		return []string{"file"}
 	case "streamers.file.keys":
 		return ao.keys
+	case "streamers.commit_sync":
+		return ao.commitSync
+	case "streamers.halt_on_error":
+		return ao.haltOnError
 	default:
 		return nil
 	}
 }
+
+type pluginAppOptions struct {
+	path string
+}
+
+func (ao pluginAppOptions) Get(o string) interface{} {
+	if o == "streamers.plugin.path" {
+		return ao.path
+	}
+	return nil
+}
@@ -247,6 +247,52 @@ func (fss *StreamingService) openEndBlockFile() (*os.File, error) {
 	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0o600)
 }
 
+// ListenCommit satisfies the baseapp.ABCIListener interface
+// It writes the received Commit response and the state changes of the block out to a file
+// as described in the above the naming schema, and syncs it to disk before returning, so that
+// the file is only present if the block was committed
+func (fss *StreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit, changeSet []*types.StoreKVPair) (rerr error) {
+	// generate the new file
+	dstFile, err := fss.openCommitFile(ctx.BlockHeight())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		cerr := dstFile.Close()
+		if rerr == nil {
+			rerr = cerr
+		}
+	}()
+
+	// write res to file
+	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(&res)
+	if err != nil {
+		return err
+	}
+	if _, err = dstFile.Write(lengthPrefixedResBytes); err != nil {
+		return err
+	}
+	// write the state changes of the block to file
+	for _, kvPair := range changeSet {
+		lengthPrefixedKVPairBytes, err := fss.codec.MarshalLengthPrefixed(kvPair)
+		if err != nil {
+			return err
+		}
+		if _, err = dstFile.Write(lengthPrefixedKVPairBytes); err != nil {
+			return err
+		}
+	}
+	return dstFile.Sync()
+}
+
+func (fss *StreamingService) openCommitFile(height int64) (*os.File, error) {
+	fileName := fmt.Sprintf("block-%d-commit", height)
+	if fss.filePrefix != "" {
+		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
+	}
+	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0o600)
+}
+
 // Stream satisfies the baseapp.StreamingService interface
 // It spins up a goroutine select loop which awaits length-prefixed binary encoded KV pairs
 // and caches them in the order they were received
@@ -0,0 +1,127 @@
+// The file streaming plugin writes the ABCI messages and the committed state changes it receives to a local file,
+// as newline delimited JSON objects. Each object has a single key, the name of the ABCI message, mapped to the
+// JSON encoded plugin request. The commit objects also have a decoded_change_set key, listing the state changes
+// decoded with the key/value schemas of the SimApp modules, or null for the changes no schema matches.
+//
+// It is configured in app.toml with:
+//
+//	[store]
+//	    streamers = ["plugin"]
+//
+//	[streamers]
+//	    [streamers.plugin]
+//	        keys = ["*"]
+//	        path = "path to the built file plugin binary"
+//	        args = ["-out", "path to the output file"]
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/streaming/plugin"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+)
+
+var _ plugin.ABCIListener = &fileListener{}
+
+// fileListener appends the messages it receives to a file.
+type fileListener struct {
+	mtx     sync.Mutex
+	out     *os.File
+	schemas *schema.Registry
+}
+
+func (l *fileListener) write(name string, msg proto.Message) error {
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return err
+	}
+	return l.writeLine(fmt.Sprintf("{%q:%s}\n", name, bz))
+}
+
+func (l *fileListener) writeLine(line string) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	_, err := l.out.WriteString(line)
+	return err
+}
+
+// decode decodes the state changes with the key/value schemas, leaving nil the ones that can't be decoded.
+func (l *fileListener) decode(changeSet []*types.StoreKVPair) []json.RawMessage {
+	decoded := make([]json.RawMessage, len(changeSet))
+	for i, pair := range changeSet {
+		var value []byte
+		if !pair.Delete {
+			value = pair.Value
+		}
+		if bz, err := l.schemas.DecodeJSON(pair.StoreKey, pair.Key, value); err == nil {
+			decoded[i] = bz
+		}
+	}
+	return decoded
+}
+
+func (l *fileListener) ListenBeginBlock(_ context.Context, blockHeight int64, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	return l.write("begin_block", &plugin.ListenBeginBlockRequest{BlockHeight: blockHeight, Req: req, Res: res})
+}
+
+func (l *fileListener) ListenEndBlock(_ context.Context, blockHeight int64, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return l.write("end_block", &plugin.ListenEndBlockRequest{BlockHeight: blockHeight, Req: req, Res: res})
+}
+
+func (l *fileListener) ListenDeliverTx(_ context.Context, blockHeight int64, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	return l.write("deliver_tx", &plugin.ListenDeliverTxRequest{BlockHeight: blockHeight, Req: req, Res: res})
+}
+
+func (l *fileListener) ListenCommit(_ context.Context, blockHeight int64, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	bz, err := codec.ProtoMarshalJSON(&plugin.ListenCommitRequest{BlockHeight: blockHeight, Res: res, ChangeSet: changeSet}, nil)
+	if err != nil {
+		return err
+	}
+	decoded, err := json.Marshal(l.decode(changeSet))
+	if err != nil {
+		return err
+	}
+	if err := l.writeLine(fmt.Sprintf("{%q:%s,%q:%s}\n", "commit", bz, "decoded_change_set", decoded)); err != nil {
+		return err
+	}
+	// the block is only acknowledged once it is persisted
+	return l.out.Sync()
+}
+
+func main() {
+	outPath := flag.String("out", "", "path to the output file")
+	flag.Parse()
+
+	if err := run(*outPath); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(outPath string) error {
+	if outPath == "" {
+		return fmt.Errorf("the -out flag is required")
+	}
+	out, err := os.OpenFile(outPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	schemas := schema.NewRegistry(simapp.MakeTestEncodingConfig().Codec)
+	simapp.ModuleBasics.RegisterKVSchemas(schemas)
+
+	return plugin.Serve(&fileListener{out: out, schemas: schemas})
+}
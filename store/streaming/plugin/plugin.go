@@ -0,0 +1,140 @@
+// Package plugin implements out-of-process streaming plugins.
+//
+// A streaming plugin is a separate binary implementing ABCIListener, which calls
+// Serve from its main function. The node starts the plugin binary, supervises it
+// and streams the ABCI messages and the committed state changes to it over a local
+// gRPC connection, as described in the package README.
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	// ProtocolVersion is the version of the handshake and of the gRPC protocol spoken between a node and its plugins.
+	ProtocolVersion = 1
+
+	// MagicCookieKey and MagicCookieValue are set in the environment of the plugins started by a node.
+	// They are not a security measure, they only let plugin binaries detect that they were executed directly.
+	MagicCookieKey   = "COSMOS_SDK_STREAMING_PLUGIN"
+	MagicCookieValue = "a2b0f5c6e9d14a8b9e3c7f1d6b4a2e80"
+)
+
+// ABCIListener is the interface implemented by streaming plugins. It mirrors the
+// baseapp.ABCIListener interface, with the block height passed explicitly since
+// the sdk.Context of the node is not available to the plugin.
+type ABCIListener interface {
+	// ListenBeginBlock is called with every BeginBlock request and response
+	ListenBeginBlock(ctx context.Context, blockHeight int64, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error
+	// ListenEndBlock is called with every EndBlock request and response
+	ListenEndBlock(ctx context.Context, blockHeight int64, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
+	// ListenDeliverTx is called with every DeliverTx request and response
+	ListenDeliverTx(ctx context.Context, blockHeight int64, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	// ListenCommit is called with every Commit response and the state changes written to the exposed stores
+	// by the committed block. Returning nil acknowledges the block.
+	ListenCommit(ctx context.Context, blockHeight int64, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error
+}
+
+// Serve serves the provided ABCIListener to the node which started the plugin.
+// It blocks until the node stops the plugin, either by closing its standard input
+// or by sending it an interrupt signal.
+func Serve(listener ABCIListener) error {
+	if os.Getenv(MagicCookieKey) != MagicCookieValue {
+		return errors.New("this binary is a streaming plugin, it is meant to be started by a node and not to be executed directly")
+	}
+
+	dir, err := os.MkdirTemp("", "streaming-plugin")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	socket := filepath.Join(dir, "plugin.sock")
+	lis, err := net.Listen("unix", socket)
+	if err != nil {
+		return err
+	}
+
+	srv := grpc.NewServer(grpc.ForceServerCodec(grpcCodec()))
+	RegisterABCIListenerServiceServer(srv, &grpcServer{listener: listener})
+
+	// the handshake tells the node where to reach the plugin
+	if _, err := fmt.Fprintf(os.Stdout, "%d|%s|%s\n", ProtocolVersion, lis.Addr().Network(), lis.Addr().String()); err != nil {
+		return err
+	}
+
+	go func() {
+		sigs := make(chan os.Signal, 1)
+		signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+		// the node holds our standard input open for as long as it runs
+		stdinClosed := make(chan struct{})
+		go func() {
+			_, _ = io.Copy(io.Discard, os.Stdin)
+			close(stdinClosed)
+		}()
+
+		select {
+		case <-sigs:
+		case <-stdinClosed:
+		}
+		srv.GracefulStop()
+	}()
+
+	return srv.Serve(lis)
+}
+
+// grpcCodec returns the codec used to encode the messages exchanged with the plugins.
+func grpcCodec() encoding.Codec {
+	return codec.NewProtoCodec(codectypes.NewInterfaceRegistry()).GRPCCodec()
+}
+
+var _ ABCIListenerServiceServer = &grpcServer{}
+
+// grpcServer serves an ABCIListener over the ABCIListenerService.
+type grpcServer struct {
+	listener ABCIListener
+}
+
+func (s *grpcServer) ListenBeginBlock(ctx context.Context, req *ListenBeginBlockRequest) (*ListenBeginBlockResponse, error) {
+	if err := s.listener.ListenBeginBlock(ctx, req.BlockHeight, req.Req, req.Res); err != nil {
+		return nil, err
+	}
+	return &ListenBeginBlockResponse{}, nil
+}
+
+func (s *grpcServer) ListenEndBlock(ctx context.Context, req *ListenEndBlockRequest) (*ListenEndBlockResponse, error) {
+	if err := s.listener.ListenEndBlock(ctx, req.BlockHeight, req.Req, req.Res); err != nil {
+		return nil, err
+	}
+	return &ListenEndBlockResponse{}, nil
+}
+
+func (s *grpcServer) ListenDeliverTx(ctx context.Context, req *ListenDeliverTxRequest) (*ListenDeliverTxResponse, error) {
+	if err := s.listener.ListenDeliverTx(ctx, req.BlockHeight, req.Req, req.Res); err != nil {
+		return nil, err
+	}
+	return &ListenDeliverTxResponse{}, nil
+}
+
+func (s *grpcServer) ListenCommit(ctx context.Context, req *ListenCommitRequest) (*ListenCommitResponse, error) {
+	if err := s.listener.ListenCommit(ctx, req.BlockHeight, req.Res, req.ChangeSet); err != nil {
+		return nil, err
+	}
+	return &ListenCommitResponse{}, nil
+}
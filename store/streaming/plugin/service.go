@@ -0,0 +1,357 @@
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// handshakeTimeout is how long a plugin has to complete the handshake once started
+	handshakeTimeout = 10 * time.Second
+	// stopTimeout is how long a plugin has to exit once asked to, before it is killed
+	stopTimeout = 5 * time.Second
+	// minRestartBackoff and maxRestartBackoff bound the delay between two restarts of a plugin
+	minRestartBackoff = time.Second
+	maxRestartBackoff = time.Minute
+	// DefaultCallTimeout is how long the plugin has to process an ABCI message, when no timeout is configured
+	DefaultCallTimeout = 10 * time.Second
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// StreamingService is a concrete implementation of StreamingService that starts a streaming plugin,
+// restarts it whenever it exits unexpectedly, and forwards the ABCI messages and the committed state
+// changes of the exposed stores to it
+type StreamingService struct {
+	path      string                                   // path to the plugin binary
+	args      []string                                 // arguments passed to the plugin binary
+	listeners map[types.StoreKey][]types.WriteListener // the exposed stores, the state changes are forwarded at Commit
+	timeout   time.Duration                            // how long the plugin has to process an ABCI message
+	logger    log.Logger
+
+	mtx    sync.Mutex
+	cmd    *exec.Cmd                 // the running plugin process
+	stdin  io.WriteCloser            // closing it asks the plugin to stop
+	exited chan struct{}             // closed when the running plugin process exits
+	conn   *grpc.ClientConn          // connection to the running plugin
+	client ABCIListenerServiceClient // client of the running plugin
+	closed bool                      // whether the service has been closed
+	quit   chan struct{}             // channel to synchronize closure
+}
+
+// NewStreamingService creates a new StreamingService for the plugin binary at the provided path, exposing the provided
+// storeKeys. The plugin is started right away, so that an invalid configuration is reported when the node starts.
+// A call to the plugin which takes longer than timeout fails, DefaultCallTimeout is used if timeout is not positive.
+func NewStreamingService(path string, args []string, storeKeys []types.StoreKey, timeout time.Duration, logger log.Logger) (*StreamingService, error) {
+	if path == "" {
+		return nil, fmt.Errorf("the path to the streaming plugin binary is not set")
+	}
+	if timeout <= 0 {
+		timeout = DefaultCallTimeout
+	}
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	for _, key := range storeKeys {
+		listeners[key] = nil
+	}
+	s := &StreamingService{
+		path:      path,
+		args:      args,
+		listeners: listeners,
+		timeout:   timeout,
+		logger:    logger.With("module", "streaming/plugin", "plugin", path),
+		quit:      make(chan struct{}),
+	}
+	if err := s.start(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// SetLogger sets the logger used to report the plugin restarts
+func (s *StreamingService) SetLogger(logger log.Logger) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.logger = logger.With("module", "streaming/plugin", "plugin", s.path)
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// The plugins receive the state changes of the exposed stores at Commit, so no WriteListener is registered
+func (s *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return s.listeners
+}
+
+// Stream satisfies the baseapp.StreamingService interface
+// It supervises the plugin in the background, restarting it whenever it exits before the service is closed
+func (s *StreamingService) Stream(wg *sync.WaitGroup) error {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.supervise()
+	}()
+	return nil
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+func (s *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	client, err := s.serviceClient()
+	if err != nil {
+		return err
+	}
+	callCtx, cancel := context.WithTimeout(ctx.Context(), s.timeout)
+	defer cancel()
+	_, err = client.ListenBeginBlock(callCtx, &ListenBeginBlockRequest{BlockHeight: ctx.BlockHeight(), Req: req, Res: res})
+	return err
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+func (s *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	client, err := s.serviceClient()
+	if err != nil {
+		return err
+	}
+	callCtx, cancel := context.WithTimeout(ctx.Context(), s.timeout)
+	defer cancel()
+	_, err = client.ListenEndBlock(callCtx, &ListenEndBlockRequest{BlockHeight: ctx.BlockHeight(), Req: req, Res: res})
+	return err
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+func (s *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	client, err := s.serviceClient()
+	if err != nil {
+		return err
+	}
+	callCtx, cancel := context.WithTimeout(ctx.Context(), s.timeout)
+	defer cancel()
+	_, err = client.ListenDeliverTx(callCtx, &ListenDeliverTxRequest{BlockHeight: ctx.BlockHeight(), Req: req, Res: res})
+	return err
+}
+
+// ListenCommit satisfies the baseapp.ABCIListener interface
+// The block is acknowledged once the plugin has successfully processed it
+func (s *StreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	client, err := s.serviceClient()
+	if err != nil {
+		return err
+	}
+	callCtx, cancel := context.WithTimeout(ctx.Context(), s.timeout)
+	defer cancel()
+	_, err = client.ListenCommit(callCtx, &ListenCommitRequest{BlockHeight: ctx.BlockHeight(), Res: res, ChangeSet: changeSet})
+	return err
+}
+
+// Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+// It stops the plugin
+func (s *StreamingService) Close() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	close(s.quit)
+	return s.stop()
+}
+
+// serviceClient returns the client of the running plugin
+func (s *StreamingService) serviceClient() (ABCIListenerServiceClient, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.closed {
+		return nil, fmt.Errorf("streaming plugin %s is closed", s.path)
+	}
+	select {
+	case <-s.exited:
+		return nil, fmt.Errorf("streaming plugin %s is not running", s.path)
+	default:
+		return s.client, nil
+	}
+}
+
+// supervise restarts the plugin whenever it exits before the service is closed
+func (s *StreamingService) supervise() {
+	for {
+		s.mtx.Lock()
+		exited := s.exited
+		s.mtx.Unlock()
+
+		select {
+		case <-s.quit:
+			return
+		case <-exited:
+		}
+
+		backoff := minRestartBackoff
+		for {
+			s.mtx.Lock()
+			closed, logger := s.closed, s.logger
+			s.mtx.Unlock()
+			if closed {
+				return
+			}
+
+			logger.Error("streaming plugin exited, restarting it", "backoff", backoff)
+			select {
+			case <-s.quit:
+				return
+			case <-time.After(backoff):
+			}
+
+			err := s.start()
+			if err == nil {
+				logger.Info("restarted streaming plugin")
+				break
+			}
+			logger.Error("failed to restart streaming plugin", "err", err)
+			if backoff *= 2; backoff > maxRestartBackoff {
+				backoff = maxRestartBackoff
+			}
+		}
+	}
+}
+
+// start starts the plugin process, performs the handshake and connects to it
+func (s *StreamingService) start() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.closed {
+		return fmt.Errorf("streaming plugin %s is closed", s.path)
+	}
+	// release the resources held for the previous process, if any
+	if s.conn != nil {
+		s.conn.Close()
+		s.stdin.Close()
+	}
+
+	stdoutReader, stdoutWriter, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command(s.path, s.args...)
+	cmd.Env = append(os.Environ(), fmt.Sprintf("%s=%s", MagicCookieKey, MagicCookieValue))
+	cmd.Stdout = stdoutWriter
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		stdoutReader.Close()
+		stdoutWriter.Close()
+		return err
+	}
+	err = cmd.Start()
+	// the child process holds its own copy of the write end
+	stdoutWriter.Close()
+	if err != nil {
+		stdoutReader.Close()
+		return fmt.Errorf("failed to start streaming plugin %s: %w", s.path, err)
+	}
+
+	exited := make(chan struct{})
+	go func() {
+		_ = cmd.Wait()
+		close(exited)
+	}()
+
+	handshake := make(chan string, 1)
+	go func() {
+		defer stdoutReader.Close()
+		reader := bufio.NewReader(stdoutReader)
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		handshake <- line
+		// forward whatever the plugin prints afterwards
+		_, _ = io.Copy(os.Stdout, reader)
+	}()
+
+	var line string
+	select {
+	case line = <-handshake:
+	case <-exited:
+		return fmt.Errorf("streaming plugin %s exited before completing the handshake", s.path)
+	case <-time.After(handshakeTimeout):
+		_ = cmd.Process.Kill()
+		return fmt.Errorf("streaming plugin %s did not complete the handshake within %s", s.path, handshakeTimeout)
+	}
+
+	network, address, err := parseHandshake(line)
+	if err != nil {
+		_ = cmd.Process.Kill()
+		return fmt.Errorf("invalid handshake from streaming plugin %s: %w", s.path, err)
+	}
+	conn, err := grpc.Dial(
+		address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(grpcCodec())),
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, addr)
+		}),
+	)
+	if err != nil {
+		_ = cmd.Process.Kill()
+		return fmt.Errorf("failed to connect to streaming plugin %s: %w", s.path, err)
+	}
+
+	s.cmd = cmd
+	s.stdin = stdin
+	s.exited = exited
+	s.conn = conn
+	s.client = NewABCIListenerServiceClient(conn)
+	return nil
+}
+
+// stop asks the running plugin to exit and kills it if it does not in time
+// The caller must hold the lock
+func (s *StreamingService) stop() error {
+	if err := s.conn.Close(); err != nil {
+		s.logger.Error("failed to close the connection to the streaming plugin", "err", err)
+	}
+	// the plugin stops once its standard input is closed
+	_ = s.stdin.Close()
+	select {
+	case <-s.exited:
+		return nil
+	case <-time.After(stopTimeout):
+		return s.cmd.Process.Kill()
+	}
+}
+
+// parseHandshake parses the handshake line written by a plugin, in the format "version|network|address"
+func parseHandshake(line string) (network, address string, err error) {
+	parts := strings.Split(strings.TrimSpace(line), "|")
+	if len(parts) != 3 {
+		return "", "", fmt.Errorf("expected 3 fields, got %d", len(parts))
+	}
+	version, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return "", "", fmt.Errorf("invalid protocol version %q", parts[0])
+	}
+	if version != ProtocolVersion {
+		return "", "", fmt.Errorf("unsupported protocol version %d, expected %d", version, ProtocolVersion)
+	}
+	switch parts[1] {
+	case "unix", "tcp":
+	default:
+		return "", "", fmt.Errorf("unsupported network %q", parts[1])
+	}
+	return parts[1], parts[2], nil
+}
@@ -0,0 +1,175 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	testStoreKey  = types.NewKVStoreKey("mockStore")
+	testChangeSet = []*types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: []byte{1, 2, 3}, Value: []byte{4, 5, 6}},
+		{StoreKey: testStoreKey.Name(), Key: []byte{7, 8, 9}, Delete: true},
+	}
+)
+
+// hangingHeight is the block height at which the test plugin hangs in ListenCommit until the call is canceled.
+const hangingHeight = 1000
+
+// recordingListener is the ABCIListener served by the test plugin, it writes a line per call to the output file.
+type recordingListener struct {
+	out *os.File
+}
+
+func (l recordingListener) record(format string, args ...interface{}) error {
+	_, err := fmt.Fprintf(l.out, format+"\n", args...)
+	return err
+}
+
+func (l recordingListener) ListenBeginBlock(_ context.Context, blockHeight int64, req abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	return l.record("begin %d %d", blockHeight, req.Header.Height)
+}
+
+func (l recordingListener) ListenEndBlock(_ context.Context, blockHeight int64, req abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	return l.record("end %d %d", blockHeight, req.Height)
+}
+
+func (l recordingListener) ListenDeliverTx(_ context.Context, blockHeight int64, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	return l.record("tx %d %x %d", blockHeight, req.Tx, res.Code)
+}
+
+func (l recordingListener) ListenCommit(ctx context.Context, blockHeight int64, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	if blockHeight == hangingHeight {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	if blockHeight < 0 {
+		return fmt.Errorf("invalid height %d", blockHeight)
+	}
+	if err := l.record("commit %d %x", blockHeight, res.Data); err != nil {
+		return err
+	}
+	for _, pair := range changeSet {
+		if err := l.record("pair %s %x %x %t", pair.StoreKey, pair.Key, pair.Value, pair.Delete); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TestHelperPlugin is not a real test, it is the streaming plugin started by the tests below.
+func TestHelperPlugin(t *testing.T) {
+	if os.Getenv(MagicCookieKey) != MagicCookieValue {
+		return
+	}
+	out, err := os.OpenFile(os.Args[len(os.Args)-1], os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		os.Exit(1)
+	}
+	if err := Serve(recordingListener{out: out}); err != nil {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+func newTestStreamingService(t *testing.T, timeout time.Duration) (*StreamingService, string) {
+	out := t.TempDir() + "/out"
+	s, err := NewStreamingService(os.Args[0], []string{"-test.run=TestHelperPlugin", "--", out}, []types.StoreKey{testStoreKey}, timeout, log.NewNopLogger())
+	require.NoError(t, err)
+	t.Cleanup(func() { s.Close() })
+	return s, out
+}
+
+func TestServeNotStartedByNode(t *testing.T) {
+	require.Error(t, Serve(recordingListener{}))
+}
+
+func TestStreamingService(t *testing.T) {
+	s, out := newTestStreamingService(t, 0)
+	require.Equal(t, map[types.StoreKey][]types.WriteListener{testStoreKey: nil}, s.Listeners())
+
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger()).WithBlockHeight(1)
+	require.NoError(t, s.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, s.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte{1, 2}}, abci.ResponseDeliverTx{Code: 3}))
+	require.NoError(t, s.ListenEndBlock(ctx, abci.RequestEndBlock{Height: 1}, abci.ResponseEndBlock{}))
+	require.NoError(t, s.ListenCommit(ctx, abci.ResponseCommit{Data: []byte{0xab}}, testChangeSet))
+
+	// errors returned by the plugin are returned to the node
+	require.Error(t, s.ListenCommit(ctx.WithBlockHeight(-1), abci.ResponseCommit{}, nil))
+
+	require.NoError(t, s.Close())
+	require.Error(t, s.ListenCommit(ctx, abci.ResponseCommit{}, nil))
+
+	bz, err := os.ReadFile(out)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"begin 1 1",
+		"tx 1 0102 3",
+		"end 1 1",
+		"commit 1 ab",
+		"pair mockStore 010203 040506 false",
+		"pair mockStore 070809  true",
+	}, strings.Split(strings.TrimSpace(string(bz)), "\n"))
+}
+
+func TestStreamingServiceRestart(t *testing.T) {
+	s, _ := newTestStreamingService(t, 0)
+	wg := new(sync.WaitGroup)
+	require.NoError(t, s.Stream(wg))
+
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger()).WithBlockHeight(1)
+	require.NoError(t, s.ListenCommit(ctx, abci.ResponseCommit{}, testChangeSet))
+
+	s.mtx.Lock()
+	require.NoError(t, s.cmd.Process.Kill())
+	exited := s.exited
+	s.mtx.Unlock()
+	<-exited
+	require.Error(t, s.ListenCommit(ctx, abci.ResponseCommit{}, testChangeSet))
+
+	// the plugin is restarted in the background
+	require.Eventually(t, func() bool {
+		return s.ListenCommit(ctx, abci.ResponseCommit{}, testChangeSet) == nil
+	}, 10*time.Second, 100*time.Millisecond)
+
+	require.NoError(t, s.Close())
+	wg.Wait()
+}
+
+func TestStreamingServiceTimeout(t *testing.T) {
+	s, _ := newTestStreamingService(t, 100*time.Millisecond)
+
+	// a plugin which does not process a block in time fails the call, instead of blocking the node
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger()).WithBlockHeight(hangingHeight)
+	err := s.ListenCommit(ctx, abci.ResponseCommit{}, testChangeSet)
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	require.NoError(t, s.ListenCommit(ctx.WithBlockHeight(1), abci.ResponseCommit{}, testChangeSet))
+}
+
+func TestParseHandshake(t *testing.T) {
+	network, address, err := parseHandshake("1|unix|/tmp/plugin.sock\n")
+	require.NoError(t, err)
+	require.Equal(t, "unix", network)
+	require.Equal(t, "/tmp/plugin.sock", address)
+
+	for _, line := range []string{"", "1|unix", "x|unix|/tmp/plugin.sock", "2|unix|/tmp/plugin.sock", "1|udp|127.0.0.1:1"} {
+		_, _, err := parseHandshake(line)
+		require.Error(t, err, line)
+	}
+}
@@ -9,6 +9,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// StoreNameCtxKey is the TraceContext metadata key that identifies
+// the store which emitted a given trace.
+const StoreNameCtxKey = "store_name"
+
 const (
 	writeOp     operation = "write"
 	readOp      operation = "read"
@@ -39,6 +43,7 @@ type (
 		Key       string                 `json:"key"`
 		Value     string                 `json:"value"`
 		Metadata  map[string]interface{} `json:"metadata"`
+		Decoded   json.RawMessage        `json:"decoded,omitempty"`
 	}
 )
 
@@ -0,0 +1,84 @@
+package tracekv
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// DecodeFunc decodes an entry of the named store into JSON. The value is nil for the
+// operations without one, e.g. a delete.
+type DecodeFunc func(storeName string, key, value []byte) (json.RawMessage, error)
+
+// decodingWriter adds the decoded entries to the trace operations written to it.
+type decodingWriter struct {
+	mtx    sync.Mutex
+	writer io.Writer
+	decode DecodeFunc
+	buf    []byte
+}
+
+// NewDecodingWriter returns a writer adding to each trace operation written to it the
+// entry decoded by the decode function, under "decoded", before writing it to the given
+// writer. The store of the operation is read from its StoreNameCtxKey metadata. The
+// operations without a key or a store name, and the ones failing to decode, are written
+// unchanged.
+func NewDecodingWriter(writer io.Writer, decode DecodeFunc) io.Writer {
+	return &decodingWriter{writer: writer, decode: decode}
+}
+
+// Write implements io.Writer. The operations are written once their terminating new
+// line is.
+func (dw *decodingWriter) Write(p []byte) (int, error) {
+	dw.mtx.Lock()
+	defer dw.mtx.Unlock()
+
+	dw.buf = append(dw.buf, p...)
+	for {
+		i := bytes.IndexByte(dw.buf, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		if _, err := dw.writer.Write(dw.decodeOperation(dw.buf[:i+1])); err != nil {
+			return 0, err
+		}
+		dw.buf = append(dw.buf[:0], dw.buf[i+1:]...)
+	}
+}
+
+// decodeOperation returns the given line, with the decoded entry if the line is a trace
+// operation which can be decoded.
+func (dw *decodingWriter) decodeOperation(line []byte) []byte {
+	var op traceOperation
+	dec := json.NewDecoder(bytes.NewReader(line))
+	dec.UseNumber()
+	if err := dec.Decode(&op); err != nil {
+		return line
+	}
+
+	storeName, ok := op.Metadata[StoreNameCtxKey].(string)
+	if !ok || op.Key == "" {
+		return line
+	}
+	key, err := base64.StdEncoding.DecodeString(op.Key)
+	if err != nil {
+		return line
+	}
+	var value []byte
+	if op.Value != "" {
+		if value, err = base64.StdEncoding.DecodeString(op.Value); err != nil {
+			return line
+		}
+	}
+
+	if op.Decoded, err = dw.decode(storeName, key, value); err != nil {
+		return line
+	}
+	raw, err := json.Marshal(op)
+	if err != nil {
+		return line
+	}
+	return append(raw, '\n')
+}
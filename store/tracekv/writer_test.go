@@ -0,0 +1,63 @@
+package tracekv_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func decodeStore1(storeName string, key, value []byte) (json.RawMessage, error) {
+	if storeName != "store1" {
+		return nil, errors.New("unknown store")
+	}
+	return json.Marshal(fmt.Sprintf("%s=%s", key, value))
+}
+
+func TestDecodingWriter(t *testing.T) {
+	testCases := map[string]struct {
+		tc          types.TraceContext
+		expectedOut string
+	}{
+		"decoded": {
+			tc: types.TraceContext{"blockHeight": 64, tracekv.StoreNameCtxKey: "store1"},
+			expectedOut: "{\"operation\":\"write\",\"key\":\"a2V5MDAwMDAwMDE=\",\"value\":\"dmFsdWUwMDAwMDAwMQ==\",\"metadata\":{\"blockHeight\":64,\"store_name\":\"store1\"},\"decoded\":\"key00000001=value00000001\"}\n" +
+				"{\"operation\":\"read\",\"key\":\"a2V5MDAwMDAwMDE=\",\"value\":\"dmFsdWUwMDAwMDAwMQ==\",\"metadata\":{\"blockHeight\":64,\"store_name\":\"store1\"},\"decoded\":\"key00000001=value00000001\"}\n" +
+				"{\"operation\":\"delete\",\"key\":\"a2V5MDAwMDAwMDE=\",\"value\":\"\",\"metadata\":{\"blockHeight\":64,\"store_name\":\"store1\"},\"decoded\":\"key00000001=\"}\n",
+		},
+		"decoding failure": {
+			tc: types.TraceContext{"blockHeight": 64, tracekv.StoreNameCtxKey: "store2"},
+			expectedOut: "{\"operation\":\"write\",\"key\":\"a2V5MDAwMDAwMDE=\",\"value\":\"dmFsdWUwMDAwMDAwMQ==\",\"metadata\":{\"blockHeight\":64,\"store_name\":\"store2\"}}\n" +
+				"{\"operation\":\"read\",\"key\":\"a2V5MDAwMDAwMDE=\",\"value\":\"dmFsdWUwMDAwMDAwMQ==\",\"metadata\":{\"blockHeight\":64,\"store_name\":\"store2\"}}\n" +
+				"{\"operation\":\"delete\",\"key\":\"a2V5MDAwMDAwMDE=\",\"value\":\"\",\"metadata\":{\"blockHeight\":64,\"store_name\":\"store2\"}}\n",
+		},
+		"no store name": {
+			tc: types.TraceContext{"blockHeight": 64},
+			expectedOut: "{\"operation\":\"write\",\"key\":\"a2V5MDAwMDAwMDE=\",\"value\":\"dmFsdWUwMDAwMDAwMQ==\",\"metadata\":{\"blockHeight\":64}}\n" +
+				"{\"operation\":\"read\",\"key\":\"a2V5MDAwMDAwMDE=\",\"value\":\"dmFsdWUwMDAwMDAwMQ==\",\"metadata\":{\"blockHeight\":64}}\n" +
+				"{\"operation\":\"delete\",\"key\":\"a2V5MDAwMDAwMDE=\",\"value\":\"\",\"metadata\":{\"blockHeight\":64}}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+			store := tracekv.NewStore(memDB, tracekv.NewDecodingWriter(&buf, decodeStore1), tc.tc)
+
+			store.Set(kvPairs[0].Key, kvPairs[0].Value)
+			store.Get(kvPairs[0].Key)
+			store.Delete(kvPairs[0].Key)
+
+			require.Equal(t, tc.expectedOut, buf.String())
+		})
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"io"
+	"sync"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -45,3 +46,40 @@ func (wl *StoreKVPairWriteListener) OnWrite(storeKey StoreKey, key []byte, value
 	}
 	return nil
 }
+
+// MemoryListener is a WriteListener which accumulates the StoreKVPairs written
+// to the KVStores in memory, until they're popped
+type MemoryListener struct {
+	stateCache     []*StoreKVPair
+	stateCacheLock sync.Mutex
+}
+
+// NewMemoryListener creates a new MemoryListener
+func NewMemoryListener() *MemoryListener {
+	return &MemoryListener{}
+}
+
+// OnWrite satisfies the WriteListener interface by caching the StoreKVPair
+func (ml *MemoryListener) OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error {
+	ml.stateCacheLock.Lock()
+	defer ml.stateCacheLock.Unlock()
+
+	ml.stateCache = append(ml.stateCache, &StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+	return nil
+}
+
+// PopStateCache returns the StoreKVPairs cached since the last call, in the
+// order they were written, and resets the cache
+func (ml *MemoryListener) PopStateCache() []*StoreKVPair {
+	ml.stateCacheLock.Lock()
+	defer ml.stateCacheLock.Unlock()
+
+	res := ml.stateCache
+	ml.stateCache = nil
+	return res
+}
@@ -64,3 +64,25 @@ func TestOnWrite(t *testing.T) {
 	testMarshaller.UnmarshalLengthPrefixed(outputBytes, outputKVPair)
 	require.EqualValues(t, expectedOutputKVPair, outputKVPair)
 }
+
+func TestMemoryListener(t *testing.T) {
+	ml := NewMemoryListener()
+
+	testStoreKey := NewKVStoreKey("test_key")
+	testKey := []byte("testing123")
+	testValue := []byte("testing321")
+
+	require.Empty(t, ml.PopStateCache())
+
+	require.NoError(t, ml.OnWrite(testStoreKey, testKey, testValue, false))
+	require.NoError(t, ml.OnWrite(testStoreKey, testKey, nil, true))
+
+	expectedKVPairs := []*StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: testKey, Value: testValue, Delete: false},
+		{StoreKey: testStoreKey.Name(), Key: testKey, Delete: true},
+	}
+	require.Equal(t, expectedKVPairs, ml.PopStateCache())
+
+	// the cache is reset once popped
+	require.Empty(t, ml.PopStateCache())
+}
@@ -190,6 +190,29 @@ type CommitMultiStore interface {
 	SetIAVLCacheSize(size int)
 }
 
+// StorePruner is implemented by the CommitMultiStores supporting pruning options specific
+// to some of their stores, which are then pruned independently of the other stores.
+type StorePruner interface {
+	// SetStorePruning sets the pruning options of the named store, overriding the ones set
+	// with SetPruning.
+	SetStorePruning(storeName string, opts pruningtypes.PruningOptions)
+
+	// GetStorePruning returns the pruning options of the named store.
+	GetStorePruning(storeName string) pruningtypes.PruningOptions
+
+	// EarliestVersion returns the earliest version of the named store from which all the
+	// versions are kept, i.e. its earliest queryable version.
+	EarliestVersion(storeName string) int64
+}
+
+// AsyncPruner is implemented by the CommitMultiStores able to remove the pruned heights from
+// disk in the background, so that committing state does not wait for the pruning.
+type AsyncPruner interface {
+	// SetAsyncPruning sets the options of the background pruning. It must be called before
+	// loading a version of the store.
+	SetAsyncPruning(opts pruningtypes.AsyncPruningOptions)
+}
+
 //---------subsp-------------------------------
 // KVStore
 
@@ -0,0 +1,363 @@
+package multi
+
+import (
+	"fmt"
+	"io"
+
+	protoio "github.com/gogo/protobuf/io"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	types "github.com/cosmos/cosmos-sdk/store/v2alpha1"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ storetypes.CommitMultiStore = (*CompatStore)(nil)
+	_ storetypes.Queryable        = (*CompatStore)(nil)
+)
+
+// CompatStore adapts a Store to the CommitMultiStore interface of the store/types package,
+// which BaseApp runs on. The substores are declared by mounting them, and the Store is opened
+// when loading the latest version.
+//
+// The IAVL specific options, i.e. the inter-block cache and the IAVL cache size, are ignored,
+// and the substores cannot be accessed as CommitStores.
+type CompatStore struct {
+	db     dbm.Connection
+	config StoreConfig
+	store  *Store
+	// root is the parent store of the cache multistores, which is never written to
+	root types.KVStore
+
+	keysByName       map[string]storetypes.StoreKey
+	snapshotInterval uint64
+
+	traceWriter  io.Writer
+	traceContext storetypes.TraceContext
+	listeners    map[storetypes.StoreKey][]storetypes.WriteListener
+}
+
+// NewCompatStore returns a CompatStore storing the state in the given DB, with the given
+// configuration. The substores of the configuration schema are extended with the mounted ones.
+func NewCompatStore(db dbm.Connection, config StoreConfig) *CompatStore {
+	return &CompatStore{
+		db:         db,
+		config:     config,
+		root:       dbadapter.Store{DB: tmdb.NewMemDB()},
+		keysByName: make(map[string]storetypes.StoreKey),
+		listeners:  make(map[storetypes.StoreKey][]storetypes.WriteListener),
+	}
+}
+
+// Close closes the underlying Store, if loaded.
+func (cs *CompatStore) Close() error {
+	if cs.store == nil {
+		return nil
+	}
+	err := cs.store.Close()
+	cs.store = nil
+	return err
+}
+
+// GetStoreType implements Store.
+func (cs *CompatStore) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeMulti
+}
+
+// CacheWrap implements CacheWrapper.
+func (cs *CompatStore) CacheWrap() storetypes.CacheWrap {
+	return cs.CacheMultiStore().(storetypes.CacheWrap)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (cs *CompatStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return cs.CacheWrap()
+}
+
+// CacheWrapWithListeners implements CacheWrapper.
+func (cs *CompatStore) CacheWrapWithListeners(_ storetypes.StoreKey, _ []storetypes.WriteListener) storetypes.CacheWrap {
+	return cs.CacheWrap()
+}
+
+// MountStoreWithDB implements CommitMultiStore. The IAVL, DB and SMT stores are mounted as
+// persistent substores. Separate DBs are not supported.
+func (cs *CompatStore) MountStoreWithDB(key storetypes.StoreKey, typ storetypes.StoreType, db tmdb.DB) {
+	if key == nil {
+		panic("MountStoreWithDB() key cannot be nil")
+	}
+	if db != nil {
+		panic(fmt.Sprintf("cannot mount store %s with a separate DB", key.Name()))
+	}
+	if cs.store != nil {
+		panic(fmt.Sprintf("cannot mount store %s after loading", key.Name()))
+	}
+
+	switch typ {
+	case storetypes.StoreTypeIAVL, storetypes.StoreTypeDB, storetypes.StoreTypeSMT, storetypes.StoreTypePersistent:
+		typ = storetypes.StoreTypePersistent
+	case storetypes.StoreTypeMemory, storetypes.StoreTypeTransient:
+	default:
+		panic(fmt.Sprintf("unsupported store type %s of store %s", typ, key.Name()))
+	}
+	if err := cs.config.RegisterSubstore(key.Name(), typ); err != nil {
+		panic(err)
+	}
+	cs.keysByName[key.Name()] = key
+}
+
+// GetCommitStore implements CommitMultiStore. It panics, as the substores are not CommitStores.
+func (cs *CompatStore) GetCommitStore(key storetypes.StoreKey) storetypes.CommitStore {
+	panic(fmt.Sprintf("store %s is not a CommitStore", key.Name()))
+}
+
+// GetCommitKVStore implements CommitMultiStore. It panics, as the substores are not CommitStores.
+func (cs *CompatStore) GetCommitKVStore(key storetypes.StoreKey) storetypes.CommitKVStore {
+	panic(fmt.Sprintf("store %s is not a CommitKVStore", key.Name()))
+}
+
+// LoadLatestVersion implements CommitMultiStore.
+func (cs *CompatStore) LoadLatestVersion() error {
+	return cs.load(nil)
+}
+
+// LoadLatestVersionAndUpgrade implements CommitMultiStore. The upgrades are applied to the
+// schema of the latest version, which is the mounted one once upgraded.
+func (cs *CompatStore) LoadLatestVersionAndUpgrade(upgrades *storetypes.StoreUpgrades) error {
+	return cs.load(upgrades)
+}
+
+// LoadVersion implements CommitMultiStore. Only the latest version can be loaded.
+func (cs *CompatStore) LoadVersion(ver int64) error {
+	return cs.LoadVersionAndUpgrade(ver, nil)
+}
+
+// LoadVersionAndUpgrade implements CommitMultiStore. Only the latest version can be loaded.
+func (cs *CompatStore) LoadVersionAndUpgrade(ver int64, upgrades *storetypes.StoreUpgrades) error {
+	if err := cs.load(upgrades); err != nil {
+		return err
+	}
+	if latest := cs.store.LastCommitID().Version; ver != 0 && ver != latest {
+		return fmt.Errorf("cannot load version %d, only the latest version %d can be loaded", ver, latest)
+	}
+	return nil
+}
+
+func (cs *CompatStore) load(upgrades *storetypes.StoreUpgrades) error {
+	if err := cs.Close(); err != nil {
+		return err
+	}
+
+	config := cs.config
+	if upgrades != nil {
+		var err error
+		if config.prefixRegistry, err = config.preUpgrade(*upgrades); err != nil {
+			return err
+		}
+		config.Upgrades = append(config.Upgrades, *upgrades)
+	}
+
+	store, err := NewStore(cs.db, config)
+	if err != nil {
+		return err
+	}
+	store.SetSnapshotInterval(cs.snapshotInterval)
+	cs.store = store
+	return nil
+}
+
+// preUpgrade returns the schema from which the upgrades lead to this one.
+func (pr prefixRegistry) preUpgrade(upgrades storetypes.StoreUpgrades) (prefixRegistry, error) {
+	schema := make(StoreSchema, len(pr.StoreSchema))
+	for key, typ := range pr.StoreSchema {
+		schema[key] = typ
+	}
+	for _, key := range upgrades.Added {
+		delete(schema, key)
+	}
+	for _, rename := range upgrades.Renamed {
+		delete(schema, rename.NewKey)
+		schema[rename.OldKey] = types.StoreTypePersistent
+	}
+	for _, key := range upgrades.Deleted {
+		schema[key] = types.StoreTypePersistent
+	}
+
+	ret := prefixRegistry{StoreSchema: StoreSchema{}}
+	for key, typ := range schema {
+		if err := ret.RegisterSubstore(key, typ); err != nil {
+			return prefixRegistry{}, err
+		}
+	}
+	return ret, nil
+}
+
+func (cs *CompatStore) loaded() *Store {
+	if cs.store == nil {
+		panic("store is not loaded")
+	}
+	return cs.store
+}
+
+// Commit implements Committer.
+func (cs *CompatStore) Commit() storetypes.CommitID {
+	return cs.loaded().Commit()
+}
+
+// LastCommitID implements Committer.
+func (cs *CompatStore) LastCommitID() storetypes.CommitID {
+	if cs.store == nil {
+		return storetypes.CommitID{}
+	}
+	return cs.store.LastCommitID()
+}
+
+// SetPruning implements Committer.
+func (cs *CompatStore) SetPruning(opts pruningtypes.PruningOptions) {
+	cs.config.Pruning = opts
+	if cs.store != nil {
+		cs.store.SetPruning(opts)
+	}
+}
+
+// GetPruning implements Committer.
+func (cs *CompatStore) GetPruning() pruningtypes.PruningOptions {
+	return cs.config.Pruning
+}
+
+// SetInterBlockCache implements CommitMultiStore. The inter-block cache is not supported.
+func (cs *CompatStore) SetInterBlockCache(storetypes.MultiStorePersistentCache) {}
+
+// SetInitialVersion implements CommitMultiStore.
+func (cs *CompatStore) SetInitialVersion(version int64) error {
+	cs.config.InitialVersion = uint64(version)
+	if cs.store != nil {
+		return cs.store.SetInitialVersion(uint64(version))
+	}
+	return nil
+}
+
+// SetIAVLCacheSize implements CommitMultiStore. It is a no-op.
+func (cs *CompatStore) SetIAVLCacheSize(int) {}
+
+// CacheMultiStore implements MultiStore.
+func (cs *CompatStore) CacheMultiStore() storetypes.CacheMultiStore {
+	store := cs.loaded()
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(cs.keysByName))
+	for _, key := range cs.keysByName {
+		stores[key] = store.GetKVStore(key)
+	}
+	return cachemulti.NewFromKVStore(cs.root, stores, cs.keysByName, cs.traceWriter, cs.traceContext, cs.listeners)
+}
+
+// CacheMultiStoreWithVersion implements MultiStore. The persistent substores are loaded at
+// the given version, while the memory and transient ones are the current ones.
+func (cs *CompatStore) CacheMultiStoreWithVersion(version int64) (storetypes.CacheMultiStore, error) {
+	store := cs.loaded()
+	view, err := store.getView(version)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to load version %d", version)
+	}
+
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(cs.keysByName))
+	for name, key := range cs.keysByName {
+		if store.schema[name] != types.StoreTypePersistent {
+			stores[key] = store.GetKVStore(key)
+			continue
+		}
+		if _, has := view.schema[name]; !has {
+			return nil, fmt.Errorf("store %s does not exist at version %d", name, version)
+		}
+		stores[key] = view.GetKVStore(key)
+	}
+	return cachemulti.NewFromKVStore(cs.root, stores, cs.keysByName, cs.traceWriter, cs.traceContext, cs.listeners), nil
+}
+
+// GetStore implements MultiStore.
+func (cs *CompatStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return cs.GetKVStore(key)
+}
+
+// GetKVStore implements MultiStore.
+func (cs *CompatStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	store := cs.loaded().GetKVStore(key)
+	if cs.TracingEnabled() {
+		tctx := cs.traceContext.Clone().Merge(storetypes.TraceContext{
+			tracekv.StoreNameCtxKey: key.Name(),
+		})
+		store = tracekv.NewStore(store, cs.traceWriter, tctx)
+	}
+	if cs.ListeningEnabled(key) {
+		store = listenkv.NewStore(store, key, cs.listeners[key])
+	}
+	return store
+}
+
+// TracingEnabled implements MultiStore.
+func (cs *CompatStore) TracingEnabled() bool {
+	return cs.traceWriter != nil
+}
+
+// SetTracer implements MultiStore.
+func (cs *CompatStore) SetTracer(w io.Writer) storetypes.MultiStore {
+	cs.traceWriter = w
+	return cs
+}
+
+// SetTracingContext implements MultiStore.
+func (cs *CompatStore) SetTracingContext(tc storetypes.TraceContext) storetypes.MultiStore {
+	if cs.traceContext == nil {
+		cs.traceContext = tc
+		return cs
+	}
+	for k, v := range tc {
+		cs.traceContext[k] = v
+	}
+	return cs
+}
+
+// ListeningEnabled implements MultiStore.
+func (cs *CompatStore) ListeningEnabled(key storetypes.StoreKey) bool {
+	return len(cs.listeners[key]) != 0
+}
+
+// AddListeners implements MultiStore.
+func (cs *CompatStore) AddListeners(key storetypes.StoreKey, listeners []storetypes.WriteListener) {
+	cs.listeners[key] = append(cs.listeners[key], listeners...)
+}
+
+// Query implements Queryable.
+func (cs *CompatStore) Query(req abci.RequestQuery) abci.ResponseQuery {
+	return cs.loaded().Query(req)
+}
+
+// Snapshot implements Snapshotter.
+func (cs *CompatStore) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	return cs.loaded().Snapshot(height, protoWriter)
+}
+
+// Restore implements Snapshotter.
+func (cs *CompatStore) Restore(height uint64, format uint32, protoReader protoio.Reader) (snapshottypes.SnapshotItem, error) {
+	return cs.loaded().Restore(height, format, protoReader)
+}
+
+// PruneSnapshotHeight implements Snapshotter.
+func (cs *CompatStore) PruneSnapshotHeight(height int64) {
+	cs.loaded().PruneSnapshotHeight(height)
+}
+
+// SetSnapshotInterval implements Snapshotter.
+func (cs *CompatStore) SetSnapshotInterval(snapshotInterval uint64) {
+	cs.snapshotInterval = snapshotInterval
+	if cs.store != nil {
+		cs.store.SetSnapshotInterval(snapshotInterval)
+	}
+}
@@ -0,0 +1,100 @@
+package multi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestCompatStore(t *testing.T) {
+	db := memdb.NewDB()
+	kvKey := storetypes.NewKVStoreKey("kv")
+	memKey := storetypes.NewMemoryStoreKey("mem")
+	tranKey := storetypes.NewTransientStoreKey("tran")
+
+	newStore := func(keys ...storetypes.StoreKey) *CompatStore {
+		cs := NewCompatStore(db, DefaultStoreConfig())
+		cs.MountStoreWithDB(kvKey, storetypes.StoreTypeIAVL, nil)
+		cs.MountStoreWithDB(memKey, storetypes.StoreTypeMemory, nil)
+		cs.MountStoreWithDB(tranKey, storetypes.StoreTypeTransient, nil)
+		for _, key := range keys {
+			cs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+		}
+		return cs
+	}
+
+	cs := newStore()
+	require.Panics(t, func() { cs.MountStoreWithDB(kvKey, storetypes.StoreTypeIAVL, nil) })
+	require.NoError(t, cs.LoadLatestVersion())
+	require.Panics(t, func() { cs.GetCommitKVStore(kvKey) })
+	require.Equal(t, storetypes.CommitID{}, cs.LastCommitID())
+
+	// writes go through the cache multistores
+	cacheMS := cs.CacheMultiStore()
+	cacheMS.GetKVStore(kvKey).Set([]byte("a"), []byte("1"))
+	cacheMS.GetKVStore(memKey).Set([]byte("b"), []byte("2"))
+	cacheMS.GetKVStore(tranKey).Set([]byte("c"), []byte("3"))
+	require.Nil(t, cs.GetKVStore(kvKey).Get([]byte("a")))
+	cacheMS.Write()
+	require.Equal(t, []byte("1"), cs.GetKVStore(kvKey).Get([]byte("a")))
+	require.Equal(t, []byte("2"), cs.GetKVStore(memKey).Get([]byte("b")))
+	require.Equal(t, []byte("3"), cs.GetKVStore(tranKey).Get([]byte("c")))
+
+	cid := cs.Commit()
+	require.Equal(t, int64(1), cid.Version)
+	require.Nil(t, cs.GetKVStore(tranKey).Get([]byte("c")))
+
+	cs.GetKVStore(kvKey).Set([]byte("a"), []byte("4"))
+	cs.Commit()
+
+	// the persistent stores are loaded at the version
+	cacheMS, err := cs.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), cacheMS.GetKVStore(kvKey).Get([]byte("a")))
+	require.Equal(t, []byte("2"), cacheMS.GetKVStore(memKey).Get([]byte("b")))
+	_, err = cs.CacheMultiStoreWithVersion(3)
+	require.Error(t, err)
+
+	res := cs.Query(abci.RequestQuery{Path: "/kv/key", Data: []byte("a"), Height: 2, Prove: true})
+	require.Equal(t, uint32(0), res.Code, res.Log)
+	require.Equal(t, []byte("4"), res.Value)
+	require.NotNil(t, res.ProofOps)
+
+	lastCommitID := cs.LastCommitID()
+	require.NoError(t, cs.Close())
+
+	// only the latest version can be loaded
+	cs = newStore()
+	require.Error(t, cs.LoadVersion(1))
+	require.NoError(t, cs.LoadVersion(2))
+	require.Equal(t, lastCommitID, cs.LastCommitID())
+	require.Equal(t, []byte("4"), cs.GetKVStore(kvKey).Get([]byte("a")))
+	require.Nil(t, cs.GetKVStore(memKey).Get([]byte("b")))
+	require.NoError(t, cs.Close())
+
+	// memory and transient stores can be added or removed without upgrades
+	cs = NewCompatStore(db, DefaultStoreConfig())
+	cs.MountStoreWithDB(kvKey, storetypes.StoreTypeIAVL, nil)
+	cs.MountStoreWithDB(storetypes.NewMemoryStoreKey("mem2"), storetypes.StoreTypeMemory, nil)
+	require.NoError(t, cs.LoadLatestVersion())
+	require.NoError(t, cs.Close())
+
+	// a persistent store is added with upgrades
+	addedKey := storetypes.NewKVStoreKey("added")
+	cs = newStore(addedKey)
+	require.Error(t, cs.LoadLatestVersion())
+	cs = newStore(addedKey)
+	require.NoError(t, cs.LoadLatestVersionAndUpgrade(&storetypes.StoreUpgrades{Added: []string{addedKey.Name()}}))
+	cs.GetKVStore(addedKey).Set([]byte("d"), []byte("5"))
+	cs.Commit()
+	require.NoError(t, cs.Close())
+
+	cs = newStore(addedKey)
+	require.NoError(t, cs.LoadLatestVersion())
+	require.Equal(t, []byte("5"), cs.GetKVStore(addedKey).Get([]byte("d")))
+	require.NoError(t, cs.Close())
+}
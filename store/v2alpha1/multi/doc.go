@@ -2,8 +2,9 @@
 // CommitMultiStore, CacheMultiStore, and BasicMultiStore (as read-only stores at past versions).
 //
 // Substores are declared as part of a schema within StoreOptions.
-// The schema cannot be changed once a CommitMultiStore is initialized, and changes to the schema must be done
-// by migrating via StoreOptions.Upgrades. If a past version is accessed, it will be loaded with the past schema.
+// The persistent substores of the schema cannot be changed once a CommitMultiStore is initialized, and changes
+// to them must be done by migrating via StoreOptions.Upgrades, whereas the memory and transient substores are
+// the ones of the configured schema. If a past version is accessed, it will be loaded with the past schema.
 // Stores may be declared as StoreTypePersistent, StoreTypeMemory (not persisted after close), or
 // StoreTypeTransient (not persisted across commits). Non-persistent substores cannot be migrated or accessed
 // in past versions.
@@ -15,5 +16,8 @@
 // Each substore's SC is allocated as an independent SMT, and query proofs contain two components: a proof
 // of a key's (non)existence within the substore SMT, and a proof of the substore's existence within the
 // MultiStore (using the Merkle map proof spec (TendermintSpec)).
+//
+// CompatStore adapts a Store to the CommitMultiStore interface of store/types, so that BaseApp can run on it,
+// and MigrateFromV1 migrates the state of an IAVL based rootmulti.Store in place.
 
 package multi
@@ -10,7 +10,11 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// MigrateFromV1 will migrate the state from iavl to smt
+// MigrateFromV1 will migrate the state from iavl to smt.
+// The IAVL stores are migrated to persistent substores, and the memory and transient stores are
+// declared as such. The migrated version is the latest version of the IAVL state, and its root
+// hash is the one of the IAVL state, so that the application hash of the latest block does not
+// change; the next version is committed with the SMT root hash.
 func MigrateFromV1(rootMultiStore *v1Store.Store, store2db dbm.Connection, storeConfig StoreConfig) (*Store, error) {
 	type namedStore struct {
 		*iavl.Store
@@ -26,8 +30,14 @@ func MigrateFromV1(rootMultiStore *v1Store.Store, store2db dbm.Connection, store
 				return nil, err
 			}
 			stores = append(stores, namedStore{name: keyName, Store: store})
-		case *transient.Store, *mem.Store:
-			continue
+		case *transient.Store:
+			if err := storeConfig.RegisterSubstore(keyName, types.StoreTypeTransient); err != nil {
+				return nil, err
+			}
+		case *mem.Store:
+			if err := storeConfig.RegisterSubstore(keyName, types.StoreTypeMemory); err != nil {
+				return nil, err
+			}
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "don't know how to migrate store %q of type %T", keyName, store)
 		}
@@ -59,7 +69,8 @@ func MigrateFromV1(rootMultiStore *v1Store.Store, store2db dbm.Connection, store
 	}
 
 	// commit the all key/values from iavl to smt tree (SMT Store)
-	_, err = rootStore.commit(uint64(rootMultiStore.LastCommitID().Version))
+	lastCommitID := rootMultiStore.LastCommitID()
+	_, err = rootStore.commitWithHash(uint64(lastCommitID.Version), lastCommitID.Hash)
 	if err != nil {
 		return nil, err
 	}
@@ -29,6 +29,10 @@ func TestMigrationV2(t *testing.T) {
 		v1Store.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
 		keys = append(keys, key)
 	}
+	memKey := types.NewMemoryStoreKey("mem")
+	v1Store.MountStoreWithDB(memKey, types.StoreTypeMemory, nil)
+	tranKey := types.NewTransientStoreKey("tran")
+	v1Store.MountStoreWithDB(tranKey, types.StoreTypeTransient, nil)
 
 	err := v1Store.LoadLatestVersion()
 	require.Nil(t, err)
@@ -86,6 +90,15 @@ func TestMigrationV2(t *testing.T) {
 			}
 			require.Equal(t, v2Store.LastCommitID().Version, v1Store.LastCommitID().Version)
 		}
+		require.Equal(t, types.StoreTypeMemory, v2Store.schema[memKey.Name()])
+		require.Equal(t, types.StoreTypeTransient, v2Store.schema[tranKey.Name()])
+		if !testCase.emptyStore {
+			// the app hash of the migrated version is unchanged
+			require.Equal(t, v1Store.LastCommitID(), v2Store.LastCommitID())
+			next := v2Store.Commit()
+			require.Equal(t, v1Store.LastCommitID().Version+1, next.Version)
+			require.NotEqual(t, v1Store.LastCommitID().Hash, next.Hash)
+		}
 		err = v2Store.Close()
 		require.NoError(t, err)
 	}
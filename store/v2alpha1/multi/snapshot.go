@@ -101,6 +101,9 @@ func (rs *Store) Restore(
 	if err := snapshots.ValidRestoreHeight(format, height); err != nil {
 		return snapshottypes.SnapshotItem{}, err
 	}
+	if format == snapshottypes.FormatIncremental {
+		return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(snapshottypes.ErrUnknownFormat, "incremental snapshots are not supported")
+	}
 
 	if rs.LastCommitID().Version != 0 {
 		return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot restore snapshot for non empty store at height %v", height)
@@ -129,7 +132,7 @@ loop:
 				receivedStoreSchema[string(sKey)] = types.StoreTypePersistent
 			}
 
-			if !rs.schema.equal(receivedStoreSchema) {
+			if !rs.schema.persistent().equal(receivedStoreSchema) {
 				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "received schema does not match app schema")
 			}
 
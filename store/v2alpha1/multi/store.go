@@ -1,6 +1,7 @@
 package multi
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
@@ -40,6 +41,8 @@ var (
 	schemaPrefix  = []byte{1} // Prefix for store keys (namespaces)
 	contentPrefix = []byte{2} // Prefix for store contents
 
+	prunedSnapshotHeightsKey = []byte{3} // Key for snapshot heights waiting to be pruned
+
 	// Per-substore prefixes
 	substoreMerkleRootKey = []byte{0} // Key for root hashes of Merkle trees
 	dataPrefix            = []byte{1} // Prefix for state mappings
@@ -99,6 +102,12 @@ type Store struct {
 
 	PersistentCache types.MultiStorePersistentCache
 	substoreCache   map[string]*substore
+
+	// Heights kept for state sync snapshots, and the ones whose snapshot is complete, which
+	// are pruned at the next pruning interval
+	snapshotInterval      uint64
+	snapshotMtx           sync.Mutex
+	prunedSnapshotHeights []int64
 }
 
 type substore struct {
@@ -192,6 +201,41 @@ func (ss StoreSchema) equal(that StoreSchema) bool {
 	return true
 }
 
+// Returns the persistent substores of the schema
+func (ss StoreSchema) persistent() StoreSchema {
+	ret := StoreSchema{}
+	for key, typ := range ss {
+		if typ == types.StoreTypePersistent {
+			ret[key] = typ
+		}
+	}
+	return ret
+}
+
+// Replaces the memory and transient substores with the ones of the given schema. They hold no
+// state, so they may be added or removed without upgrades.
+func (pr *prefixRegistry) setNonPersistent(schema StoreSchema) error {
+	ret := prefixRegistry{StoreSchema: StoreSchema{}}
+	for _, key := range pr.reserved {
+		if typ, has := pr.StoreSchema[key]; !has || typ == types.StoreTypePersistent {
+			ret.reserved = append(ret.reserved, key)
+			if has {
+				ret.StoreSchema[key] = typ
+			}
+		}
+	}
+	for key, typ := range schema {
+		if typ == types.StoreTypePersistent {
+			continue
+		}
+		if err := ret.RegisterSubstore(key, typ); err != nil {
+			return err
+		}
+	}
+	*pr = ret
+	return nil
+}
+
 // Parses a schema from the DB
 func readSavedSchema(bucket dbm.Reader) (*prefixRegistry, error) {
 	ret := prefixRegistry{StoreSchema: StoreSchema{}}
@@ -276,6 +320,13 @@ func NewStore(db dbm.Connection, opts StoreConfig) (ret *Store, err error) {
 		InitialVersion: opts.InitialVersion,
 	}
 
+	// Load the snapshot heights left to prune before the node stopped
+	bz, err := ret.stateTxn.Get(prunedSnapshotHeightsKey)
+	if err != nil {
+		return
+	}
+	ret.prunedSnapshotHeights = bytesToHeights(bz)
+
 	// Now load the substore schema
 	schemaView := prefixdb.NewReader(ret.stateDB.Reader(), schemaPrefix)
 	defer func() {
@@ -296,9 +347,11 @@ func NewStore(db dbm.Connection, opts StoreConfig) (ret *Store, err error) {
 		}
 		reg.reserved = make([]string, len(opts.reserved))
 		copy(reg.reserved, opts.reserved)
-	} else if !reg.equal(opts.StoreSchema) {
+	} else if !reg.persistent().equal(opts.persistent()) {
 		err = errors.New("loaded schema does not match configured schema")
 		return
+	} else if err = reg.setNonPersistent(opts.StoreSchema); err != nil {
+		return
 	}
 
 	// Apply migrations, then clear old schema and write the new one
@@ -534,29 +587,72 @@ func (s *Store) Commit() types.CommitID {
 	if s.InitialVersion != 0 && target < s.InitialVersion {
 		target = s.InitialVersion
 	}
+	if err := s.flushPrunedSnapshotHeights(); err != nil {
+		panic(err)
+	}
 	cid, err := s.commit(target)
 	if err != nil {
 		panic(err)
 	}
 
 	// Prune if necessary
-	previous := cid.Version - 1
 	if s.Pruning.Interval != 0 && cid.Version%int64(s.Pruning.Interval) == 0 {
-		// The range of newly prunable versions
-		lastPrunable := previous - int64(s.Pruning.KeepRecent)
-		firstPrunable := lastPrunable - int64(s.Pruning.Interval)
+		s.prune(cid.Version)
+	}
+
+	s.tran.Commit()
+	return *cid
+}
 
-		for version := firstPrunable; version <= lastPrunable; version++ {
-			s.stateDB.DeleteVersion(uint64(version))
+// Deletes the versions which became prunable at the given version, except the snapshot heights
+// until their snapshot is complete.
+func (s *Store) prune(version int64) {
+	// The range of newly prunable versions
+	lastPrunable := version - 1 - int64(s.Pruning.KeepRecent)
+	firstPrunable := lastPrunable - int64(s.Pruning.Interval)
+	if firstPrunable < 1 {
+		firstPrunable = 1
+	}
 
-			if s.StateCommitmentDB != nil {
-				s.StateCommitmentDB.DeleteVersion(uint64(version))
-			}
+	for v := firstPrunable; v <= lastPrunable; v++ {
+		if s.snapshotInterval != 0 && v%int64(s.snapshotInterval) == 0 {
+			continue
 		}
+		s.deleteVersion(v)
 	}
 
-	s.tran.Commit()
-	return *cid
+	s.snapshotMtx.Lock()
+	defer s.snapshotMtx.Unlock()
+	kept := s.prunedSnapshotHeights[:0]
+	for _, v := range s.prunedSnapshotHeights {
+		if v <= lastPrunable {
+			s.deleteVersion(v)
+		} else {
+			kept = append(kept, v)
+		}
+	}
+	s.prunedSnapshotHeights = kept
+}
+
+// Persists the snapshot heights waiting to be pruned with the version being committed, so that
+// they are still pruned after a restart.
+func (s *Store) flushPrunedSnapshotHeights() error {
+	s.snapshotMtx.Lock()
+	defer s.snapshotMtx.Unlock()
+
+	if len(s.prunedSnapshotHeights) == 0 {
+		return s.stateTxn.Delete(prunedSnapshotHeightsKey)
+	}
+	return s.stateTxn.Set(prunedSnapshotHeightsKey, heightsToBytes(s.prunedSnapshotHeights))
+}
+
+func (s *Store) deleteVersion(version int64) {
+	// the versions deleted at a previous pruning interval may be deleted again
+	s.stateDB.DeleteVersion(uint64(version))
+
+	if s.StateCommitmentDB != nil {
+		s.StateCommitmentDB.DeleteVersion(uint64(version))
+	}
 }
 
 func (s *Store) getMerkleRoots() (ret map[string][]byte, err error) {
@@ -576,6 +672,12 @@ func (s *Store) getMerkleRoots() (ret map[string][]byte, err error) {
 
 // Calculates root hashes and commits to DB. Does not verify target version or perform pruning.
 func (s *Store) commit(target uint64) (id *types.CommitID, err error) {
+	return s.commitWithHash(target, nil)
+}
+
+// Commits to DB like commit, but records the given hash as the root hash of the version if
+// not nil, instead of the hash of the substore roots.
+func (s *Store) commitWithHash(target uint64, hash []byte) (id *types.CommitID, err error) {
 	storeHashes, err := s.getMerkleRoots()
 	if err != nil {
 		return
@@ -589,6 +691,9 @@ func (s *Store) commit(target uint64) (id *types.CommitID, err error) {
 		}
 	}
 	rootHash := sdkmaps.HashFromMap(storeHashes)
+	if hash != nil {
+		rootHash = hash
+	}
 	if err = s.stateTxn.Set(merkleRootKey, rootHash); err != nil {
 		return
 	}
@@ -693,14 +798,36 @@ func (s *Store) CacheMultiStore() types.CacheMultiStore {
 // If PruneNothing, this is a no-op.
 // If other strategy, this height is persisted until it is
 // less than <current height> - KeepRecent and <current height> % Interval == 0
+// The height is persisted with the next commit.
 func (s *Store) PruneSnapshotHeight(height int64) {
-	panic("not implemented")
+	if s.Pruning.GetPruningStrategy() == pruningtypes.PruningNothing || height <= 0 {
+		return
+	}
+	s.snapshotMtx.Lock()
+	defer s.snapshotMtx.Unlock()
+	s.prunedSnapshotHeights = append(s.prunedSnapshotHeights, height)
 }
 
 // SetSnapshotInterval sets the interval at which the snapshots are taken.
 // It is used by the store to determine which heights to retain until after the snapshot is complete.
 func (s *Store) SetSnapshotInterval(snapshotInterval uint64) {
-	panic("not implemented")
+	s.snapshotInterval = snapshotInterval
+}
+
+func heightsToBytes(heights []int64) []byte {
+	bz := make([]byte, 8*len(heights))
+	for i, h := range heights {
+		binary.BigEndian.PutUint64(bz[8*i:], uint64(h))
+	}
+	return bz
+}
+
+func bytesToHeights(bz []byte) []int64 {
+	heights := make([]int64, len(bz)/8)
+	for i := range heights {
+		heights[i] = int64(binary.BigEndian.Uint64(bz[8*i:]))
+	}
+	return heights
 }
 
 // parsePath expects a format like /<storeName>[/<subpath>]
@@ -780,7 +907,8 @@ func (s *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 		if !req.Prove {
 			break
 		}
-		// TODO: actual IBC compatible proof. This is a placeholder so unit tests can pass
+		// ICS-23 proof of the key in the substore SMT, followed by the proof of the substore
+		// root in the multistore root hash
 		res.ProofOps, err = substore.GetProof(res.Key)
 		if err != nil {
 			return sdkerrors.QueryResult(fmt.Errorf("merkle proof creation failed for key: %v", res.Key), false)
@@ -868,7 +996,8 @@ func (pr *prefixRegistry) RegisterSubstore(key string, typ types.StoreType) erro
 	if i < len(pr.reserved) && strings.HasPrefix(pr.reserved[i], key) {
 		return fmt.Errorf("prefix conflict: '%v' exists, cannot add '%v'", pr.reserved[i], key)
 	}
-	reserved := pr.reserved[:i]
+	reserved := make([]string, 0, len(pr.reserved)+1)
+	reserved = append(reserved, pr.reserved[:i]...)
 	reserved = append(reserved, key)
 	pr.reserved = append(reserved, pr.reserved[i:]...)
 	pr.StoreSchema[key] = typ
@@ -902,7 +1031,10 @@ func (tlm *traceListenMixin) SetTraceContext(tc types.TraceContext) {
 
 func (tlm *traceListenMixin) wrapTraceListen(store types.KVStore, skey types.StoreKey) types.KVStore {
 	if tlm.TracingEnabled() {
-		store = tracekv.NewStore(store, tlm.TraceWriter, tlm.TraceContext)
+		tctx := tlm.TraceContext.Clone().Merge(types.TraceContext{
+			tracekv.StoreNameCtxKey: skey.Name(),
+		})
+		store = tracekv.NewStore(store, tlm.TraceWriter, tctx)
 	}
 	if tlm.ListeningEnabled(skey) {
 		store = listenkv.NewStore(store, skey, tlm.listeners[skey.Name()])
@@ -2,6 +2,7 @@ package multi
 
 import (
 	"bytes"
+	"fmt"
 	"math"
 	"testing"
 
@@ -471,6 +472,42 @@ func TestPruning(t *testing.T) {
 	}
 }
 
+func TestPruneSnapshotHeight(t *testing.T) {
+	db := memdb.NewDB()
+	opts := simpleStoreConfig(t)
+	opts.Pruning = pruningtypes.NewCustomPruningOptions(0, 10)
+	store, err := NewStore(db, opts)
+	require.NoError(t, err)
+	store.SetSnapshotInterval(5)
+
+	commitUpTo := func(version byte) {
+		for i := byte(store.LastCommitID().Version + 1); i <= version; i++ {
+			store.GetKVStore(skey_1).Set([]byte{i}, []byte{i})
+			store.Commit()
+		}
+	}
+
+	// the snapshot height whose snapshot is complete is still pruned after a restart
+	commitUpTo(7)
+	store.PruneSnapshotHeight(5)
+	commitUpTo(8)
+	require.NoError(t, store.Close())
+
+	store, err = NewStore(db, opts)
+	require.NoError(t, err)
+	store.SetSnapshotInterval(5)
+
+	commitUpTo(9)
+	versions, err := db.Versions()
+	require.NoError(t, err)
+	require.True(t, versions.Exists(5))
+
+	commitUpTo(10)
+	versions, err = db.Versions()
+	require.NoError(t, err)
+	require.False(t, versions.Exists(5))
+}
+
 func queryPath(skey types.StoreKey, endp string) string { return "/" + skey.Name() + endp }
 
 func TestQuery(t *testing.T) {
@@ -855,12 +892,10 @@ func TestTrace(t *testing.T) {
 	key, value := []byte("test-key"), []byte("test-value")
 	tctx := types.TraceContext(map[string]interface{}{"blockHeight": 64})
 
-	expected_Set := "{\"operation\":\"write\",\"key\":\"dGVzdC1rZXk=\",\"value\":\"dGVzdC12YWx1ZQ==\",\"metadata\":{\"blockHeight\":64}}\n"
-	expected_Get := "{\"operation\":\"read\",\"key\":\"dGVzdC1rZXk=\",\"value\":\"dGVzdC12YWx1ZQ==\",\"metadata\":{\"blockHeight\":64}}\n"
-	expected_Get_missing := "{\"operation\":\"read\",\"key\":\"dGVzdC1rZXk=\",\"value\":\"\",\"metadata\":{\"blockHeight\":64}}\n"
-	expected_Delete := "{\"operation\":\"delete\",\"key\":\"dGVzdC1rZXk=\",\"value\":\"\",\"metadata\":{\"blockHeight\":64}}\n"
-	expected_IterKey := "{\"operation\":\"iterKey\",\"key\":\"dGVzdC1rZXk=\",\"value\":\"\",\"metadata\":{\"blockHeight\":64}}\n"
-	expected_IterValue := "{\"operation\":\"iterValue\",\"key\":\"\",\"value\":\"dGVzdC12YWx1ZQ==\",\"metadata\":{\"blockHeight\":64}}\n"
+	// the trace operations are written with the name of their store
+	expected := func(op, key, value string, skey types.StoreKey) string {
+		return fmt.Sprintf("{\"operation\":%q,\"key\":%q,\"value\":%q,\"metadata\":{\"blockHeight\":64,\"store_name\":%q}}\n", op, key, value, skey.Name())
+	}
 
 	db := memdb.NewDB()
 	opts := simpleStoreConfig(t)
@@ -879,28 +914,28 @@ func TestTrace(t *testing.T) {
 	for _, skey := range []types.StoreKey{skey_1, skey_2, skey_3} {
 		buf.Reset()
 		store.GetKVStore(skey).Get(key)
-		require.Equal(t, expected_Get_missing, buf.String())
+		require.Equal(t, expected("read", "dGVzdC1rZXk=", "", skey), buf.String())
 
 		buf.Reset()
 		store.GetKVStore(skey).Set(key, value)
-		require.Equal(t, expected_Set, buf.String())
+		require.Equal(t, expected("write", "dGVzdC1rZXk=", "dGVzdC12YWx1ZQ==", skey), buf.String())
 
 		buf.Reset()
 		require.Equal(t, value, store.GetKVStore(skey).Get(key))
-		require.Equal(t, expected_Get, buf.String())
+		require.Equal(t, expected("read", "dGVzdC1rZXk=", "dGVzdC12YWx1ZQ==", skey), buf.String())
 
 		iter := store.GetKVStore(skey).Iterator(nil, nil)
 		buf.Reset()
 		require.Equal(t, key, iter.Key())
-		require.Equal(t, expected_IterKey, buf.String())
+		require.Equal(t, expected("iterKey", "dGVzdC1rZXk=", "", skey), buf.String())
 		buf.Reset()
 		require.Equal(t, value, iter.Value())
-		require.Equal(t, expected_IterValue, buf.String())
+		require.Equal(t, expected("iterValue", "", "dGVzdC12YWx1ZQ==", skey), buf.String())
 		require.NoError(t, iter.Close())
 
 		buf.Reset()
 		store.GetKVStore(skey).Delete(key)
-		require.Equal(t, expected_Delete, buf.String())
+		require.Equal(t, expected("delete", "dGVzdC1rZXk=", "", skey), buf.String())
 
 	}
 	store.SetTracer(nil)
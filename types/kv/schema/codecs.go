@@ -0,0 +1,188 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// KeyCodec decodes a field at the start of a key. It returns the value of the field,
+// encoded to JSON in the decoded key, and the rest of the key.
+type KeyCodec func(key []byte) (value interface{}, rest []byte, err error)
+
+// ValueCodec decodes a value into JSON.
+type ValueCodec func(cdc codec.Codec, value []byte) (json.RawMessage, error)
+
+// StringKey decodes the rest of the key as a string.
+func StringKey(key []byte) (interface{}, []byte, error) {
+	return string(key), nil, nil
+}
+
+// BytesKey decodes the rest of the key as hex encoded bytes.
+func BytesKey(key []byte) (interface{}, []byte, error) {
+	return hex.EncodeToString(key), nil, nil
+}
+
+// Uint64Key decodes a big-endian uint64.
+func Uint64Key(key []byte) (interface{}, []byte, error) {
+	if len(key) < 8 {
+		return nil, nil, fmt.Errorf("expected 8 bytes, got %d", len(key))
+	}
+	return binary.BigEndian.Uint64(key), key[8:], nil
+}
+
+// LittleEndianUint64Key decodes a little-endian uint64.
+func LittleEndianUint64Key(key []byte) (interface{}, []byte, error) {
+	if len(key) < 8 {
+		return nil, nil, fmt.Errorf("expected 8 bytes, got %d", len(key))
+	}
+	return binary.LittleEndian.Uint64(key), key[8:], nil
+}
+
+// TimeKey decodes the rest of the key as a time formatted with sdk.FormatTimeBytes.
+func TimeKey(key []byte) (interface{}, []byte, error) {
+	t, err := sdk.ParseTimeBytes(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return t, nil, nil
+}
+
+// AccAddressKey decodes the rest of the key as a bech32 account address.
+func AccAddressKey(key []byte) (interface{}, []byte, error) {
+	return sdk.AccAddress(key).String(), nil, nil
+}
+
+// ValAddressKey decodes the rest of the key as a bech32 validator operator address.
+func ValAddressKey(key []byte) (interface{}, []byte, error) {
+	return sdk.ValAddress(key).String(), nil, nil
+}
+
+// ConsAddressKey decodes the rest of the key as a bech32 consensus address.
+func ConsAddressKey(key []byte) (interface{}, []byte, error) {
+	return sdk.ConsAddress(key).String(), nil, nil
+}
+
+// LengthPrefixed decodes a field prefixed with its length on one byte, as written by
+// address.MustLengthPrefix, with a codec decoding the rest of its input.
+func LengthPrefixed(c KeyCodec) KeyCodec {
+	return func(key []byte) (interface{}, []byte, error) {
+		if len(key) == 0 {
+			return nil, nil, fmt.Errorf("missing length prefix")
+		}
+		n := int(key[0])
+		if len(key) < 1+n {
+			return nil, nil, fmt.Errorf("expected %d bytes, got %d", n, len(key)-1)
+		}
+		value, _, err := c(key[1 : 1+n])
+		if err != nil {
+			return nil, nil, err
+		}
+		return value, key[1+n:], nil
+	}
+}
+
+// Fixed decodes a field of n bytes, with a codec decoding the rest of its input.
+func Fixed(n int, c KeyCodec) KeyCodec {
+	return func(key []byte) (interface{}, []byte, error) {
+		if len(key) < n {
+			return nil, nil, fmt.Errorf("expected %d bytes, got %d", n, len(key))
+		}
+		value, _, err := c(key[:n])
+		if err != nil {
+			return nil, nil, err
+		}
+		return value, key[n:], nil
+	}
+}
+
+// NullTerminated decodes a field terminated by a 0 byte, with a codec decoding the rest
+// of its input.
+func NullTerminated(c KeyCodec) KeyCodec {
+	return Terminated(0, c)
+}
+
+// Terminated decodes a field terminated by the given separator byte, with a codec
+// decoding the rest of its input.
+func Terminated(sep byte, c KeyCodec) KeyCodec {
+	return func(key []byte) (interface{}, []byte, error) {
+		i := bytes.IndexByte(key, sep)
+		if i < 0 {
+			return nil, nil, fmt.Errorf("missing terminator %q", sep)
+		}
+		value, _, err := c(key[:i])
+		if err != nil {
+			return nil, nil, err
+		}
+		return value, key[i+1:], nil
+	}
+}
+
+// ProtoValue decodes values of the type of the given Protobuf message, which may be an
+// Any for values marshaled with codec.Codec.MarshalInterface.
+func ProtoValue(msg codec.ProtoMarshaler) ValueCodec {
+	typ := reflect.TypeOf(msg).Elem()
+	return func(cdc codec.Codec, value []byte) (json.RawMessage, error) {
+		ptr := reflect.New(typ).Interface().(codec.ProtoMarshaler)
+		if err := cdc.Unmarshal(value, ptr); err != nil {
+			return nil, err
+		}
+		return cdc.MarshalJSON(ptr)
+	}
+}
+
+// IntValue decodes a math.Int marshaled with its Marshal method.
+func IntValue(_ codec.Codec, value []byte) (json.RawMessage, error) {
+	var i math.Int
+	if err := i.Unmarshal(value); err != nil {
+		return nil, err
+	}
+	return json.Marshal(i)
+}
+
+// Uint64Value decodes a big-endian uint64.
+func Uint64Value(_ codec.Codec, value []byte) (json.RawMessage, error) {
+	if len(value) != 8 {
+		return nil, fmt.Errorf("expected 8 bytes, got %d", len(value))
+	}
+	return json.Marshal(binary.BigEndian.Uint64(value))
+}
+
+// JSONValue decodes a value stored as JSON.
+func JSONValue(_ codec.Codec, value []byte) (json.RawMessage, error) {
+	if !json.Valid(value) {
+		return nil, fmt.Errorf("invalid JSON value %X", value)
+	}
+	return append(json.RawMessage{}, value...), nil
+}
+
+// BoolValue decodes a boolean stored as a single byte.
+func BoolValue(_ codec.Codec, value []byte) (json.RawMessage, error) {
+	if len(value) != 1 || value[0] > 1 {
+		return nil, fmt.Errorf("invalid bool value %X", value)
+	}
+	return json.Marshal(value[0] == 1)
+}
+
+// BytesValue decodes a value as hex encoded bytes.
+func BytesValue(_ codec.Codec, value []byte) (json.RawMessage, error) {
+	return json.Marshal(hex.EncodeToString(value))
+}
+
+// AccAddressValue decodes a value as a bech32 account address.
+func AccAddressValue(_ codec.Codec, value []byte) (json.RawMessage, error) {
+	return json.Marshal(sdk.AccAddress(value).String())
+}
+
+// ValAddressValue decodes a value as a bech32 validator operator address.
+func ValAddressValue(_ codec.Codec, value []byte) (json.RawMessage, error) {
+	return json.Marshal(sdk.ValAddress(value).String())
+}
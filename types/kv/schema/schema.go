@@ -0,0 +1,186 @@
+// Package schema provides a registry of the key/value layouts of the module stores.
+//
+// Modules declare, for each key prefix of their store, the layout of the rest of the
+// key and the type of the value. Tooling such as the state-diff and `debug kv get`
+// commands then decode any store entry into readable JSON, without knowing the modules.
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// ErrUnknownKey is returned when no entry of the store schema matches a key.
+var ErrUnknownKey = errors.New("unknown key")
+
+// Entry describes the keys of a store starting with a given prefix, and their values.
+type Entry struct {
+	// Name is the name of the entry, e.g. "balance".
+	Name string
+	// Prefix is the prefix of the keys of the entry.
+	Prefix []byte
+	// Key is the layout of the rest of the key. Without fields, the key is the prefix itself.
+	Key []KeyField
+	// Value decodes the values of the entry.
+	Value ValueCodec
+}
+
+// KeyField is a named field of a key.
+type KeyField struct {
+	Name  string
+	Codec KeyCodec
+}
+
+// Field returns a named key field.
+func Field(name string, codec KeyCodec) KeyField {
+	return KeyField{Name: name, Codec: codec}
+}
+
+// DecodedField is a decoded key field.
+type DecodedField struct {
+	Name  string
+	Value interface{}
+}
+
+// DecodedKey is a decoded key. It is encoded as a JSON object keeping the order of the fields.
+type DecodedKey []DecodedField
+
+// MarshalJSON implements json.Marshaler.
+func (k DecodedKey) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range k {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(field.Name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(field.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// DecodedKV is a store entry decoded with its schema.
+type DecodedKV struct {
+	Entry string          `json:"entry"`
+	Key   DecodedKey      `json:"key"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Registry maps store names to the schema of their entries.
+type Registry struct {
+	cdc    codec.Codec
+	stores map[string][]Entry
+}
+
+// NewRegistry creates an empty registry, decoding the Protobuf values with the given codec.
+func NewRegistry(cdc codec.Codec) *Registry {
+	return &Registry{
+		cdc:    cdc,
+		stores: make(map[string][]Entry),
+	}
+}
+
+// Register adds entries to the schema of a store.
+// It panics if an entry has the same prefix as an entry already registered for the store.
+func (r *Registry) Register(storeName string, entries ...Entry) {
+	for _, entry := range entries {
+		for _, existing := range r.stores[storeName] {
+			if bytes.Equal(existing.Prefix, entry.Prefix) {
+				panic(fmt.Sprintf("store %s: entry %s has the same prefix %X as entry %s", storeName, entry.Name, entry.Prefix, existing.Name))
+			}
+		}
+		if entry.Value == nil {
+			panic(fmt.Sprintf("store %s: entry %s has no value codec", storeName, entry.Name))
+		}
+		r.stores[storeName] = append(r.stores[storeName], entry)
+	}
+}
+
+// StoreNames returns the sorted names of the stores with a schema.
+func (r *Registry) StoreNames() []string {
+	names := make([]string, 0, len(r.stores))
+	for name := range r.stores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Lookup returns the entry of the store schema matching the key. When several entries
+// match, the one with the longest prefix wins.
+func (r *Registry) Lookup(storeName string, key []byte) (Entry, bool) {
+	var (
+		match Entry
+		found bool
+	)
+	for _, entry := range r.stores[storeName] {
+		if !bytes.HasPrefix(key, entry.Prefix) {
+			continue
+		}
+		if len(entry.Key) == 0 && len(key) != len(entry.Prefix) {
+			continue
+		}
+		if !found || len(entry.Prefix) > len(match.Prefix) {
+			match, found = entry, true
+		}
+	}
+	return match, found
+}
+
+// Decode decodes a store entry with the store schema. The value is not decoded if nil,
+// e.g. for a deleted entry. It returns an error wrapping ErrUnknownKey when no entry of
+// the schema matches the key.
+func (r *Registry) Decode(storeName string, key, value []byte) (DecodedKV, error) {
+	entry, ok := r.Lookup(storeName, key)
+	if !ok {
+		return DecodedKV{}, fmt.Errorf("store %s, key %X: %w", storeName, key, ErrUnknownKey)
+	}
+
+	decoded := DecodedKV{Entry: entry.Name, Key: DecodedKey{}}
+	rest := key[len(entry.Prefix):]
+	for _, field := range entry.Key {
+		fieldValue, fieldRest, err := field.Codec(rest)
+		if err != nil {
+			return DecodedKV{}, fmt.Errorf("store %s, entry %s: failed to decode key field %s: %w", storeName, entry.Name, field.Name, err)
+		}
+		decoded.Key = append(decoded.Key, DecodedField{Name: field.Name, Value: fieldValue})
+		rest = fieldRest
+	}
+	if len(rest) > 0 {
+		return DecodedKV{}, fmt.Errorf("store %s, entry %s: %d trailing bytes in key", storeName, entry.Name, len(rest))
+	}
+
+	if value != nil {
+		bz, err := entry.Value(r.cdc, value)
+		if err != nil {
+			return DecodedKV{}, fmt.Errorf("store %s, entry %s: failed to decode value: %w", storeName, entry.Name, err)
+		}
+		decoded.Value = bz
+	}
+
+	return decoded, nil
+}
+
+// DecodeJSON decodes a store entry like Decode, into JSON.
+func (r *Registry) DecodeJSON(storeName string, key, value []byte) (json.RawMessage, error) {
+	decoded, err := r.Decode(storeName, key, value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(decoded)
+}
@@ -0,0 +1,163 @@
+package schema_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+)
+
+func newRegistry() *schema.Registry {
+	registry := schema.NewRegistry(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()))
+	registry.Register("test",
+		schema.Entry{Name: "count", Prefix: []byte{0x00}, Value: schema.ProtoValue(&gogotypes.UInt64Value{})},
+		schema.Entry{
+			Name:   "item",
+			Prefix: []byte{0x01},
+			Key: []schema.KeyField{
+				schema.Field("owner", schema.LengthPrefixed(schema.AccAddressKey)),
+				schema.Field("id", schema.Uint64Key),
+			},
+			Value: schema.BytesValue,
+		},
+		schema.Entry{
+			Name:   "item_by_name",
+			Prefix: []byte{0x01, 0xff},
+			Key: []schema.KeyField{
+				schema.Field("name", schema.NullTerminated(schema.StringKey)),
+				schema.Field("time", schema.TimeKey),
+			},
+			Value: schema.BoolValue,
+		},
+		schema.Entry{
+			Name:   "queue",
+			Prefix: []byte{0x03},
+			Key: []schema.KeyField{
+				schema.Field("time", schema.Fixed(len(sdk.FormatTimeBytes(time.Time{})), schema.TimeKey)),
+				schema.Field("id", schema.Uint64Key),
+			},
+			Value: schema.Uint64Value,
+		},
+		schema.Entry{
+			Name:   "param",
+			Prefix: []byte{0x04},
+			Key: []schema.KeyField{
+				schema.Field("subspace", schema.Terminated('/', schema.StringKey)),
+				schema.Field("key", schema.StringKey),
+			},
+			Value: schema.JSONValue,
+		},
+	)
+	return registry
+}
+
+func TestRegistry_Decode(t *testing.T) {
+	registry := newRegistry()
+	addr := sdk.AccAddress("addr________________")
+	now := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	testCases := map[string]struct {
+		key, value []byte
+		expected   string
+		err        bool
+		unknown    bool
+	}{
+		"singleton": {
+			key:      []byte{0x00},
+			value:    []byte{0x08, 0x05},
+			expected: `{"entry":"count","key":{},"value":"5"}`,
+		},
+		"fields": {
+			key:      append(append([]byte{0x01}, address.MustLengthPrefix(addr)...), 0, 0, 0, 0, 0, 0, 0, 7),
+			value:    []byte{0xab},
+			expected: `{"entry":"item","key":{"owner":"` + addr.String() + `","id":7},"value":"ab"}`,
+		},
+		"longest prefix": {
+			key:      append(append([]byte{0x01, 0xff}, "foo\x00"...), sdk.FormatTimeBytes(now)...),
+			value:    []byte{1},
+			expected: `{"entry":"item_by_name","key":{"name":"foo","time":"2022-01-02T03:04:05Z"},"value":true}`,
+		},
+		"fixed length field": {
+			key:      append(append([]byte{0x03}, sdk.FormatTimeBytes(now)...), 0, 0, 0, 0, 0, 0, 0, 7),
+			value:    []byte{0, 0, 0, 0, 0, 0, 0, 7},
+			expected: `{"entry":"queue","key":{"time":"2022-01-02T03:04:05Z","id":7},"value":7}`,
+		},
+		"separated field": {
+			key:      []byte("\x04bank/SendEnabled"),
+			value:    []byte(`[{"denom":"stake","enabled":true}]`),
+			expected: `{"entry":"param","key":{"subspace":"bank","key":"SendEnabled"},"value":[{"denom":"stake","enabled":true}]}`,
+		},
+		"nil value": {
+			key:      []byte{0x00},
+			expected: `{"entry":"count","key":{}}`,
+		},
+		"unknown prefix":        {key: []byte{0x02}, unknown: true},
+		"singleton with suffix": {key: []byte{0x00, 0x01}, unknown: true},
+		"truncated key":         {key: []byte{0x01, 20, 1, 2}, err: true},
+		"trailing bytes":        {key: append(append([]byte{0x01}, address.MustLengthPrefix(addr)...), 0, 0, 0, 0, 0, 0, 0, 7, 8), err: true},
+		"missing null":          {key: []byte{0x01, 0xff, 'f'}, err: true},
+		"invalid value":         {key: []byte{0x00}, value: []byte{0xff}, err: true},
+		"truncated fixed field": {key: append([]byte{0x03}, "2022"...), err: true},
+		"invalid uint64":        {key: append(append([]byte{0x03}, sdk.FormatTimeBytes(now)...), 0, 0, 0, 0, 0, 0, 0, 7), value: []byte{7}, err: true},
+		"missing separator":     {key: []byte("\x04bank"), err: true},
+		"invalid json":          {key: []byte("\x04bank/SendEnabled"), value: []byte("{"), err: true},
+		"invalid bool":          {key: append(append([]byte{0x01, 0xff}, "foo\x00"...), sdk.FormatTimeBytes(now)...), value: []byte{2}, err: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			decoded, err := registry.Decode("test", tc.key, tc.value)
+			switch {
+			case tc.unknown:
+				require.True(t, errors.Is(err, schema.ErrUnknownKey), err)
+			case tc.err:
+				require.Error(t, err)
+				require.False(t, errors.Is(err, schema.ErrUnknownKey))
+			default:
+				require.NoError(t, err)
+				bz, err := json.Marshal(decoded)
+				require.NoError(t, err)
+				require.JSONEq(t, tc.expected, string(bz))
+				// the fields of the key keep their order
+				require.Contains(t, string(bz), tc.expected[len(`{"entry":"`) : len(tc.expected)-len(`}`)][:10])
+
+				bz, err = registry.DecodeJSON("test", tc.key, tc.value)
+				require.NoError(t, err)
+				require.JSONEq(t, tc.expected, string(bz))
+			}
+		})
+	}
+
+	_, err := registry.Decode("other", []byte{0x00}, nil)
+	require.True(t, errors.Is(err, schema.ErrUnknownKey))
+}
+
+func TestDecodedKey_MarshalJSON(t *testing.T) {
+	bz, err := json.Marshal(schema.DecodedKey{{Name: "z", Value: 1}, {Name: "a", Value: "b"}})
+	require.NoError(t, err)
+	require.Equal(t, `{"z":1,"a":"b"}`, string(bz))
+}
+
+func TestRegistry_Register(t *testing.T) {
+	registry := newRegistry()
+	require.Equal(t, []string{"test"}, registry.StoreNames())
+
+	require.Panics(t, func() {
+		registry.Register("test", schema.Entry{Name: "dup", Prefix: []byte{0x01}, Value: schema.BytesValue})
+	})
+	require.Panics(t, func() {
+		registry.Register("test", schema.Entry{Name: "novalue", Prefix: []byte{0x02}})
+	})
+	require.NotPanics(t, func() {
+		registry.Register("other", schema.Entry{Name: "item", Prefix: []byte{0x01}, Value: schema.BytesValue})
+	})
+}
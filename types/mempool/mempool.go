@@ -0,0 +1,207 @@
+package mempool
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// Mempool defines the application-side transaction mempool. It is owned by
+// BaseApp, which inserts transactions after they pass CheckTx, or a re-check
+// after Commit, and removes them once they are included in a block or fail a
+// re-check. The ordering in which transactions are returned by Select is left
+// to the implementation.
+//
+// The ABCI of Tendermint v0.35 has no PrepareProposal, so the blocks are still
+// built from the mempool of Tendermint and nothing in the node calls Select:
+// the app-side mempool only tracks the txs which pass a check or a re-check,
+// and evicts the ones Tendermint dropped. Select is only used by the block
+// builders calling BaseApp.SelectTxs.
+//
+// Implementations must be safe for concurrent use, as BaseApp inserts txs
+// from the mempool connection of Tendermint while it removes them from the
+// consensus connection.
+type Mempool interface {
+	// Insert attempts to insert a Tx into the app-side mempool returning an
+	// error upon failure. The provided context holds the priority that was
+	// computed for the transaction by the AnteHandler.
+	Insert(sdk.Context, sdk.Tx) error
+
+	// Select returns an Iterator over the app-side mempool in the order in
+	// which transactions should be included in a block. The provided raw txs
+	// are the transactions the consensus engine already proposes, which an
+	// implementation may use as a hint. A nil Iterator is returned when the
+	// mempool is empty.
+	Select(sdk.Context, [][]byte) Iterator
+
+	// CountTx returns the number of transactions currently in the mempool.
+	CountTx() int
+
+	// Remove attempts to remove a transaction from the mempool, returning an
+	// error upon failure.
+	Remove(sdk.Tx) error
+}
+
+// Iterator defines an app-side mempool iterator interface that is as minimal as
+// possible. The order of iteration is determined by the app-side mempool
+// implementation.
+type Iterator interface {
+	// Next returns the next transaction from the mempool. If there are no more
+	// transactions, it returns nil.
+	Next() Iterator
+
+	// Tx returns the transaction at the current position of the iterator.
+	Tx() sdk.Tx
+}
+
+var (
+	// ErrTxNotFound is returned when a transaction to remove is not present in
+	// the mempool.
+	ErrTxNotFound = errors.New("tx not found in mempool")
+
+	// ErrMempoolTxMaxCapacity is returned when a transaction is inserted into
+	// a mempool that already holds its maximum number of transactions.
+	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
+)
+
+// txMeta holds the group and nonce a transaction is ordered by.
+type txMeta struct {
+	// group is the sender of the transaction, or the hash of an unordered
+	// transaction, which is not ordered with the transactions of its sender.
+	group string
+	nonce uint64
+}
+
+// getTxMeta extracts the group and the nonce of a transaction. The group is
+// the first signer of the transaction and the nonce is the sequence of its
+// signature. An unordered transaction has no sequence, so it is the only
+// transaction of the group keyed by its hash.
+func getTxMeta(tx sdk.Tx) (txMeta, error) {
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return txMeta{}, fmt.Errorf("tx of type %T does not implement SigVerifiableTx", tx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return txMeta{}, err
+	}
+	if len(sigs) == 0 {
+		return txMeta{}, fmt.Errorf("tx must have at least one signer")
+	}
+
+	signers := sigTx.GetSigners()
+	if len(signers) == 0 {
+		return txMeta{}, fmt.Errorf("tx must have at least one signer")
+	}
+
+	if unorderedTx, ok := tx.(sdk.TxWithUnordered); ok && unorderedTx.GetUnordered() {
+		hash, err := unorderedTxHash(tx)
+		if err != nil {
+			return txMeta{}, err
+		}
+
+		return txMeta{group: hash}, nil
+	}
+
+	return txMeta{group: signers[0].String(), nonce: sigs[0].Sequence}, nil
+}
+
+// evictExpired removes from senders the txs inserted, or last re-checked, at
+// least ttl blocks before height, which the consensus engine most likely
+// dropped from its own mempool, together with the txs of the same sender with
+// a higher nonce, which cannot be included without them. Nothing is evicted if
+// ttl <= 0. It returns the number of removed txs.
+func evictExpired(senders map[string]*nonceTxs, height, ttl int64) int {
+	if ttl <= 0 {
+		return 0
+	}
+
+	evicted := 0
+	for group, txs := range senders {
+		for i, e := range txs.entries {
+			if height-e.height < ttl {
+				continue
+			}
+
+			evicted += len(txs.entries) - i
+			txs.entries = txs.entries[:i]
+			break
+		}
+
+		if len(txs.entries) == 0 {
+			delete(senders, group)
+		}
+	}
+
+	return evicted
+}
+
+// protoTxProvider is implemented by the protobuf transactions.
+type protoTxProvider interface {
+	GetProtoTx() *txtypes.Tx
+}
+
+// unorderedTxHash returns the hash of an unordered transaction, computed like
+// the UnorderedTxDecorator over its body and auth info, and not over its
+// signatures.
+func unorderedTxHash(tx sdk.Tx) (string, error) {
+	protoTx, ok := tx.(protoTxProvider)
+	if !ok {
+		return "", fmt.Errorf("unordered tx of type %T is not a protobuf tx", tx)
+	}
+
+	bodyBz, err := protoTx.GetProtoTx().Body.Marshal()
+	if err != nil {
+		return "", err
+	}
+	authInfoBz, err := protoTx.GetProtoTx().AuthInfo.Marshal()
+	if err != nil {
+		return "", err
+	}
+
+	raw := txtypes.TxRaw{BodyBytes: bodyBz, AuthInfoBytes: authInfoBz}
+	bz, err := raw.Marshal()
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(bz)
+	return string(hash[:]), nil
+}
+
+// sliceIterator is an Iterator over a slice of transactions which was ordered
+// by the mempool at the time Select was called.
+type sliceIterator struct {
+	txs   []sdk.Tx
+	index int
+}
+
+var _ Iterator = (*sliceIterator)(nil)
+
+// newSliceIterator returns an Iterator over txs, or nil if txs is empty.
+func newSliceIterator(txs []sdk.Tx) Iterator {
+	if len(txs) == 0 {
+		return nil
+	}
+
+	return &sliceIterator{txs: txs}
+}
+
+// Next implements the Iterator interface.
+func (i *sliceIterator) Next() Iterator {
+	if i.index+1 >= len(i.txs) {
+		return nil
+	}
+
+	return &sliceIterator{txs: i.txs, index: i.index + 1}
+}
+
+// Tx implements the Iterator interface.
+func (i *sliceIterator) Tx() sdk.Tx {
+	return i.txs[i.index]
+}
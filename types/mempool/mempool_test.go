@@ -0,0 +1,170 @@
+package mempool_test
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	txsigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// testTx is a tx signed by a single sender with a given nonce and priority.
+type testTx struct {
+	id        int
+	sender    sdk.AccAddress
+	nonce     uint64
+	priority  int64
+	unordered bool
+}
+
+var (
+	_ signing.SigVerifiableTx = testTx{}
+	_ sdk.TxWithUnordered     = testTx{}
+)
+
+func (tx testTx) GetMsgs() []sdk.Msg                        { return nil }
+func (tx testTx) ValidateBasic() error                      { return nil }
+func (tx testTx) GetSigners() []sdk.AccAddress              { return []sdk.AccAddress{tx.sender} }
+func (tx testTx) GetPubKeys() ([]cryptotypes.PubKey, error) { return nil, nil }
+func (tx testTx) GetSignaturesV2() ([]txsigning.SignatureV2, error) {
+	return []txsigning.SignatureV2{{Sequence: tx.nonce}}, nil
+}
+func (tx testTx) GetTimeoutHeight() uint64 { return 0 }
+func (tx testTx) GetUnordered() bool       { return tx.unordered }
+
+// GetProtoTx returns a protobuf tx whose body is unique to the tx id.
+func (tx testTx) GetProtoTx() *txtypes.Tx {
+	return &txtypes.Tx{
+		Body:     &txtypes.TxBody{Memo: strconv.Itoa(tx.id), Unordered: tx.unordered},
+		AuthInfo: &txtypes.AuthInfo{},
+	}
+}
+
+// invalidTx is a tx which cannot be ordered by sender and nonce.
+type invalidTx struct{}
+
+func (invalidTx) GetMsgs() []sdk.Msg   { return nil }
+func (invalidTx) ValidateBasic() error { return nil }
+
+var (
+	alice = sdk.AccAddress("alice_______________")
+	bob   = sdk.AccAddress("bob_________________")
+	carol = sdk.AccAddress("carol_______________")
+)
+
+func newTestCtx() sdk.Context {
+	return sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+}
+
+// insertAll inserts txs into mp using each tx priority.
+func insertAll(t *testing.T, mp mempool.Mempool, txs []testTx) {
+	ctx := newTestCtx()
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+}
+
+// selectIDs returns the ids of the txs returned by Select in order.
+func selectIDs(mp mempool.Mempool) []int {
+	var ids []int
+	for it := mp.Select(newTestCtx(), nil); it != nil; it = it.Next() {
+		ids = append(ids, it.Tx().(testTx).id)
+	}
+
+	return ids
+}
+
+func TestInvalidTx(t *testing.T) {
+	pools := []mempool.Mempool{
+		mempool.NewPriorityMempool(),
+		mempool.NewSenderNonceMempool(),
+	}
+
+	for _, mp := range pools {
+		require.Error(t, mp.Insert(newTestCtx(), invalidTx{}))
+		require.Error(t, mp.Remove(invalidTx{}))
+		require.Equal(t, 0, mp.CountTx())
+	}
+}
+
+func TestUnorderedTxs(t *testing.T) {
+	pools := []mempool.Mempool{
+		mempool.NewPriorityMempool(),
+		mempool.NewSenderNonceMempool(),
+	}
+
+	// the unordered txs of a sender have no sequence, and don't replace each
+	// other nor the tx of their sender with the same nonce
+	txs := []testTx{
+		{id: 0, sender: alice, nonce: 0, priority: 10},
+		{id: 1, sender: alice, unordered: true, priority: 20},
+		{id: 2, sender: alice, unordered: true, priority: 30},
+	}
+
+	for _, mp := range pools {
+		insertAll(t, mp, txs)
+		require.Equal(t, 3, mp.CountTx())
+		require.ElementsMatch(t, []int{0, 1, 2}, selectIDs(mp))
+
+		// inserting the same unordered tx again replaces it
+		require.NoError(t, mp.Insert(newTestCtx(), txs[2]))
+		require.Equal(t, 3, mp.CountTx())
+
+		require.NoError(t, mp.Remove(txs[1]))
+		require.ErrorIs(t, mp.Remove(txs[1]), mempool.ErrTxNotFound)
+		require.ElementsMatch(t, []int{0, 2}, selectIDs(mp))
+	}
+
+	// the unordered txs are selected by priority, as the next pending tx of a
+	// sender
+	mp := mempool.NewPriorityMempool()
+	insertAll(t, mp, txs)
+	require.Equal(t, []int{2, 1, 0}, selectIDs(mp))
+}
+
+func TestConcurrentAccess(t *testing.T) {
+	pools := []mempool.Mempool{
+		mempool.NewPriorityMempool(),
+		mempool.NewSenderNonceMempool(),
+	}
+
+	// the txs are inserted and removed concurrently, as BaseApp does from the
+	// mempool and the consensus connections
+	for _, mp := range pools {
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			tx := testTx{id: i, sender: sdk.AccAddress(fmt.Sprintf("sender_%013d", i))}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				require.NoError(t, mp.Insert(newTestCtx(), tx))
+				mp.Select(newTestCtx(), nil)
+				require.NoError(t, mp.Remove(tx))
+			}()
+		}
+		wg.Wait()
+
+		require.Equal(t, 0, mp.CountTx())
+	}
+}
+
+func TestNoOpMempool(t *testing.T) {
+	mp := mempool.NoOpMempool{}
+	tx := testTx{sender: alice}
+
+	require.NoError(t, mp.Insert(newTestCtx(), tx))
+	require.Equal(t, 0, mp.CountTx())
+	require.Nil(t, mp.Select(newTestCtx(), nil))
+	require.NoError(t, mp.Remove(tx))
+}
@@ -0,0 +1,17 @@
+package mempool
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Mempool = (*NoOpMempool)(nil)
+
+// NoOpMempool defines a no-op mempool. Transactions are completely discarded
+// and ignored when BaseApp interacts with the mempool, so ordering is left
+// entirely to the consensus engine. This is the default mempool of BaseApp.
+type NoOpMempool struct{}
+
+func (NoOpMempool) Insert(sdk.Context, sdk.Tx) error      { return nil }
+func (NoOpMempool) Select(sdk.Context, [][]byte) Iterator { return nil }
+func (NoOpMempool) CountTx() int                          { return 0 }
+func (NoOpMempool) Remove(sdk.Tx) error                   { return nil }
@@ -0,0 +1,212 @@
+package mempool
+
+import (
+	"container/heap"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Mempool = (*PriorityNonceMempool)(nil)
+
+// PriorityNonceMempool is a mempool which orders transactions by the priority
+// computed by the AnteHandler, while still returning the transactions of a
+// single sender in nonce order. Among the next pending transaction of every
+// sender, the one with the highest priority is always selected first. Ties
+// are broken in insertion order.
+type PriorityNonceMempool struct {
+	// mtx guards the fields below, as BaseApp inserts the txs from the mempool
+	// connection of Tendermint and removes them from the consensus connection
+	mtx sync.Mutex
+
+	// senders holds the txs of each sender, and every unordered tx on its
+	// own, keyed by their txMeta group
+	senders map[string]*nonceTxs
+	count   int
+	maxTx   int
+	txTTL   int64
+	nextSeq uint64
+}
+
+// PriorityNonceOption defines a functional option of a PriorityNonceMempool.
+type PriorityNonceOption func(mp *PriorityNonceMempool)
+
+// PriorityNonceWithMaxTx sets the maximum number of transactions the mempool
+// holds. A value <= 0 means the mempool is unbounded.
+func PriorityNonceWithMaxTx(maxTx int) PriorityNonceOption {
+	return func(mp *PriorityNonceMempool) {
+		mp.maxTx = maxTx
+	}
+}
+
+// PriorityNonceWithTxTTL sets the number of blocks after which a transaction
+// which was neither included in a block nor re-checked is evicted. A value <= 0
+// means the transactions are never evicted.
+func PriorityNonceWithTxTTL(ttl int64) PriorityNonceOption {
+	return func(mp *PriorityNonceMempool) {
+		mp.txTTL = ttl
+	}
+}
+
+// NewPriorityMempool returns a new PriorityNonceMempool with the given options.
+func NewPriorityMempool(opts ...PriorityNonceOption) *PriorityNonceMempool {
+	mp := &PriorityNonceMempool{
+		senders: make(map[string]*nonceTxs),
+	}
+
+	for _, opt := range opts {
+		opt(mp)
+	}
+
+	return mp
+}
+
+// Insert adds a tx to the mempool using the priority set on the context. A tx
+// with the same sender and nonce as a tx already in the mempool replaces it,
+// while an unordered tx is only replaced by itself. The expired txs are evicted
+// before a new tx is rejected at capacity.
+func (mp *PriorityNonceMempool) Insert(ctx sdk.Context, tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	meta, err := getTxMeta(tx)
+	if err != nil {
+		return err
+	}
+
+	txs, ok := mp.senders[meta.group]
+	isNew := !ok || txs.find(meta.nonce) < 0
+	if isNew && mp.maxTx > 0 && mp.count >= mp.maxTx {
+		mp.count -= evictExpired(mp.senders, ctx.BlockHeight(), mp.txTTL)
+		if mp.count >= mp.maxTx {
+			return ErrMempoolTxMaxCapacity
+		}
+
+		txs, ok = mp.senders[meta.group]
+	}
+
+	if !ok {
+		txs = &nonceTxs{}
+		mp.senders[meta.group] = txs
+	}
+
+	entry := &txEntry{
+		meta:     meta,
+		priority: ctx.Priority(),
+		seq:      mp.nextSeq,
+		height:   ctx.BlockHeight(),
+		tx:       tx,
+	}
+	mp.nextSeq++
+
+	if txs.put(entry) {
+		mp.count++
+	}
+
+	return nil
+}
+
+// Select returns an Iterator over the mempool ordered by priority, after
+// evicting the expired txs, such that the transactions of a single sender are
+// returned in nonce order.
+func (mp *PriorityNonceMempool) Select(ctx sdk.Context, _ [][]byte) Iterator {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	mp.count -= evictExpired(mp.senders, ctx.BlockHeight(), mp.txTTL)
+
+	h := make(senderHeap, 0, len(mp.senders))
+	for _, txs := range mp.senders {
+		h = append(h, &senderCursor{entries: txs.entries})
+	}
+	heap.Init(&h)
+
+	selected := make([]sdk.Tx, 0, mp.count)
+	for h.Len() > 0 {
+		cursor := h[0]
+		selected = append(selected, cursor.head().tx)
+
+		cursor.index++
+		if cursor.index == len(cursor.entries) {
+			heap.Pop(&h)
+		} else {
+			heap.Fix(&h, 0)
+		}
+	}
+
+	return newSliceIterator(selected)
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *PriorityNonceMempool) CountTx() int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	return mp.count
+}
+
+// Remove removes a tx from the mempool. It returns ErrTxNotFound if no tx with
+// the same sender and nonce, or no such unordered tx, is present.
+func (mp *PriorityNonceMempool) Remove(tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	meta, err := getTxMeta(tx)
+	if err != nil {
+		return err
+	}
+
+	txs, ok := mp.senders[meta.group]
+	if !ok || !txs.remove(meta.nonce) {
+		return ErrTxNotFound
+	}
+
+	if len(txs.entries) == 0 {
+		delete(mp.senders, meta.group)
+	}
+
+	mp.count--
+	return nil
+}
+
+// senderCursor points at the next pending tx of a sender during Select.
+type senderCursor struct {
+	entries []*txEntry
+	index   int
+}
+
+func (c *senderCursor) head() *txEntry {
+	return c.entries[c.index]
+}
+
+// senderHeap is a max-heap of senders keyed by the priority of their next
+// pending tx.
+type senderHeap []*senderCursor
+
+var _ heap.Interface = (*senderHeap)(nil)
+
+func (h senderHeap) Len() int { return len(h) }
+
+func (h senderHeap) Less(i, j int) bool {
+	a, b := h[i].head(), h[j].head()
+	if a.priority != b.priority {
+		return a.priority > b.priority
+	}
+
+	return a.seq < b.seq
+}
+
+func (h senderHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *senderHeap) Push(x interface{}) {
+	*h = append(*h, x.(*senderCursor))
+}
+
+func (h *senderHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+
+	return x
+}
@@ -0,0 +1,137 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+func TestPriorityNonceMempoolSelect(t *testing.T) {
+	testCases := []struct {
+		name  string
+		txs   []testTx
+		order []int
+	}{
+		{
+			name: "single sender in nonce order despite priority",
+			txs: []testTx{
+				{id: 0, sender: alice, nonce: 2, priority: 30},
+				{id: 1, sender: alice, nonce: 0, priority: 10},
+				{id: 2, sender: alice, nonce: 1, priority: 20},
+			},
+			order: []int{1, 2, 0},
+		},
+		{
+			name: "senders ordered by priority",
+			txs: []testTx{
+				{id: 0, sender: alice, nonce: 0, priority: 10},
+				{id: 1, sender: bob, nonce: 0, priority: 30},
+				{id: 2, sender: carol, nonce: 0, priority: 20},
+			},
+			order: []int{1, 2, 0},
+		},
+		{
+			name: "interleaved senders",
+			txs: []testTx{
+				{id: 0, sender: alice, nonce: 0, priority: 20},
+				{id: 1, sender: alice, nonce: 1, priority: 5},
+				{id: 2, sender: bob, nonce: 0, priority: 15},
+				{id: 3, sender: bob, nonce: 1, priority: 25},
+			},
+			order: []int{0, 2, 3, 1},
+		},
+		{
+			name: "priority ties are first in first out",
+			txs: []testTx{
+				{id: 0, sender: bob, nonce: 0, priority: 10},
+				{id: 1, sender: alice, nonce: 0, priority: 10},
+				{id: 2, sender: carol, nonce: 0, priority: 10},
+			},
+			order: []int{0, 1, 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mp := mempool.NewPriorityMempool()
+			insertAll(t, mp, tc.txs)
+
+			require.Equal(t, len(tc.txs), mp.CountTx())
+			require.Equal(t, tc.order, selectIDs(mp))
+		})
+	}
+}
+
+func TestPriorityNonceMempoolReplace(t *testing.T) {
+	mp := mempool.NewPriorityMempool()
+	insertAll(t, mp, []testTx{
+		{id: 0, sender: alice, nonce: 0, priority: 10},
+		{id: 1, sender: bob, nonce: 0, priority: 20},
+		{id: 2, sender: alice, nonce: 0, priority: 30},
+	})
+
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, []int{2, 1}, selectIDs(mp))
+}
+
+func TestPriorityNonceMempoolRemove(t *testing.T) {
+	mp := mempool.NewPriorityMempool()
+	txs := []testTx{
+		{id: 0, sender: alice, nonce: 0, priority: 10},
+		{id: 1, sender: alice, nonce: 1, priority: 10},
+		{id: 2, sender: bob, nonce: 0, priority: 20},
+	}
+	insertAll(t, mp, txs)
+
+	require.NoError(t, mp.Remove(txs[0]))
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, []int{2, 1}, selectIDs(mp))
+
+	require.ErrorIs(t, mp.Remove(txs[0]), mempool.ErrTxNotFound)
+
+	require.NoError(t, mp.Remove(txs[1]))
+	require.NoError(t, mp.Remove(txs[2]))
+	require.Equal(t, 0, mp.CountTx())
+	require.Nil(t, mp.Select(newTestCtx(), nil))
+}
+
+func TestPriorityNonceMempoolMaxTx(t *testing.T) {
+	mp := mempool.NewPriorityMempool(mempool.PriorityNonceWithMaxTx(2))
+	insertAll(t, mp, []testTx{
+		{id: 0, sender: alice, nonce: 0},
+		{id: 1, sender: bob, nonce: 0},
+	})
+
+	err := mp.Insert(newTestCtx(), testTx{id: 2, sender: carol})
+	require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+	require.ElementsMatch(t, []int{0, 1}, selectIDs(mp))
+
+	// replacing a tx does not grow the mempool
+	require.NoError(t, mp.Insert(newTestCtx(), testTx{id: 3, sender: bob, nonce: 0}))
+	require.Equal(t, 2, mp.CountTx())
+}
+
+func TestPriorityNonceMempoolTxTTL(t *testing.T) {
+	mp := mempool.NewPriorityMempool(mempool.PriorityNonceWithMaxTx(3), mempool.PriorityNonceWithTxTTL(2))
+	ctx := newTestCtx().WithBlockHeight(1)
+	require.NoError(t, mp.Insert(ctx, testTx{id: 0, sender: alice, nonce: 0}))
+	require.NoError(t, mp.Insert(ctx, testTx{id: 1, sender: bob, nonce: 0}))
+
+	// a re-checked tx is inserted again, which resets its age, while a tx
+	// depending on an expired tx of its sender is evicted with it
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, mp.Insert(ctx, testTx{id: 0, sender: alice, nonce: 0}))
+	require.NoError(t, mp.Insert(ctx, testTx{id: 2, sender: bob, nonce: 1}))
+
+	// the expired txs are evicted instead of rejecting a new tx at capacity
+	ctx = ctx.WithBlockHeight(3)
+	require.NoError(t, mp.Insert(ctx, testTx{id: 3, sender: carol, nonce: 0}))
+	require.Equal(t, 2, mp.CountTx())
+	require.ElementsMatch(t, []int{0, 3}, selectIDs(mp))
+
+	// and by Select
+	require.Nil(t, mp.Select(ctx.WithBlockHeight(5), nil))
+	require.Equal(t, 0, mp.CountTx())
+}
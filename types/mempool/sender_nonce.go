@@ -0,0 +1,242 @@
+package mempool
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Mempool = (*SenderNonceMempool)(nil)
+
+// DefaultSenderNonceSeed is the seed used by a SenderNonceMempool to pick the
+// next sender during Select when no seed is configured.
+const DefaultSenderNonceSeed = 1
+
+// SenderNonceMempool is a mempool which orders the transactions of each
+// sender by nonce and disregards their priority. During Select the next
+// sender is picked at random, using a deterministic seed, so that no single
+// sender can starve the others by flooding the mempool.
+type SenderNonceMempool struct {
+	// mtx guards the fields below, as BaseApp inserts the txs from the mempool
+	// connection of Tendermint and removes them from the consensus connection
+	mtx sync.Mutex
+
+	// senders holds the txs of each sender, and every unordered tx on its
+	// own, keyed by their txMeta group
+	senders map[string]*nonceTxs
+	count   int
+	maxTx   int
+	txTTL   int64
+	seed    int64
+}
+
+// SenderNonceOption defines a functional option of a SenderNonceMempool.
+type SenderNonceOption func(mp *SenderNonceMempool)
+
+// SenderNonceSeedOpt sets the seed used to pick senders during Select.
+func SenderNonceSeedOpt(seed int64) SenderNonceOption {
+	return func(mp *SenderNonceMempool) {
+		mp.seed = seed
+	}
+}
+
+// SenderNonceMaxTxOpt sets the maximum number of transactions the mempool
+// holds. A value <= 0 means the mempool is unbounded.
+func SenderNonceMaxTxOpt(maxTx int) SenderNonceOption {
+	return func(mp *SenderNonceMempool) {
+		mp.maxTx = maxTx
+	}
+}
+
+// SenderNonceTxTTLOpt sets the number of blocks after which a transaction
+// which was neither included in a block nor re-checked is evicted. A value <= 0
+// means the transactions are never evicted.
+func SenderNonceTxTTLOpt(ttl int64) SenderNonceOption {
+	return func(mp *SenderNonceMempool) {
+		mp.txTTL = ttl
+	}
+}
+
+// NewSenderNonceMempool creates a new SenderNonceMempool with the given
+// options.
+func NewSenderNonceMempool(opts ...SenderNonceOption) *SenderNonceMempool {
+	mp := &SenderNonceMempool{
+		senders: make(map[string]*nonceTxs),
+		seed:    DefaultSenderNonceSeed,
+	}
+
+	for _, opt := range opts {
+		opt(mp)
+	}
+
+	return mp
+}
+
+// Insert adds a tx to the mempool. A tx with the same sender and nonce as a
+// tx already in the mempool replaces it, while an unordered tx is only
+// replaced by itself. The expired txs are evicted before a new tx is rejected
+// at capacity.
+func (mp *SenderNonceMempool) Insert(ctx sdk.Context, tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	meta, err := getTxMeta(tx)
+	if err != nil {
+		return err
+	}
+
+	txs, ok := mp.senders[meta.group]
+	isNew := !ok || txs.find(meta.nonce) < 0
+	if isNew && mp.maxTx > 0 && mp.count >= mp.maxTx {
+		mp.count -= evictExpired(mp.senders, ctx.BlockHeight(), mp.txTTL)
+		if mp.count >= mp.maxTx {
+			return ErrMempoolTxMaxCapacity
+		}
+
+		txs, ok = mp.senders[meta.group]
+	}
+
+	if !ok {
+		txs = &nonceTxs{}
+		mp.senders[meta.group] = txs
+	}
+
+	if txs.put(&txEntry{meta: meta, height: ctx.BlockHeight(), tx: tx}) {
+		mp.count++
+	}
+
+	return nil
+}
+
+// Select returns an Iterator over the mempool, after evicting the expired txs.
+// The transactions of a single sender are returned in nonce order, while
+// senders are interleaved at random.
+func (mp *SenderNonceMempool) Select(ctx sdk.Context, _ [][]byte) Iterator {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	mp.count -= evictExpired(mp.senders, ctx.BlockHeight(), mp.txTTL)
+
+	senders := make([]string, 0, len(mp.senders))
+	for sender := range mp.senders {
+		senders = append(senders, sender)
+	}
+	sort.Strings(senders)
+
+	cursors := make(map[string]int, len(senders))
+	r := rand.New(rand.NewSource(mp.seed)) // #nosec G404 -- determinism is wanted here
+	selected := make([]sdk.Tx, 0, mp.count)
+
+	for len(senders) > 0 {
+		i := r.Intn(len(senders))
+		sender := senders[i]
+
+		entries := mp.senders[sender].entries
+		selected = append(selected, entries[cursors[sender]].tx)
+		cursors[sender]++
+
+		if cursors[sender] == len(entries) {
+			senders = append(senders[:i], senders[i+1:]...)
+		}
+	}
+
+	return newSliceIterator(selected)
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *SenderNonceMempool) CountTx() int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	return mp.count
+}
+
+// Remove removes a tx from the mempool. It returns ErrTxNotFound if no tx with
+// the same sender and nonce, or no such unordered tx, is present.
+func (mp *SenderNonceMempool) Remove(tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	meta, err := getTxMeta(tx)
+	if err != nil {
+		return err
+	}
+
+	txs, ok := mp.senders[meta.group]
+	if !ok || !txs.remove(meta.nonce) {
+		return ErrTxNotFound
+	}
+
+	if len(txs.entries) == 0 {
+		delete(mp.senders, meta.group)
+	}
+
+	mp.count--
+	return nil
+}
+
+// txEntry is a transaction held by a mempool together with its ordering data.
+type txEntry struct {
+	meta     txMeta
+	priority int64
+	// seq is the insertion sequence of the tx, used to break priority ties in
+	// a first-in-first-out manner.
+	seq uint64
+	// height is the block height at which the tx was inserted, or last
+	// re-checked.
+	height int64
+	tx     sdk.Tx
+}
+
+// nonceTxs holds the transactions of a single sender sorted by nonce.
+type nonceTxs struct {
+	entries []*txEntry
+}
+
+// search returns the index at which a tx with the given nonce is, or should
+// be inserted.
+func (n *nonceTxs) search(nonce uint64) int {
+	return sort.Search(len(n.entries), func(i int) bool {
+		return n.entries[i].meta.nonce >= nonce
+	})
+}
+
+// find returns the index of the tx with the given nonce, or -1.
+func (n *nonceTxs) find(nonce uint64) int {
+	i := n.search(nonce)
+	if i < len(n.entries) && n.entries[i].meta.nonce == nonce {
+		return i
+	}
+
+	return -1
+}
+
+// put inserts e keeping the nonce ordering, replacing any entry with the same
+// nonce. It returns true if a new entry was added.
+func (n *nonceTxs) put(e *txEntry) bool {
+	i := n.search(e.meta.nonce)
+	if i < len(n.entries) && n.entries[i].meta.nonce == e.meta.nonce {
+		n.entries[i] = e
+		return false
+	}
+
+	n.entries = append(n.entries, nil)
+	copy(n.entries[i+1:], n.entries[i:])
+	n.entries[i] = e
+
+	return true
+}
+
+// remove deletes the entry with the given nonce. It returns false if no such
+// entry exists.
+func (n *nonceTxs) remove(nonce uint64) bool {
+	i := n.find(nonce)
+	if i < 0 {
+		return false
+	}
+
+	n.entries = append(n.entries[:i], n.entries[i+1:]...)
+	return true
+}
@@ -0,0 +1,85 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+func TestSenderNonceMempoolSelect(t *testing.T) {
+	txs := []testTx{
+		{id: 0, sender: alice, nonce: 2, priority: 100},
+		{id: 1, sender: alice, nonce: 0},
+		{id: 2, sender: bob, nonce: 1},
+		{id: 3, sender: alice, nonce: 1},
+		{id: 4, sender: bob, nonce: 0, priority: 100},
+		{id: 5, sender: carol, nonce: 7},
+	}
+
+	for _, seed := range []int64{1, 2, 3, 42} {
+		mp := mempool.NewSenderNonceMempool(mempool.SenderNonceSeedOpt(seed))
+		insertAll(t, mp, txs)
+		require.Equal(t, len(txs), mp.CountTx())
+
+		ids := selectIDs(mp)
+		require.Len(t, ids, len(txs))
+
+		// each sender's txs are returned in nonce order
+		nonces := make(map[string]uint64)
+		seen := make(map[string]bool)
+		for _, id := range ids {
+			tx := txs[id]
+			sender := tx.sender.String()
+			if seen[sender] {
+				require.Greater(t, tx.nonce, nonces[sender])
+			}
+			seen[sender] = true
+			nonces[sender] = tx.nonce
+		}
+
+		// the selection is deterministic for a given seed
+		require.Equal(t, ids, selectIDs(mp))
+	}
+}
+
+func TestSenderNonceMempoolRemove(t *testing.T) {
+	mp := mempool.NewSenderNonceMempool()
+	txs := []testTx{
+		{id: 0, sender: alice, nonce: 0},
+		{id: 1, sender: alice, nonce: 1},
+	}
+	insertAll(t, mp, txs)
+
+	require.NoError(t, mp.Remove(txs[0]))
+	require.Equal(t, []int{1}, selectIDs(mp))
+	require.ErrorIs(t, mp.Remove(txs[0]), mempool.ErrTxNotFound)
+
+	require.NoError(t, mp.Remove(txs[1]))
+	require.Equal(t, 0, mp.CountTx())
+	require.Nil(t, mp.Select(newTestCtx(), nil))
+}
+
+func TestSenderNonceMempoolMaxTx(t *testing.T) {
+	mp := mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(1))
+	insertAll(t, mp, []testTx{{id: 0, sender: alice}})
+
+	err := mp.Insert(newTestCtx(), testTx{id: 1, sender: bob})
+	require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []int{0}, selectIDs(mp))
+}
+
+func TestSenderNonceMempoolTxTTL(t *testing.T) {
+	mp := mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(1), mempool.SenderNonceTxTTLOpt(2))
+	require.NoError(t, mp.Insert(newTestCtx().WithBlockHeight(1), testTx{id: 0, sender: alice}))
+
+	err := mp.Insert(newTestCtx().WithBlockHeight(2), testTx{id: 1, sender: bob})
+	require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+
+	// the expired tx is evicted instead of rejecting the new one
+	require.NoError(t, mp.Insert(newTestCtx().WithBlockHeight(3), testTx{id: 1, sender: bob}))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []int{1}, selectIDs(mp))
+}
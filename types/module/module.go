@@ -42,6 +42,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
 )
 
 // AppModuleBasic is the standard form for basic non-dependant elements of an application module.
@@ -85,6 +86,15 @@ func (bm BasicManager) RegisterInterfaces(registry codectypes.InterfaceRegistry)
 	}
 }
 
+// RegisterKVSchemas registers the key/value schemas of the modules implementing HasKVSchema
+func (bm BasicManager) RegisterKVSchemas(registry *schema.Registry) {
+	for _, b := range bm {
+		if b, ok := b.(HasKVSchema); ok {
+			b.RegisterKVSchema(registry)
+		}
+	}
+}
+
 // DefaultGenesis provides default genesis information for all modules
 func (bm BasicManager) DefaultGenesis(cdc codec.JSONCodec) map[string]json.RawMessage {
 	genesis := make(map[string]json.RawMessage)
@@ -175,6 +185,12 @@ type AppModule interface {
 	EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate
 }
 
+// HasKVSchema is the interface of the modules declaring the key/value schema of their
+// store, used by tooling to decode the store entries.
+type HasKVSchema interface {
+	RegisterKVSchema(*schema.Registry)
+}
+
 // GenesisOnlyAppModule is an AppModule that only has import/export functionality
 type GenesisOnlyAppModule struct {
 	AppModuleGenesis
@@ -299,6 +315,15 @@ func (m *Manager) RegisterServices(cfg Configurator) {
 	}
 }
 
+// RegisterKVSchemas registers the key/value schemas of the modules implementing HasKVSchema
+func (m *Manager) RegisterKVSchemas(registry *schema.Registry) {
+	for _, module := range m.Modules {
+		if module, ok := module.(HasKVSchema); ok {
+			module.RegisterKVSchema(registry)
+		}
+	}
+}
+
 // InitGenesis performs init genesis functionality for modules. Exactly one
 // module must return a non-empty validator set update to correctly initialize
 // the chain.
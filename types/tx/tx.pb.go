@@ -364,6 +364,14 @@ type TxBody struct {
 	// timeout is the block height after which this transaction will not
 	// be processed by the chain
 	TimeoutHeight uint64 `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	// unordered, when set to true, indicates that the transaction signers are not
+	// checking and incrementing their account sequence. The transaction is instead
+	// protected against replays by its timeout_height, which must be set and close
+	// to the current block height, and is rejected if it was already included
+	// before its timeout.
+	//
+	// Since: cosmos-sdk 0.47
+	Unordered bool `protobuf:"varint,4,opt,name=unordered,proto3" json:"unordered,omitempty"`
 	// extension_options are arbitrary options that can be added by chains
 	// when the default options are not sufficient. If any of these are present
 	// and can't be handled, the transaction will be rejected
@@ -428,6 +436,13 @@ func (m *TxBody) GetTimeoutHeight() uint64 {
 	return 0
 }
 
+func (m *TxBody) GetUnordered() bool {
+	if m != nil {
+		return m.Unordered
+	}
+	return false
+}
+
 func (m *TxBody) GetExtensionOptions() []*types.Any {
 	if m != nil {
 		return m.ExtensionOptions
@@ -1019,71 +1034,72 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/tx.proto", fileDescriptor_96d1575ffde80842) }
 
 var fileDescriptor_96d1575ffde80842 = []byte{
-	// 1015 bytes of a gzipped FileDescriptorProto
+	// 1025 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x66, 0x6d, 0xc7, 0x7e, 0x4d, 0xda, 0x74, 0x14, 0x21, 0xc7, 0x51, 0xdd, 0xe0, 0xaa,
+	0x14, 0xf6, 0x7a, 0x6d, 0xc7, 0x7e, 0x4d, 0xfa, 0x63, 0x14, 0xa1, 0x8d, 0x43, 0xdd, 0xe0, 0xaa,
 	0xe0, 0x4b, 0x76, 0xd3, 0xf4, 0x40, 0x41, 0x08, 0xb0, 0x1b, 0xaa, 0x54, 0xa5, 0x20, 0x4d, 0x72,
-	0xea, 0x65, 0x35, 0x5e, 0x4f, 0xd6, 0xa3, 0x7a, 0x67, 0x96, 0x9d, 0x59, 0xb0, 0xff, 0x08, 0xa4,
-	0x0a, 0x09, 0x71, 0xe1, 0xc0, 0x99, 0x33, 0x7f, 0x44, 0x4f, 0xa8, 0xe2, 0xc4, 0x09, 0xaa, 0xe4,
-	0x88, 0xc4, 0xbf, 0x00, 0x9a, 0xd9, 0xd9, 0x4d, 0x5a, 0x5c, 0x1b, 0x04, 0xea, 0x69, 0xe7, 0xc7,
-	0xf7, 0xbe, 0xf9, 0xde, 0xbc, 0x6f, 0xdf, 0x40, 0x3b, 0x14, 0x32, 0x16, 0xd2, 0x57, 0x53, 0xff,
-	0x8b, 0x5b, 0x43, 0xaa, 0xc8, 0x2d, 0x5f, 0x4d, 0xbd, 0x24, 0x15, 0x4a, 0xa0, 0xab, 0xf9, 0x9e,
-	0xa7, 0xa6, 0x9e, 0xdd, 0x6b, 0x6f, 0x46, 0x22, 0x12, 0x66, 0xd7, 0xd7, 0xa3, 0x1c, 0xd8, 0xde,
-	0xb5, 0x24, 0x61, 0x3a, 0x4b, 0x94, 0xf0, 0xe3, 0x6c, 0xa2, 0x98, 0x64, 0x51, 0xc9, 0x58, 0x2c,
-	0x58, 0x78, 0xc7, 0xc2, 0x87, 0x44, 0xd2, 0x12, 0x13, 0x0a, 0xc6, 0xed, 0xfe, 0xdb, 0xe7, 0x9a,
-	0x24, 0x8b, 0x38, 0xe3, 0xe7, 0x4c, 0x76, 0x6e, 0x81, 0x5b, 0x91, 0x10, 0xd1, 0x84, 0xfa, 0x66,
-	0x36, 0xcc, 0x4e, 0x7c, 0xc2, 0x67, 0xc5, 0x56, 0xce, 0x11, 0xe4, 0x5a, 0x6d, 0x22, 0x66, 0xd2,
-	0xfd, 0xca, 0x81, 0x95, 0xe3, 0x29, 0xda, 0x85, 0xea, 0x50, 0x8c, 0x66, 0x2d, 0x67, 0xc7, 0xe9,
-	0x5d, 0xda, 0xdf, 0xf2, 0xfe, 0x96, 0xac, 0x77, 0x3c, 0x1d, 0x88, 0xd1, 0x0c, 0x1b, 0x18, 0xba,
-	0x03, 0x4d, 0x92, 0xa9, 0x71, 0xc0, 0xf8, 0x89, 0x68, 0xad, 0x98, 0x98, 0xed, 0x39, 0x31, 0xfd,
-	0x4c, 0x8d, 0xef, 0xf3, 0x13, 0x81, 0x1b, 0xc4, 0x8e, 0x50, 0x07, 0x40, 0xcb, 0x26, 0x2a, 0x4b,
-	0xa9, 0x6c, 0xb9, 0x3b, 0x6e, 0x6f, 0x0d, 0x5f, 0x58, 0xe9, 0x72, 0xa8, 0x1d, 0x4f, 0x31, 0xf9,
-	0x12, 0x5d, 0x03, 0xd0, 0x47, 0x05, 0xc3, 0x99, 0xa2, 0xd2, 0xe8, 0x5a, 0xc3, 0x4d, 0xbd, 0x32,
-	0xd0, 0x0b, 0xe8, 0x2d, 0xb8, 0x52, 0x2a, 0xb0, 0x98, 0x15, 0x83, 0x59, 0x2f, 0x8e, 0xca, 0x71,
-	0xcb, 0xce, 0xfb, 0xda, 0x81, 0xd5, 0x23, 0x16, 0xf1, 0x03, 0x11, 0xfe, 0x5f, 0x47, 0x6e, 0x41,
-	0x23, 0x1c, 0x13, 0xc6, 0x03, 0x36, 0x6a, 0xb9, 0x3b, 0x4e, 0xaf, 0x89, 0x57, 0xcd, 0xfc, 0xfe,
-	0x08, 0xdd, 0x84, 0xcb, 0x24, 0x0c, 0x45, 0xc6, 0x55, 0xc0, 0xb3, 0x78, 0x48, 0xd3, 0x56, 0x75,
-	0xc7, 0xe9, 0x55, 0xf1, 0xba, 0x5d, 0xfd, 0xd4, 0x2c, 0x76, 0xff, 0x70, 0x60, 0xc3, 0x8a, 0x3a,
-	0x60, 0x29, 0x0d, 0x55, 0x3f, 0x9b, 0x2e, 0x53, 0x77, 0x1b, 0x20, 0xc9, 0x86, 0x13, 0x16, 0x06,
-	0x8f, 0xe9, 0xcc, 0xd6, 0x64, 0xd3, 0xcb, 0x3d, 0xe1, 0x15, 0x9e, 0xf0, 0xfa, 0x7c, 0x86, 0x9b,
-	0x39, 0xee, 0x01, 0x9d, 0xfd, 0x77, 0xa9, 0xa8, 0x0d, 0x0d, 0x49, 0x3f, 0xcf, 0x28, 0x0f, 0x69,
-	0xab, 0x66, 0x00, 0xe5, 0x1c, 0xf5, 0xc0, 0x55, 0x2c, 0x69, 0xd5, 0x8d, 0x96, 0x37, 0xe6, 0x79,
-	0x8a, 0x25, 0x58, 0x43, 0xba, 0xdf, 0xac, 0x40, 0x3d, 0x37, 0x18, 0xda, 0x83, 0x46, 0x4c, 0xa5,
-	0x24, 0x91, 0x49, 0xd2, 0x7d, 0x65, 0x16, 0x25, 0x0a, 0x21, 0xa8, 0xc6, 0x34, 0xce, 0x7d, 0xd8,
-	0xc4, 0x66, 0xac, 0xd5, 0x2b, 0x16, 0x53, 0x91, 0xa9, 0x60, 0x4c, 0x59, 0x34, 0x56, 0x26, 0xbd,
-	0x2a, 0x5e, 0xb7, 0xab, 0x87, 0x66, 0x11, 0x0d, 0xe0, 0x2a, 0x9d, 0x2a, 0xca, 0x25, 0x13, 0x3c,
-	0x10, 0x89, 0x62, 0x82, 0xcb, 0xd6, 0x9f, 0xab, 0x0b, 0x8e, 0xdd, 0x28, 0xf1, 0x9f, 0xe5, 0x70,
-	0xf4, 0x08, 0x3a, 0x5c, 0xf0, 0x20, 0x4c, 0x99, 0x62, 0x21, 0x99, 0x04, 0x73, 0x08, 0xaf, 0x2c,
-	0x20, 0xdc, 0xe6, 0x82, 0xdf, 0xb5, 0xb1, 0x1f, 0xbf, 0xc4, 0xdd, 0xfd, 0xde, 0x81, 0x46, 0xf1,
-	0x13, 0xa1, 0x8f, 0x60, 0x4d, 0x1b, 0x97, 0xa6, 0xc6, 0x81, 0xc5, 0xed, 0x5c, 0x9b, 0x73, 0xaf,
-	0x47, 0x06, 0x66, 0xfe, 0xbc, 0x4b, 0xb2, 0x1c, 0x4b, 0x5d, 0x90, 0x13, 0x4a, 0xad, 0x39, 0xe6,
-	0x15, 0xe4, 0x1e, 0xa5, 0x58, 0x43, 0x8a, 0xd2, 0xb9, 0xcb, 0x4b, 0xf7, 0xad, 0x03, 0x70, 0x7e,
-	0xde, 0x4b, 0x36, 0x74, 0xfe, 0x99, 0x0d, 0xef, 0x40, 0x33, 0x16, 0x23, 0xba, 0xac, 0x9d, 0x3c,
-	0x14, 0x23, 0x9a, 0xb7, 0x93, 0xd8, 0x8e, 0x5e, 0xb0, 0x9f, 0xfb, 0xa2, 0xfd, 0xba, 0xcf, 0x57,
-	0xa0, 0x51, 0x84, 0xa0, 0xf7, 0xa1, 0x2e, 0x19, 0x8f, 0x26, 0xd4, 0x6a, 0xea, 0x2e, 0xe0, 0xf7,
-	0x8e, 0x0c, 0xf2, 0xb0, 0x82, 0x6d, 0x0c, 0x7a, 0x17, 0x6a, 0xa6, 0x6d, 0x5b, 0x71, 0x6f, 0x2e,
-	0x0a, 0x7e, 0xa8, 0x81, 0x87, 0x15, 0x9c, 0x47, 0xb4, 0xfb, 0x50, 0xcf, 0xe9, 0xd0, 0x3b, 0x50,
-	0xd5, 0xba, 0x8d, 0x80, 0xcb, 0xfb, 0x37, 0x2e, 0x70, 0x14, 0x8d, 0xfc, 0x62, 0xfd, 0x34, 0x1f,
-	0x36, 0x01, 0xed, 0x27, 0x0e, 0xd4, 0x0c, 0x2b, 0x7a, 0x00, 0x8d, 0x21, 0x53, 0x24, 0x4d, 0x49,
-	0x71, 0xb7, 0x7e, 0x41, 0x93, 0x3f, 0x37, 0x5e, 0xf9, 0xba, 0x14, 0x5c, 0x77, 0x45, 0x9c, 0x90,
-	0x50, 0x0d, 0x98, 0xea, 0xeb, 0x30, 0x5c, 0x12, 0xa0, 0xf7, 0x00, 0xca, 0x5b, 0xd7, 0xad, 0xcc,
-	0x5d, 0x76, 0xed, 0xcd, 0xe2, 0xda, 0xe5, 0xa0, 0x06, 0xae, 0xcc, 0xe2, 0xee, 0xef, 0x0e, 0xb8,
-	0xf7, 0x28, 0x45, 0x21, 0xd4, 0x49, 0xac, 0xbb, 0x82, 0x35, 0x65, 0xf9, 0x80, 0xe8, 0x57, 0xed,
-	0x82, 0x14, 0xc6, 0x07, 0x7b, 0x4f, 0x7f, 0xbd, 0x5e, 0xf9, 0xe1, 0xb7, 0xeb, 0xbd, 0x88, 0xa9,
-	0x71, 0x36, 0xf4, 0x42, 0x11, 0xfb, 0xc5, 0x8b, 0x69, 0x3e, 0xbb, 0x72, 0xf4, 0xd8, 0x57, 0xb3,
-	0x84, 0x4a, 0x13, 0x20, 0xb1, 0xa5, 0x46, 0xdb, 0xd0, 0x8c, 0x88, 0x0c, 0x26, 0x2c, 0x66, 0xca,
-	0x14, 0xa2, 0x8a, 0x1b, 0x11, 0x91, 0x9f, 0xe8, 0x39, 0xf2, 0xa0, 0x96, 0x90, 0x19, 0x4d, 0xf3,
-	0x36, 0x36, 0x68, 0xfd, 0xfc, 0xe3, 0xee, 0xa6, 0xd5, 0xd0, 0x1f, 0x8d, 0x52, 0x2a, 0xe5, 0x91,
-	0x4a, 0x19, 0x8f, 0x70, 0x0e, 0x43, 0xfb, 0xb0, 0x1a, 0xa5, 0x84, 0x2b, 0xdb, 0xd7, 0x16, 0x45,
-	0x14, 0xc0, 0xee, 0x77, 0x0e, 0xb8, 0xc7, 0x2c, 0x79, 0x3d, 0xd9, 0xee, 0x41, 0x5d, 0xb1, 0x24,
-	0xa1, 0x69, 0xde, 0xd7, 0x16, 0xe8, 0xb3, 0xb8, 0xee, 0x4f, 0x0e, 0xac, 0xf7, 0xb3, 0x69, 0xfe,
-	0x33, 0x1e, 0x10, 0x45, 0x74, 0x92, 0x24, 0x87, 0x1a, 0xb7, 0x2c, 0x4c, 0xd2, 0x02, 0xd1, 0x07,
-	0xd0, 0xd0, 0x76, 0x0c, 0x46, 0x22, 0xb4, 0x6e, 0xbf, 0xf1, 0x8a, 0x0e, 0x73, 0xf1, 0x75, 0xc2,
-	0xab, 0xd2, 0x3e, 0xa2, 0x85, 0xcb, 0xdd, 0x7f, 0xe9, 0x72, 0xb4, 0x01, 0xae, 0x64, 0x91, 0xa9,
-	0xc6, 0x1a, 0xd6, 0xc3, 0xc1, 0x87, 0x4f, 0x4f, 0x3b, 0xce, 0xb3, 0xd3, 0x8e, 0xf3, 0xfc, 0xb4,
-	0xe3, 0x3c, 0x39, 0xeb, 0x54, 0x9e, 0x9d, 0x75, 0x2a, 0xbf, 0x9c, 0x75, 0x2a, 0x8f, 0x6e, 0x2e,
-	0xbf, 0x4e, 0x5f, 0x4d, 0x87, 0x75, 0xd3, 0x70, 0x6e, 0xff, 0x15, 0x00, 0x00, 0xff, 0xff, 0x4d,
-	0x26, 0x62, 0x70, 0xd8, 0x09, 0x00, 0x00,
+	0xea, 0x65, 0x35, 0xde, 0x9d, 0xac, 0x47, 0xf5, 0xce, 0x2c, 0x3b, 0xb3, 0x60, 0xff, 0x11, 0x48,
+	0x15, 0x17, 0x2e, 0x1c, 0x38, 0x73, 0x85, 0x3f, 0xa2, 0x27, 0x54, 0x71, 0xe2, 0x04, 0x55, 0x72,
+	0x44, 0xe2, 0x5f, 0x00, 0xed, 0xec, 0xec, 0x26, 0x2d, 0x89, 0x0d, 0x02, 0x71, 0xda, 0x99, 0x37,
+	0xdf, 0xfb, 0xe6, 0x9b, 0x79, 0xdf, 0xbe, 0x81, 0x6e, 0x20, 0x64, 0x2c, 0xa4, 0xa7, 0x66, 0xde,
+	0xe7, 0xb7, 0xc7, 0x54, 0x91, 0xdb, 0x9e, 0x9a, 0xb9, 0x49, 0x2a, 0x94, 0x40, 0xd7, 0x8a, 0x35,
+	0x57, 0xcd, 0x5c, 0xb3, 0xd6, 0x5d, 0x8f, 0x44, 0x24, 0xf4, 0xaa, 0x97, 0x8f, 0x0a, 0x60, 0x77,
+	0xdb, 0x90, 0x04, 0xe9, 0x3c, 0x51, 0xc2, 0x8b, 0xb3, 0xa9, 0x62, 0x92, 0x45, 0x15, 0x63, 0x19,
+	0x30, 0xf0, 0x9e, 0x81, 0x8f, 0x89, 0xa4, 0x15, 0x26, 0x10, 0x8c, 0x9b, 0xf5, 0xb7, 0x4e, 0x35,
+	0x49, 0x16, 0x71, 0xc6, 0x4f, 0x99, 0xcc, 0xdc, 0x00, 0x37, 0x22, 0x21, 0xa2, 0x29, 0xf5, 0xf4,
+	0x6c, 0x9c, 0x1d, 0x79, 0x84, 0xcf, 0xcb, 0xa5, 0x82, 0xc3, 0x2f, 0xb4, 0x9a, 0x83, 0xe8, 0x49,
+	0xff, 0x4b, 0x0b, 0xea, 0x87, 0x33, 0xb4, 0x0d, 0x8d, 0xb1, 0x08, 0xe7, 0x8e, 0xb5, 0x65, 0x0d,
+	0x2e, 0xed, 0x6e, 0xb8, 0x7f, 0x39, 0xac, 0x7b, 0x38, 0x1b, 0x89, 0x70, 0x8e, 0x35, 0x0c, 0xdd,
+	0x85, 0x0e, 0xc9, 0xd4, 0xc4, 0x67, 0xfc, 0x48, 0x38, 0x75, 0x9d, 0xb3, 0x79, 0x4e, 0xce, 0x30,
+	0x53, 0x93, 0x07, 0xfc, 0x48, 0xe0, 0x36, 0x31, 0x23, 0xd4, 0x03, 0xc8, 0x65, 0x13, 0x95, 0xa5,
+	0x54, 0x3a, 0xf6, 0x96, 0x3d, 0x58, 0xc5, 0x67, 0x22, 0x7d, 0x0e, 0xcd, 0xc3, 0x19, 0x26, 0x5f,
+	0xa0, 0xeb, 0x00, 0xf9, 0x56, 0xfe, 0x78, 0xae, 0xa8, 0xd4, 0xba, 0x56, 0x71, 0x27, 0x8f, 0x8c,
+	0xf2, 0x00, 0x7a, 0x13, 0xae, 0x54, 0x0a, 0x0c, 0xa6, 0xae, 0x31, 0x6b, 0xe5, 0x56, 0x05, 0x6e,
+	0xd9, 0x7e, 0x5f, 0x59, 0xb0, 0x72, 0xc0, 0x22, 0xbe, 0x27, 0x82, 0xff, 0x6a, 0xcb, 0x0d, 0x68,
+	0x07, 0x13, 0xc2, 0xb8, 0xcf, 0x42, 0xc7, 0xde, 0xb2, 0x06, 0x1d, 0xbc, 0xa2, 0xe7, 0x0f, 0x42,
+	0x74, 0x0b, 0x2e, 0x93, 0x20, 0x10, 0x19, 0x57, 0x3e, 0xcf, 0xe2, 0x31, 0x4d, 0x9d, 0xc6, 0x96,
+	0x35, 0x68, 0xe0, 0x35, 0x13, 0xfd, 0x44, 0x07, 0xfb, 0xbf, 0x5b, 0x70, 0xd5, 0x88, 0xda, 0x63,
+	0x29, 0x0d, 0xd4, 0x30, 0x9b, 0x2d, 0x53, 0x77, 0x07, 0x20, 0xc9, 0xc6, 0x53, 0x16, 0xf8, 0x4f,
+	0xe8, 0xdc, 0xd4, 0x64, 0xdd, 0x2d, 0x3c, 0xe1, 0x96, 0x9e, 0x70, 0x87, 0x7c, 0x8e, 0x3b, 0x05,
+	0xee, 0x21, 0x9d, 0xff, 0x7b, 0xa9, 0xa8, 0x0b, 0x6d, 0x49, 0x3f, 0xcb, 0x28, 0x0f, 0xa8, 0xd3,
+	0xd4, 0x80, 0x6a, 0x8e, 0x06, 0x60, 0x2b, 0x96, 0x38, 0x2d, 0xad, 0xe5, 0xb5, 0xf3, 0x3c, 0xc5,
+	0x12, 0x9c, 0x43, 0xfa, 0xdf, 0xd7, 0xa1, 0x55, 0x18, 0x0c, 0xed, 0x40, 0x3b, 0xa6, 0x52, 0x92,
+	0x48, 0x1f, 0xd2, 0xbe, 0xf0, 0x14, 0x15, 0x0a, 0x21, 0x68, 0xc4, 0x34, 0x2e, 0x7c, 0xd8, 0xc1,
+	0x7a, 0x9c, 0xab, 0x57, 0x2c, 0xa6, 0x22, 0x53, 0xfe, 0x84, 0xb2, 0x68, 0xa2, 0xf4, 0xf1, 0x1a,
+	0x78, 0xcd, 0x44, 0xf7, 0x75, 0x10, 0xbd, 0x0e, 0x9d, 0x8c, 0x8b, 0x34, 0xa4, 0x29, 0x0d, 0xf5,
+	0xf9, 0xda, 0xf8, 0x34, 0x80, 0x46, 0x70, 0x8d, 0xce, 0x14, 0xe5, 0x92, 0x09, 0xee, 0x8b, 0x44,
+	0x31, 0xc1, 0xa5, 0xf3, 0xc7, 0xca, 0x02, 0x51, 0x57, 0x2b, 0xfc, 0xa7, 0x05, 0x1c, 0x3d, 0x86,
+	0x1e, 0x17, 0xdc, 0x0f, 0x52, 0xa6, 0x58, 0x40, 0xa6, 0xfe, 0x39, 0x84, 0x57, 0x16, 0x10, 0x6e,
+	0x72, 0xc1, 0xef, 0x99, 0xdc, 0x8f, 0x5e, 0xe1, 0xee, 0x7f, 0x6b, 0x41, 0xbb, 0xfc, 0xc5, 0xd0,
+	0x87, 0xb0, 0x9a, 0xdb, 0x9a, 0xa6, 0xda, 0x9f, 0xe5, 0xdd, 0x5d, 0x3f, 0xe7, 0xd6, 0x0f, 0x34,
+	0x4c, 0xff, 0x97, 0x97, 0x64, 0x35, 0x96, 0x79, 0xb9, 0x8e, 0x28, 0x75, 0xea, 0x17, 0x96, 0xeb,
+	0x3e, 0xa5, 0x38, 0x87, 0x94, 0x85, 0xb5, 0x97, 0x17, 0xf6, 0x6b, 0x0b, 0xe0, 0x74, 0xbf, 0x57,
+	0x4c, 0x6a, 0xfd, 0x3d, 0x93, 0xde, 0x85, 0x4e, 0x2c, 0x42, 0xba, 0xac, 0xd9, 0x3c, 0x12, 0x21,
+	0x2d, 0x9a, 0x4d, 0x6c, 0x46, 0x2f, 0x99, 0xd3, 0x7e, 0xd9, 0x9c, 0xfd, 0x17, 0x75, 0x68, 0x97,
+	0x29, 0xe8, 0x3d, 0x68, 0x49, 0xc6, 0xa3, 0x29, 0x35, 0x9a, 0xfa, 0x0b, 0xf8, 0xdd, 0x03, 0x8d,
+	0xdc, 0xaf, 0x61, 0x93, 0x83, 0xde, 0x81, 0xa6, 0x6e, 0xea, 0x46, 0xdc, 0x1b, 0x8b, 0x92, 0x1f,
+	0xe5, 0xc0, 0xfd, 0x1a, 0x2e, 0x32, 0xba, 0x43, 0x68, 0x15, 0x74, 0xe8, 0x6d, 0x68, 0xe4, 0xba,
+	0xb5, 0x80, 0xcb, 0xbb, 0x37, 0xcf, 0x70, 0x94, 0x6d, 0xfe, 0x6c, 0xfd, 0x72, 0x3e, 0xac, 0x13,
+	0xba, 0x4f, 0x2d, 0x68, 0x6a, 0x56, 0xf4, 0x10, 0xda, 0x63, 0xa6, 0x48, 0x9a, 0x92, 0xf2, 0x6e,
+	0xbd, 0x92, 0xa6, 0x78, 0x8c, 0xdc, 0xea, 0xed, 0x29, 0xb9, 0xee, 0x89, 0x38, 0x21, 0x81, 0x1a,
+	0x31, 0x35, 0xcc, 0xd3, 0x70, 0x45, 0x80, 0xde, 0x05, 0xa8, 0x6e, 0x3d, 0x6f, 0x74, 0xf6, 0xb2,
+	0x6b, 0xef, 0x94, 0xd7, 0x2e, 0x47, 0x4d, 0xb0, 0x65, 0x16, 0xf7, 0x7f, 0xb3, 0xc0, 0xbe, 0x4f,
+	0x29, 0x0a, 0xa0, 0x45, 0xe2, 0xbc, 0x67, 0x18, 0x53, 0x56, 0xcf, 0x4b, 0xfe, 0xe6, 0x9d, 0x91,
+	0xc2, 0xf8, 0x68, 0xe7, 0xd9, 0x2f, 0x37, 0x6a, 0xdf, 0xfd, 0x7a, 0x63, 0x10, 0x31, 0x35, 0xc9,
+	0xc6, 0x6e, 0x20, 0x62, 0xaf, 0x7c, 0x4f, 0xf5, 0x67, 0x5b, 0x86, 0x4f, 0x3c, 0x35, 0x4f, 0xa8,
+	0xd4, 0x09, 0x12, 0x1b, 0x6a, 0xb4, 0x09, 0x9d, 0x88, 0x48, 0x7f, 0xca, 0x62, 0xa6, 0x74, 0x21,
+	0x1a, 0xb8, 0x1d, 0x11, 0xf9, 0x71, 0x3e, 0x47, 0x2e, 0x34, 0x13, 0x32, 0xa7, 0x69, 0xd1, 0xe4,
+	0x46, 0xce, 0x4f, 0x3f, 0x6c, 0xaf, 0x1b, 0x0d, 0xc3, 0x30, 0x4c, 0xa9, 0x94, 0x07, 0x2a, 0x65,
+	0x3c, 0xc2, 0x05, 0x0c, 0xed, 0xc2, 0x4a, 0x94, 0x12, 0xae, 0x4c, 0xd7, 0x5b, 0x94, 0x51, 0x02,
+	0xfb, 0xdf, 0x58, 0x60, 0x1f, 0xb2, 0xe4, 0xff, 0x39, 0xed, 0x0e, 0xb4, 0x14, 0x4b, 0x12, 0x9a,
+	0x3a, 0xf5, 0x25, 0xfa, 0x0c, 0xae, 0xff, 0xa3, 0x05, 0x6b, 0xc3, 0x6c, 0x56, 0xfc, 0x8c, 0x7b,
+	0x44, 0x91, 0xfc, 0x90, 0xa4, 0x80, 0x3a, 0xd6, 0x12, 0x92, 0x12, 0x88, 0xde, 0x87, 0x76, 0x6e,
+	0x47, 0x3f, 0x14, 0x81, 0x71, 0xfb, 0xcd, 0x0b, 0x3a, 0xcc, 0xd9, 0xb7, 0x0b, 0xaf, 0xc8, 0x22,
+	0x52, 0xb9, 0xdc, 0xfe, 0x87, 0x2e, 0x47, 0x57, 0xc1, 0x96, 0x2c, 0xd2, 0xd5, 0x58, 0xc5, 0xf9,
+	0x70, 0xf4, 0xc1, 0xb3, 0xe3, 0x9e, 0xf5, 0xfc, 0xb8, 0x67, 0xbd, 0x38, 0xee, 0x59, 0x4f, 0x4f,
+	0x7a, 0xb5, 0xe7, 0x27, 0xbd, 0xda, 0xcf, 0x27, 0xbd, 0xda, 0xe3, 0x5b, 0xcb, 0xaf, 0xd3, 0x53,
+	0xb3, 0x71, 0x4b, 0x37, 0x9c, 0x3b, 0x7f, 0x0e, 0x00, 0x9e, 0x57, 0xda, 0xcc, 0xf6, 0x09, 0x00,
+	0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1360,6 +1376,16 @@ func (m *TxBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xfa
 		}
 	}
+	if m.Unordered {
+		i--
+		if m.Unordered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.TimeoutHeight != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutHeight))
 		i--
@@ -1938,6 +1964,9 @@ func (m *TxBody) Size() (n int) {
 	if m.TimeoutHeight != 0 {
 		n += 1 + sovTx(uint64(m.TimeoutHeight))
 	}
+	if m.Unordered {
+		n += 2
+	}
 	if len(m.ExtensionOptions) > 0 {
 		for _, e := range m.ExtensionOptions {
 			l = e.Size()
@@ -2951,6 +2980,26 @@ func (m *TxBody) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unordered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unordered = bool(v != 0)
 		case 1023:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionOptions", wireType)
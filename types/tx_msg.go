@@ -67,6 +67,15 @@ type (
 
 		GetTimeoutHeight() uint64
 	}
+
+	// TxWithUnordered extends the TxWithTimeoutHeight interface by allowing a
+	// transaction to skip the account sequence checks of its signers, relying
+	// on its height timeout for replay protection.
+	TxWithUnordered interface {
+		TxWithTimeoutHeight
+
+		GetUnordered() bool
+	}
 )
 
 // TxDecoder unmarshals transaction bytes
@@ -17,6 +17,9 @@ type HandlerOptions struct {
 	SignModeHandler        authsigning.SignModeHandler
 	SigGasConsumer         func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker           TxFeeChecker
+	// UnorderedTxKeeper records the included unordered transactions. They are
+	// rejected if it is nil.
+	UnorderedTxKeeper UnorderedTxKeeper
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -40,6 +43,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		NewValidateBasicDecorator(),
 		NewTxTimeoutHeightDecorator(),
+		NewUnorderedTxDecorator(DefaultMaxUnorderedTTL, options.UnorderedTxKeeper),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
@@ -85,11 +85,12 @@ func (suite *AnteTestSuite) SetupTest(isCheckTx bool) {
 
 	anteHandler, err := ante.NewAnteHandler(
 		ante.HandlerOptions{
-			AccountKeeper:   suite.accountKeeper,
-			BankKeeper:      suite.bankKeeper,
-			FeegrantKeeper:  suite.feeGrantKeeper,
-			SignModeHandler: txConfig.SignModeHandler(),
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			AccountKeeper:     suite.accountKeeper,
+			BankKeeper:        suite.bankKeeper,
+			FeegrantKeeper:    suite.feeGrantKeeper,
+			SignModeHandler:   txConfig.SignModeHandler(),
+			SigGasConsumer:    ante.DefaultSigVerificationGasConsumer,
+			UnorderedTxKeeper: suite.accountKeeper,
 		},
 	)
 
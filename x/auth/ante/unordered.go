@@ -0,0 +1,103 @@
+package ante
+
+import (
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// DefaultMaxUnorderedTTL is the default maximum number of blocks between the block height
+// and the timeout height of an unordered transaction.
+const DefaultMaxUnorderedTTL = 1024
+
+// UnorderedTxKeeper defines the expected keeper of the unordered transactions included before
+// their timeout height.
+type UnorderedTxKeeper interface {
+	ContainsUnorderedTx(ctx sdk.Context, txHash []byte, timeoutHeight uint64) bool
+	AddUnorderedTx(ctx sdk.Context, txHash []byte, timeoutHeight uint64)
+}
+
+// UnorderedTxDecorator defines an AnteHandler decorator that protects the unordered
+// transactions against replays, in place of the account sequences of their signers. An
+// unordered transaction must have a timeout height at most maxTTL blocks after the block
+// height, and is rejected if a transaction with the same hash was included before its
+// timeout. The hash only covers the body and the auth info of the transaction, so that it
+// can't be replayed with other signature bytes. The included unordered transactions are
+// recorded with their timeout height, and are pruned once it is passed.
+//
+// CONTRACT: must be called after the TxTimeoutHeightDecorator, which rejects the timed out
+// transactions.
+type UnorderedTxDecorator struct {
+	maxTTL uint64
+	k      UnorderedTxKeeper
+}
+
+// NewUnorderedTxDecorator returns an UnorderedTxDecorator. The unordered transactions are
+// rejected if the keeper is nil.
+func NewUnorderedTxDecorator(maxTTL uint64, k UnorderedTxKeeper) UnorderedTxDecorator {
+	return UnorderedTxDecorator{
+		maxTTL: maxTTL,
+		k:      k,
+	}
+}
+
+func (d UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !IsUnordered(tx) {
+		return next(ctx, tx, simulate)
+	}
+	if d.k == nil {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrNotSupported, "unordered transactions are not supported")
+	}
+
+	timeoutHeight := tx.(sdk.TxWithUnordered).GetTimeoutHeight()
+	if timeoutHeight == 0 {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unordered transaction must have a timeout height")
+	}
+	if timeoutHeight > uint64(ctx.BlockHeight())+d.maxTTL {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"unordered transaction timeout height %d is more than %d blocks after the block height %d", timeoutHeight, d.maxTTL, ctx.BlockHeight(),
+		)
+	}
+
+	txHash, err := unorderedTxHash(ctx.TxBytes())
+	if err != nil {
+		return ctx, err
+	}
+	if d.k.ContainsUnorderedTx(ctx, txHash, timeoutHeight) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unordered transaction %X was already included", txHash)
+	}
+	if !simulate {
+		d.k.AddUnorderedTx(ctx, txHash, timeoutHeight)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// unorderedTxHash returns the hash identifying an unordered transaction, computed over the
+// body and the auth info bytes of the TxRaw, which are covered by the signatures, and not over
+// the signatures themselves.
+func unorderedTxHash(txBytes []byte) ([]byte, error) {
+	var raw tx.TxRaw
+	if err := raw.Unmarshal(txBytes); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+
+	signed := tx.TxRaw{BodyBytes: raw.BodyBytes, AuthInfoBytes: raw.AuthInfoBytes}
+	bz, err := signed.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
+
+// IsUnordered returns whether the transaction is unordered, i.e. whether the account sequences
+// of its signers are not checked and incremented.
+func IsUnordered(tx sdk.Tx) bool {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	return ok && unorderedTx.GetUnordered()
+}
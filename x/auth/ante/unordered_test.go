@@ -0,0 +1,117 @@
+package ante_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+func (suite *AnteTestSuite) TestUnorderedTx() {
+	suite.SetupTest(false) // setup
+
+	accounts := suite.CreateTestAccounts(1)
+	priv, acc := accounts[0].priv, accounts[0].acc
+	msg := testdata.NewTestMsg(acc.GetAddress())
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	blockHeight := suite.ctx.BlockHeight()
+
+	testCases := []struct {
+		name          string
+		timeoutHeight uint64
+		sequence      uint64
+		expErr        error
+	}{
+		{"no timeout height", 0, 0, sdkerrors.ErrInvalidRequest},
+		{"timeout height too far", uint64(blockHeight) + ante.DefaultMaxUnorderedTTL + 1, 0, sdkerrors.ErrInvalidRequest},
+		{"non zero sequence", uint64(blockHeight) + 10, 1, sdkerrors.ErrWrongSequence},
+		{"valid", uint64(blockHeight) + ante.DefaultMaxUnorderedTTL, 0, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+			suite.txBuilder.SetFeeAmount(feeAmount)
+			suite.txBuilder.SetGasLimit(gasLimit)
+			suite.txBuilder.SetTimeoutHeight(tc.timeoutHeight)
+			suite.txBuilder.SetUnordered(true)
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{tc.sequence}
+			tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+			txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+			suite.Require().NoError(err)
+
+			ctx := suite.ctx.WithTxBytes(txBytes)
+			_, err = suite.anteHandler(ctx, tx, false)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the sequence of the signer is not incremented
+			suite.Require().Equal(acc.GetSequence(), suite.accountKeeper.GetAccount(ctx, acc.GetAddress()).GetSequence())
+
+			// the tx can't be replayed before its timeout
+			_, err = suite.anteHandler(ctx, tx, false)
+			suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestUnorderedTxNotSupported() {
+	suite.SetupTest(false) // setup
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetTimeoutHeight(10)
+	suite.txBuilder.SetUnordered(true)
+
+	antehandler := ante.NewUnorderedTxDecorator(ante.DefaultMaxUnorderedTTL, nil)
+	_, err := antehandler.AnteHandle(suite.ctx, suite.txBuilder.GetTx(), false, nil)
+	suite.Require().ErrorIs(err, sdkerrors.ErrNotSupported)
+}
+
+func (suite *AnteTestSuite) TestUnorderedTxReplayWithOtherSignature() {
+	suite.SetupTest(false) // setup
+
+	accounts := suite.CreateTestAccounts(1)
+	priv, acc := accounts[0].priv, accounts[0].acc
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(acc.GetAddress())))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.SetTimeoutHeight(uint64(suite.ctx.BlockHeight()) + 10)
+	suite.txBuilder.SetUnordered(true)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+
+	_, err = suite.anteHandler(suite.ctx.WithTxBytes(txBytes), tx, false)
+	suite.Require().NoError(err)
+
+	// replay the same signed body with other signature bytes
+	var raw txtypes.TxRaw
+	suite.Require().NoError(raw.Unmarshal(txBytes))
+	raw.Signatures[0] = append([]byte{}, raw.Signatures[0]...)
+	raw.Signatures[0][0] ^= 0xff
+	replayBytes, err := raw.Marshal()
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(txBytes, replayBytes)
+	replay, err := suite.clientCtx.TxConfig.TxDecoder()(replayBytes)
+	suite.Require().NoError(err)
+
+	_, err = suite.anteHandler(suite.ctx.WithTxBytes(replayBytes), replay, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	suite.Require().Contains(err.Error(), "was already included")
+}
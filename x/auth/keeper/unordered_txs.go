@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ContainsUnorderedTx returns whether the unordered tx with the given hash and timeout height
+// was already included.
+func (ak AccountKeeper) ContainsUnorderedTx(ctx sdk.Context, txHash []byte, timeoutHeight uint64) bool {
+	return ctx.KVStore(ak.storeKey).Has(types.UnorderedTxKey(timeoutHeight, txHash))
+}
+
+// AddUnorderedTx records the inclusion of the unordered tx with the given hash and timeout
+// height, until its timeout.
+func (ak AccountKeeper) AddUnorderedTx(ctx sdk.Context, txHash []byte, timeoutHeight uint64) {
+	ctx.KVStore(ak.storeKey).Set(types.UnorderedTxKey(timeoutHeight, txHash), []byte{})
+}
+
+// RemoveExpiredUnorderedTxs removes the unordered txs whose timeout height is lower than the
+// current block height, which can't be included anymore. The txs timing out at the current
+// height are kept, as they are still accepted by CheckTx until the next block.
+func (ak AccountKeeper) RemoveExpiredUnorderedTxs(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(ak.storeKey), types.UnorderedTxsKeyPrefix)
+	end := sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()))
+	iterator := store.Iterator(nil, end)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
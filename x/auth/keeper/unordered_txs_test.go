@@ -0,0 +1,29 @@
+package keeper_test
+
+func (suite *KeeperTestSuite) TestUnorderedTxs() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	txHash1, txHash2 := []byte("tx1"), []byte("tx2")
+
+	suite.Require().False(suite.accountKeeper.ContainsUnorderedTx(ctx, txHash1, 12))
+	suite.accountKeeper.AddUnorderedTx(ctx, txHash1, 12)
+	suite.accountKeeper.AddUnorderedTx(ctx, txHash2, 13)
+	suite.Require().True(suite.accountKeeper.ContainsUnorderedTx(ctx, txHash1, 12))
+	suite.Require().False(suite.accountKeeper.ContainsUnorderedTx(ctx, txHash1, 13))
+
+	// nothing is removed before the timeout heights
+	suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(11))
+	suite.Require().True(suite.accountKeeper.ContainsUnorderedTx(ctx, txHash1, 12))
+	suite.Require().True(suite.accountKeeper.ContainsUnorderedTx(ctx, txHash2, 13))
+
+	// the txs are kept at their timeout height, and removed after it
+	suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(12))
+	suite.Require().True(suite.accountKeeper.ContainsUnorderedTx(ctx, txHash1, 12))
+	suite.Require().True(suite.accountKeeper.ContainsUnorderedTx(ctx, txHash2, 13))
+
+	suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(13))
+	suite.Require().False(suite.accountKeeper.ContainsUnorderedTx(ctx, txHash1, 12))
+	suite.Require().True(suite.accountKeeper.ContainsUnorderedTx(ctx, txHash2, 13))
+
+	suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(20))
+	suite.Require().False(suite.accountKeeper.ContainsUnorderedTx(ctx, txHash2, 13))
+}
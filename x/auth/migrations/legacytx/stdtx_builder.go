@@ -71,6 +71,13 @@ func (s *StdTxBuilder) SetTimeoutHeight(height uint64) {
 	s.TimeoutHeight = height
 }
 
+// SetUnordered panics for unordered transactions, which StdTx does not support.
+func (s *StdTxBuilder) SetUnordered(unordered bool) {
+	if unordered {
+		panic("StdTxBuilder does not support unordered transactions")
+	}
+}
+
 // SetFeeGranter does nothing for stdtx
 func (s *StdTxBuilder) SetFeeGranter(_ sdk.AccAddress) {}
 
@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/cli"
@@ -36,6 +37,7 @@ var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+	_ module.HasKVSchema         = AppModuleBasic{}
 )
 
 // AppModuleBasic defines the basic application module used by the auth module.
@@ -89,6 +91,11 @@ func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry)
 	types.RegisterInterfaces(registry)
 }
 
+// RegisterKVSchema registers the key/value schema of the auth store.
+func (AppModuleBasic) RegisterKVSchema(registry *schema.Registry) {
+	registry.Register(types.StoreKey, types.KVSchema()...)
+}
+
 // AppModule implements an application module for the auth module.
 type AppModule struct {
 	AppModuleBasic
@@ -174,9 +181,10 @@ func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 // BeginBlock returns the begin blocker for the auth module.
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock returns the end blocker for the auth module. It returns no validator
-// updates.
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// EndBlock returns the end blocker for the auth module, which removes the expired
+// unordered txs. It returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.accountKeeper.RemoveExpiredUnorderedTxs(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -2,14 +2,37 @@ package posthandler
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // HandlerOptions are the options required for constructing a default SDK PostHandler.
-type HandlerOptions struct{}
+type HandlerOptions struct {
+	// BankKeeper is required by the GasRefundDecorator.
+	BankKeeper BankKeeper
 
-// NewPostHandler returns an empty posthandler chain.
+	// GasRefundRatio is the share of the fees paid for the unused gas which is
+	// refunded to the fee payer. The refunds are disabled when it is nil or zero.
+	GasRefundRatio sdk.Dec
+
+	// MaxRefundedGas caps the unused gas for which the fees are refunded.
+	MaxRefundedGas uint64
+}
+
+// NewPostHandler returns the posthandler chain, which refunds the fees of the
+// unused gas if HandlerOptions.GasRefundRatio is set, and is empty otherwise.
 func NewPostHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	postDecorators := []sdk.AnteDecorator{}
 
+	if !options.GasRefundRatio.IsNil() && !options.GasRefundRatio.IsZero() {
+		if options.BankKeeper == nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for the gas refunds")
+		}
+		if options.GasRefundRatio.IsNegative() || options.GasRefundRatio.GT(sdk.OneDec()) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "gas refund ratio must be between 0 and 1: %s", options.GasRefundRatio)
+		}
+
+		postDecorators = append(postDecorators, NewGasRefundDecorator(options.BankKeeper, options.GasRefundRatio, options.MaxRefundedGas))
+	}
+
 	return sdk.ChainAnteDecorators(postDecorators...), nil
 }
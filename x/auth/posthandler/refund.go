@@ -0,0 +1,97 @@
+package posthandler
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AttributeKeyRefund is the attribute of the tx event holding the fees refunded
+// for the unused gas.
+const AttributeKeyRefund = "refund"
+
+// BankKeeper defines the contract needed by the GasRefundDecorator to refund
+// the fees from the fee collector.
+type BankKeeper interface {
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// GasRefundDecorator refunds a share of the fees paid for the gas left unused by
+// a successful tx, from the fee collector to the account which paid the fees:
+// the fee granter when a fee grant was used, the fee payer otherwise. It must be
+// used with the DeductFeeDecorator in the ante handler.
+//
+// The refund is refundRatio of the fees times the unused gas over the gas limit,
+// rounded down, where at most maxRefundedGas of unused gas is counted, so that
+// the txs setting an inflated gas limit to get a higher priority, or to fill the
+// blocks, cannot get most of their fees back.
+type GasRefundDecorator struct {
+	bankKeeper     BankKeeper
+	refundRatio    sdk.Dec
+	maxRefundedGas uint64
+}
+
+// NewGasRefundDecorator returns a new GasRefundDecorator. The refund ratio must
+// be between 0 and 1.
+func NewGasRefundDecorator(bk BankKeeper, refundRatio sdk.Dec, maxRefundedGas uint64) GasRefundDecorator {
+	if refundRatio.IsNil() || refundRatio.IsNegative() || refundRatio.GT(sdk.OneDec()) {
+		panic(fmt.Sprintf("invalid gas refund ratio %s", refundRatio))
+	}
+
+	return GasRefundDecorator{
+		bankKeeper:     bk,
+		refundRatio:    refundRatio,
+		maxRefundedGas: maxRefundedGas,
+	}
+}
+
+func (grd GasRefundDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	refund := grd.refundAmount(feeTx, ctx.GasMeter().GasConsumed())
+	if !refund.IsZero() {
+		refundTo := feeTx.FeePayer()
+		if feeGranter := feeTx.FeeGranter(); feeGranter != nil {
+			refundTo = feeGranter
+		}
+
+		// the refund is priced on the gas consumed so far, so the transfer itself
+		// must not consume the gas of the tx, which could run out of gas
+		refundCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		if err := grd.bankKeeper.SendCoinsFromModuleToAccount(refundCtx, types.FeeCollectorName, refundTo, refund); err != nil {
+			return ctx, sdkerrors.Wrapf(err, "failed to refund the fees of the unused gas")
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(sdk.EventTypeTx,
+			sdk.NewAttribute(AttributeKeyRefund, refund.String()),
+		))
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// refundAmount returns the fees refunded to a tx which consumed gasUsed gas.
+func (grd GasRefundDecorator) refundAmount(feeTx sdk.FeeTx, gasUsed uint64) sdk.Coins {
+	gasLimit := feeTx.GetGas()
+	if gasLimit == 0 || gasUsed >= gasLimit {
+		return nil
+	}
+
+	unusedGas := gasLimit - gasUsed
+	if unusedGas > grd.maxRefundedGas {
+		unusedGas = grd.maxRefundedGas
+	}
+
+	share := grd.refundRatio.MulInt64(int64(unusedGas)).QuoInt64(int64(gasLimit))
+	refund := sdk.NewCoins()
+	for _, coin := range feeTx.GetFee() {
+		refund = refund.Add(sdk.NewCoin(coin.Denom, share.MulInt(coin.Amount).TruncateInt()))
+	}
+
+	return refund
+}
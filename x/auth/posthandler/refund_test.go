@@ -0,0 +1,180 @@
+package posthandler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
+	"github.com/cosmos/cosmos-sdk/x/auth/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+)
+
+func TestGasRefundDecorator(t *testing.T) {
+	var (
+		accountKeeper  keeper.AccountKeeper
+		bankKeeper     bankkeeper.Keeper
+		feeGrantKeeper feegrantkeeper.Keeper
+		txConfig       client.TxConfig
+	)
+	app, err := simtestutil.Setup(testutil.AppConfig, &accountKeeper, &bankKeeper, &feeGrantKeeper, &txConfig)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		refundRatio    sdk.Dec
+		maxRefundedGas uint64
+		fee            sdk.Coins
+		gasLimit       uint64
+		gasUsed        uint64
+		useFeeGrant    bool
+		expRefund      sdk.Coins
+	}{
+		{
+			name:           "half of the unused gas is refunded",
+			refundRatio:    sdk.NewDecWithPrec(5, 1),
+			maxRefundedGas: 1000000,
+			fee:            sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+			gasLimit:       100000,
+			gasUsed:        30000,
+			expRefund:      sdk.NewCoins(sdk.NewInt64Coin("atom", 350)),
+		},
+		{
+			name:           "the refund is made to the fee granter",
+			refundRatio:    sdk.OneDec(),
+			maxRefundedGas: 1000000,
+			fee:            sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+			gasLimit:       100000,
+			gasUsed:        30000,
+			useFeeGrant:    true,
+			expRefund:      sdk.NewCoins(sdk.NewInt64Coin("atom", 700)),
+		},
+		{
+			name:           "the refunded gas is capped",
+			refundRatio:    sdk.OneDec(),
+			maxRefundedGas: 10000,
+			fee:            sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+			gasLimit:       100000,
+			gasUsed:        30000,
+			expRefund:      sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+		},
+		{
+			name:           "the refund of each fee denom is rounded down",
+			refundRatio:    sdk.NewDecWithPrec(5, 1),
+			maxRefundedGas: 1000000,
+			fee:            sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 3)),
+			gasLimit:       100000,
+			gasUsed:        30000,
+			expRefund:      sdk.NewCoins(sdk.NewInt64Coin("atom", 350), sdk.NewInt64Coin("stake", 1)),
+		},
+		{
+			name:           "the refund does not consume the gas of the tx",
+			refundRatio:    sdk.OneDec(),
+			maxRefundedGas: 1000000,
+			fee:            sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+			gasLimit:       100000,
+			gasUsed:        99000,
+			expRefund:      sdk.NewCoins(sdk.NewInt64Coin("atom", 10)),
+		},
+		{
+			name:           "no refund when all the gas is used",
+			refundRatio:    sdk.OneDec(),
+			maxRefundedGas: 1000000,
+			fee:            sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+			gasLimit:       100000,
+			gasUsed:        100000,
+			expRefund:      sdk.NewCoins(),
+		},
+		{
+			name:           "no refund without fees",
+			refundRatio:    sdk.OneDec(),
+			maxRefundedGas: 1000000,
+			fee:            sdk.NewCoins(),
+			gasLimit:       100000,
+			gasUsed:        30000,
+			expRefund:      sdk.NewCoins(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(1)
+
+			_, _, payer := testdata.KeyTestPubAddr()
+			_, _, granter := testdata.KeyTestPubAddr()
+			balance := sdk.NewCoins(sdk.NewInt64Coin("atom", 10000), sdk.NewInt64Coin("stake", 10000))
+			for _, addr := range []sdk.AccAddress{payer, granter} {
+				accountKeeper.SetAccount(ctx, accountKeeper.NewAccountWithAddress(ctx, addr))
+				require.NoError(t, banktestutil.FundAccount(bankKeeper, ctx, addr, balance))
+			}
+
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(payer)))
+			txBuilder.SetFeeAmount(tc.fee)
+			txBuilder.SetGasLimit(tc.gasLimit)
+			refundTo := payer
+			if tc.useFeeGrant {
+				require.NoError(t, feeGrantKeeper.GrantAllowance(ctx, granter, payer, &feegrant.BasicAllowance{}))
+				txBuilder.SetFeeGranter(granter)
+				refundTo = granter
+			}
+			tx := txBuilder.GetTx()
+
+			anteHandler := sdk.ChainAnteDecorators(ante.NewDeductFeeDecorator(accountKeeper, bankKeeper, feeGrantKeeper, nil))
+			_, err := anteHandler(ctx, tx, false)
+			require.NoError(t, err)
+			require.Equal(t, balance.Sub(tc.fee...), bankKeeper.GetAllBalances(ctx, refundTo))
+
+			postHandler, err := posthandler.NewPostHandler(posthandler.HandlerOptions{
+				BankKeeper:     bankKeeper,
+				GasRefundRatio: tc.refundRatio,
+				MaxRefundedGas: tc.maxRefundedGas,
+			})
+			require.NoError(t, err)
+
+			feeCollector := accountKeeper.GetModuleAddress(types.FeeCollectorName)
+			collected := bankKeeper.GetAllBalances(ctx, feeCollector)
+
+			gasMeter := sdk.NewGasMeter(tc.gasLimit)
+			gasMeter.ConsumeGas(tc.gasUsed, "test")
+			postCtx := ctx.WithGasMeter(gasMeter).WithEventManager(sdk.NewEventManager())
+			_, err = postHandler(postCtx, tx, false)
+			require.NoError(t, err)
+			require.Equal(t, tc.gasUsed, gasMeter.GasConsumed())
+
+			require.Equal(t, balance.Sub(tc.fee...).Add(tc.expRefund...), bankKeeper.GetAllBalances(ctx, refundTo))
+			require.Equal(t, collected.Sub(tc.expRefund...), bankKeeper.GetAllBalances(ctx, feeCollector))
+			if !tc.expRefund.IsZero() {
+				events := postCtx.EventManager().Events()
+				require.Equal(t, sdk.NewEvent(sdk.EventTypeTx, sdk.NewAttribute(posthandler.AttributeKeyRefund, tc.expRefund.String())), events[len(events)-1])
+			}
+		})
+	}
+}
+
+func TestNewPostHandlerRefundOptions(t *testing.T) {
+	_, err := posthandler.NewPostHandler(posthandler.HandlerOptions{GasRefundRatio: sdk.NewDecWithPrec(5, 1)})
+	require.Error(t, err)
+
+	bk := bankkeeper.BaseKeeper{}
+	_, err = posthandler.NewPostHandler(posthandler.HandlerOptions{BankKeeper: bk, GasRefundRatio: sdk.NewDecWithPrec(15, 1)})
+	require.Error(t, err)
+	_, err = posthandler.NewPostHandler(posthandler.HandlerOptions{BankKeeper: bk, GasRefundRatio: sdk.NewDec(-1)})
+	require.Error(t, err)
+
+	_, err = posthandler.NewPostHandler(posthandler.HandlerOptions{GasRefundRatio: sdk.ZeroDec()})
+	require.NoError(t, err)
+	_, err = posthandler.NewPostHandler(posthandler.HandlerOptions{})
+	require.NoError(t, err)
+}
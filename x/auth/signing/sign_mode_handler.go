@@ -1,6 +1,8 @@
 package signing
 
 import (
+	"context"
+
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -21,6 +23,25 @@ type SignModeHandler interface {
 	GetSignBytes(mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
 }
 
+// SignModeHandlerWithContext is a SignModeHandler whose sign bytes may depend on the
+// context, e.g. on the chain state queried through it.
+type SignModeHandlerWithContext interface {
+	SignModeHandler
+
+	// GetSignBytesWithContext returns the sign bytes for the provided SignMode, SignerData
+	// and Tx in the given context, or an error
+	GetSignBytesWithContext(ctx context.Context, mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
+}
+
+// GetSignBytesWithContext returns the sign bytes of the handler in the given context if it
+// supports one, and its context-free sign bytes otherwise.
+func GetSignBytesWithContext(ctx context.Context, h SignModeHandler, mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	if hc, ok := h.(SignModeHandlerWithContext); ok {
+		return hc.GetSignBytesWithContext(ctx, mode, data, tx)
+	}
+	return h.GetSignBytes(mode, data, tx)
+}
+
 // SignerData is the specific information needed to sign a transaction that generally
 // isn't included in the transaction body itself
 type SignerData struct {
@@ -1,6 +1,7 @@
 package signing_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -77,7 +78,7 @@ func TestVerifySignature(t *testing.T) {
 	handler := MakeTestHandlerMap()
 	stdTx := legacytx.NewStdTx(msgs, fee, []legacytx.StdSignature{stdSig}, memo)
 	stdTx.TimeoutHeight = 10
-	err = signing.VerifySignature(pubKey, signerData, sigV2.Data, handler, stdTx)
+	err = signing.VerifySignature(context.Background(), pubKey, signerData, sigV2.Data, handler, stdTx)
 	require.NoError(t, err)
 
 	pkSet := []cryptotypes.PubKey{pubKey, pubKey1}
@@ -106,6 +107,6 @@ func TestVerifySignature(t *testing.T) {
 	stdTx = legacytx.NewStdTx(msgs, fee, []legacytx.StdSignature{stdSig1, stdSig2}, memo)
 	stdTx.TimeoutHeight = 10
 
-	err = signing.VerifySignature(multisigKey, signerData, multisignature, handler, stdTx)
+	err = signing.VerifySignature(context.Background(), multisigKey, signerData, multisignature, handler, stdTx)
 	require.NoError(t, err)
 }
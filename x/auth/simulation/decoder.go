@@ -41,6 +41,9 @@ func NewDecodeStore(ak AuthUnmarshaler) func(kvA, kvB kv.Pair) string {
 
 			return fmt.Sprintf("GlobalAccNumberA: %d\nGlobalAccNumberB: %d", globalAccNumberA, globalAccNumberB)
 
+		case bytes.Equal(kvA.Key[:1], types.UnorderedTxsKeyPrefix):
+			return fmt.Sprintf("%X\n%X", kvA.Key[1:], kvB.Key[1:])
+
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
@@ -37,6 +37,7 @@ var (
 	_ authsigning.Tx             = &wrapper{}
 	_ client.TxBuilder           = &wrapper{}
 	_ tx.TipTx                   = &wrapper{}
+	_ sdk.TxWithUnordered        = &wrapper{}
 	_ ante.HasExtensionOptionsTx = &wrapper{}
 	_ ExtensionOptionsTxBuilder  = &wrapper{}
 	_ tx.TipTx                   = &wrapper{}
@@ -167,6 +168,10 @@ func (w *wrapper) GetTimeoutHeight() uint64 {
 	return w.tx.Body.TimeoutHeight
 }
 
+func (w *wrapper) GetUnordered() bool {
+	return w.tx.Body.Unordered
+}
+
 func (w *wrapper) GetSignaturesV2() ([]signing.SignatureV2, error) {
 	signerInfos := w.tx.AuthInfo.SignerInfos
 	sigs := w.tx.Signatures
@@ -223,6 +228,14 @@ func (w *wrapper) SetTimeoutHeight(height uint64) {
 	w.bodyBz = nil
 }
 
+// SetUnordered sets whether the transaction is unordered.
+func (w *wrapper) SetUnordered(unordered bool) {
+	w.tx.Body.Unordered = unordered
+
+	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
+	w.bodyBz = nil
+}
+
 func (w *wrapper) SetMemo(memo string) {
 	w.tx.Body.Memo = memo
 
@@ -434,6 +447,7 @@ func (w *wrapper) AddAuxSignerData(data tx.AuxSignerData) error {
 
 	w.SetMemo(body.Memo)
 	w.SetTimeoutHeight(body.TimeoutHeight)
+	w.SetUnordered(body.Unordered)
 	w.SetExtensionOptions(body.ExtensionOptions...)
 	w.SetNonCriticalExtensionOptions(body.NonCriticalExtensionOptions...)
 	msgs := make([]sdk.Msg, len(body.Messages))
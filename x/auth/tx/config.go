@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
 )
 
 type config struct {
@@ -25,8 +26,18 @@ type config struct {
 // first enabled sign mode will become the default sign mode.
 // NOTE: Use NewTxConfigWithHandler to provide a custom signing handler in case the sign mode
 // is not supported by default (eg: SignMode_SIGN_MODE_EIP_191).
+//
+// The coins of the transactions signed with SIGN_MODE_TEXTUAL are rendered in their base
+// denom, use NewTxConfigWithTextual to render them in their display denom.
 func NewTxConfig(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode) client.TxConfig {
-	return NewTxConfigWithHandler(protoCodec, makeSignModeHandler(enabledSignModes))
+	return NewTxConfigWithTextual(protoCodec, enabledSignModes, nil)
+}
+
+// NewTxConfigWithTextual returns a new protobuf TxConfig using the provided ProtoCodec and
+// sign modes, SIGN_MODE_TEXTUAL rendering the coins in the display denom of the metadata
+// queried with coinMetadataQueryFn.
+func NewTxConfigWithTextual(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode, coinMetadataQueryFn textual.CoinMetadataQueryFn) client.TxConfig {
+	return NewTxConfigWithHandler(protoCodec, makeSignModeHandler(enabledSignModes, textual.NewTextual(coinMetadataQueryFn)))
 }
 
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and signing handler.
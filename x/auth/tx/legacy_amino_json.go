@@ -46,6 +46,12 @@ func (s signModeLegacyAminoJSONHandler) GetSignBytes(mode signingtypes.SignMode,
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s does not support protobuf extension options", signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
 	}
 
+	// the unordered flag is not part of the StdSignDoc, so it could be set on a
+	// signed ordered tx to replay it
+	if body.Unordered {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s does not support unordered transactions", signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	}
+
 	addr := data.Address
 	if addr == "" {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "got empty address in %s handler", signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
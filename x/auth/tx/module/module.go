@@ -14,7 +14,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 )
 
@@ -33,6 +35,15 @@ type txInputs struct {
 	AccountKeeper  ante.AccountKeeper    `optional:"true"`
 	BankKeeper     authtypes.BankKeeper  `optional:"true"`
 	FeeGrantKeeper feegrantkeeper.Keeper `optional:"true"`
+
+	// TxFeeChecker overrides the default fee check of the ante handler, it is
+	// provided by the modules pricing the gas, e.g. x/feemarket.
+	TxFeeChecker ante.TxFeeChecker `optional:"true"`
+}
+
+// metadataKeeper is implemented by the bank keepers holding the coin metadata.
+type metadataKeeper interface {
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
 }
 
 type txOutputs struct {
@@ -43,7 +54,13 @@ type txOutputs struct {
 }
 
 func provideModule(in txInputs) txOutputs {
-	txConfig := tx.NewTxConfig(in.ProtoCodecMarshaler, tx.DefaultSignModes)
+	// SIGN_MODE_TEXTUAL renders the coins in their display denom when the bank keeper holds
+	// their metadata
+	var coinMetadataQueryFn textual.CoinMetadataQueryFn
+	if k, ok := in.BankKeeper.(metadataKeeper); ok {
+		coinMetadataQueryFn = textual.NewKeeperCoinMetadataQueryFn(k)
+	}
+	txConfig := tx.NewTxConfigWithTextual(in.ProtoCodecMarshaler, tx.DefaultSignModes, coinMetadataQueryFn)
 
 	baseAppOption := func(app *baseapp.BaseApp) {
 		// AnteHandlers
@@ -79,8 +96,9 @@ func provideModule(in txInputs) txOutputs {
 			app.SetPostHandler(postHandler)
 		}
 
-		// TxDecoder
+		// TxDecoder and TxEncoder
 		app.SetTxDecoder(txConfig.TxDecoder())
+		app.SetTxEncoder(txConfig.TxEncoder())
 	}
 
 	return txOutputs{TxConfig: txConfig, BaseAppOption: baseAppOption}
@@ -91,13 +109,18 @@ func newAnteHandler(txConfig client.TxConfig, in txInputs) (sdk.AnteHandler, err
 		return nil, fmt.Errorf("both AccountKeeper and BankKeeper are required")
 	}
 
+	// the unordered transactions are supported if the account keeper records them
+	unorderedTxKeeper, _ := in.AccountKeeper.(ante.UnorderedTxKeeper)
+
 	anteHandler, err := ante.NewAnteHandler(
 		ante.HandlerOptions{
-			AccountKeeper:   in.AccountKeeper,
-			BankKeeper:      in.BankKeeper,
-			SignModeHandler: txConfig.SignModeHandler(),
-			FeegrantKeeper:  in.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			AccountKeeper:     in.AccountKeeper,
+			BankKeeper:        in.BankKeeper,
+			SignModeHandler:   txConfig.SignModeHandler(),
+			FeegrantKeeper:    in.FeeGrantKeeper,
+			SigGasConsumer:    ante.DefaultSigVerificationGasConsumer,
+			UnorderedTxKeeper: unorderedTxKeeper,
+			TxFeeChecker:      in.TxFeeChecker,
 		},
 	)
 	if err != nil {
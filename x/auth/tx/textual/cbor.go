@@ -0,0 +1,84 @@
+package textual
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// CBOR major types, see RFC 8949.
+const (
+	cborUint  = 0
+	cborText  = 3
+	cborArray = 4
+	cborMap   = 5
+	cborTrue  = 0xf5
+)
+
+// Keys of the screen fields in their CBOR encoding.
+const (
+	screenTextKey   = 1
+	screenIndentKey = 2
+	screenExpertKey = 3
+)
+
+// EncodeScreens returns the CBOR encoding of the screens, which is the payload signed with
+// SIGN_MODE_TEXTUAL: an array of maps, one per screen, holding the text (key 1), the indent
+// (key 2) and the expert flag (key 3) of the screen. The fields with their default value are
+// omitted, and the encoding is deterministic.
+func EncodeScreens(screens []Screen) []byte {
+	var buf bytes.Buffer
+	writeCBORHead(&buf, cborArray, uint64(len(screens)))
+	for _, screen := range screens {
+		var fields uint64
+		if screen.Text != "" {
+			fields++
+		}
+		if screen.Indent != 0 {
+			fields++
+		}
+		if screen.Expert {
+			fields++
+		}
+		writeCBORHead(&buf, cborMap, fields)
+
+		if screen.Text != "" {
+			writeCBORHead(&buf, cborUint, screenTextKey)
+			writeCBORHead(&buf, cborText, uint64(len(screen.Text)))
+			buf.WriteString(screen.Text)
+		}
+		if screen.Indent != 0 {
+			writeCBORHead(&buf, cborUint, screenIndentKey)
+			writeCBORHead(&buf, cborUint, uint64(screen.Indent))
+		}
+		if screen.Expert {
+			writeCBORHead(&buf, cborUint, screenExpertKey)
+			buf.WriteByte(cborTrue)
+		}
+	}
+	return buf.Bytes()
+}
+
+// writeCBORHead writes the head of a data item of the major type, with its argument encoded
+// in the shortest form.
+func writeCBORHead(buf *bytes.Buffer, major byte, arg uint64) {
+	major <<= 5
+	var bz [8]byte
+	switch {
+	case arg < 24:
+		buf.WriteByte(major | byte(arg))
+	case arg <= 0xff:
+		buf.Write([]byte{major | 24, byte(arg)})
+	case arg <= 0xffff:
+		buf.WriteByte(major | 25)
+		binary.BigEndian.PutUint16(bz[:], uint16(arg))
+		buf.Write(bz[:2])
+	case arg <= 0xffffffff:
+		buf.WriteByte(major | 26)
+		binary.BigEndian.PutUint32(bz[:], uint32(arg))
+		buf.Write(bz[:4])
+	default:
+		buf.WriteByte(major | 27)
+		binary.BigEndian.PutUint64(bz[:], arg)
+		buf.Write(bz[:])
+	}
+}
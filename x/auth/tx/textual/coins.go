@@ -0,0 +1,80 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// FormatCoins renders the coins on a single line, each coin in the display denom of its
+// metadata, e.g. 1.5 atom, 20 stake.
+func (t Textual) FormatCoins(ctx context.Context, coins []sdk.Coin) (string, error) {
+	decCoins := make([]sdk.DecCoin, len(coins))
+	for i, coin := range coins {
+		if coin.Amount.IsNil() {
+			return "", fmt.Errorf("nil amount of coin %s", coin.Denom)
+		}
+		decCoins[i] = sdk.DecCoin{Denom: coin.Denom, Amount: sdk.NewDecFromInt(coin.Amount)}
+	}
+	return t.FormatDecCoins(ctx, decCoins)
+}
+
+// FormatDecCoins renders the decimal coins on a single line, each coin in the display denom
+// of its metadata.
+func (t Textual) FormatDecCoins(ctx context.Context, coins []sdk.DecCoin) (string, error) {
+	if len(coins) == 0 {
+		return "zero", nil
+	}
+
+	formatted := make([]string, len(coins))
+	for i, coin := range coins {
+		if coin.Amount.IsNil() {
+			return "", fmt.Errorf("nil amount of coin %s", coin.Denom)
+		}
+		s, err := t.formatCoin(ctx, coin.Denom, coin.Amount.String())
+		if err != nil {
+			return "", err
+		}
+		formatted[i] = s
+	}
+	return strings.Join(formatted, ", "), nil
+}
+
+// formatCoin renders the decimal amount of the base denom in the display denom of the
+// metadata of the denom, or in the base denom if it has no metadata.
+func (t Textual) formatCoin(ctx context.Context, denom, amount string) (string, error) {
+	var metadata *banktypes.Metadata
+	if t.coinMetadataQuerier != nil {
+		var err error
+		metadata, err = t.coinMetadataQuerier(ctx, denom)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	displayDenom, shift := denom, 0
+	if metadata != nil && metadata.Display != "" {
+		var baseExponent, displayExponent uint32
+		var found bool
+		for _, unit := range metadata.DenomUnits {
+			switch unit.Denom {
+			case denom:
+				baseExponent = unit.Exponent
+			case metadata.Display:
+				displayExponent, found = unit.Exponent, true
+			}
+		}
+		if found && displayExponent >= baseExponent {
+			displayDenom, shift = metadata.Display, int(displayExponent-baseExponent)
+		}
+	}
+
+	formatted, err := formatDecimal(shiftDecimal(amount, shift))
+	if err != nil {
+		return "", err
+	}
+	return formatted + " " + displayDenom, nil
+}
@@ -0,0 +1,280 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Full names of the messages with a dedicated rendering.
+const (
+	anyName       protoreflect.FullName = "google.protobuf.Any"
+	timestampName protoreflect.FullName = "google.protobuf.Timestamp"
+	durationName  protoreflect.FullName = "google.protobuf.Duration"
+	coinName      protoreflect.FullName = "cosmos.base.v1beta1.Coin"
+	decCoinName   protoreflect.FullName = "cosmos.base.v1beta1.DecCoin"
+)
+
+// Scalars of the string fields with a dedicated rendering.
+const (
+	scalarInt = "cosmos.Int"
+	scalarDec = "cosmos.Dec"
+)
+
+// formatMessage renders a message. The messages with a dedicated rendering are rendered on
+// a single screen, the other ones as a "<Name> object" screen followed by their fields.
+func (t Textual) formatMessage(ctx context.Context, msg protoreflect.Message) ([]Screen, error) {
+	switch msg.Descriptor().FullName() {
+	case timestampName:
+		seconds, nanos := secondsAndNanos(msg)
+		return []Screen{{Text: formatTimestamp(seconds, nanos)}}, nil
+
+	case durationName:
+		seconds, nanos := secondsAndNanos(msg)
+		return []Screen{{Text: formatDuration(seconds, nanos)}}, nil
+
+	case coinName, decCoinName:
+		text, err := t.formatCoinMessages(ctx, []protoreflect.Message{msg})
+		if err != nil {
+			return nil, err
+		}
+		return []Screen{{Text: text}}, nil
+
+	case anyName:
+		return t.formatAny(ctx, msg)
+	}
+
+	fields, err := t.formatFields(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	header := Screen{Text: fmt.Sprintf("%s object", msg.Descriptor().Name())}
+	return append([]Screen{header}, fields...), nil
+}
+
+// formatAny renders the type URL of the Any followed by its unpacked message.
+func (t Textual) formatAny(ctx context.Context, anyMsg protoreflect.Message) ([]Screen, error) {
+	fields := anyMsg.Descriptor().Fields()
+	typeURL := anyMsg.Get(fields.ByName("type_url")).String()
+	value := anyMsg.Get(fields.ByName("value")).Bytes()
+
+	msg, err := t.resolver.unmarshal(typeURL, value)
+	if err != nil {
+		return nil, err
+	}
+	return t.formatTypedMessage(ctx, typeURL, msg)
+}
+
+// formatTypedMessage renders the type URL of the message followed by its fields, or by the
+// message itself if it has a dedicated rendering.
+func (t Textual) formatTypedMessage(ctx context.Context, typeURL string, msg protoreflect.Message) ([]Screen, error) {
+	screens, err := t.formatMessage(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if hasDedicatedRendering(msg.Descriptor().FullName()) {
+		return append([]Screen{{Text: typeURL}}, indent(screens, 1)...), nil
+	}
+	// replace the "<Name> object" header
+	return append([]Screen{{Text: typeURL}}, screens[1:]...), nil
+}
+
+// formatFields renders the populated fields of the message in the order of their numbers,
+// indented by one level.
+func (t Textual) formatFields(ctx context.Context, msg protoreflect.Message) ([]Screen, error) {
+	fds := msg.Descriptor().Fields()
+	ordered := make([]protoreflect.FieldDescriptor, fds.Len())
+	for i := 0; i < fds.Len(); i++ {
+		ordered[i] = fds.Get(i)
+	}
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i].Number() < ordered[j].Number() })
+
+	var screens []Screen
+	for _, fd := range ordered {
+		if !msg.Has(fd) {
+			continue
+		}
+		fieldScreens, err := t.formatField(ctx, fd, msg.Get(fd))
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, fieldScreens...)
+	}
+	return indent(screens, 1), nil
+}
+
+// formatField renders a field as screens titled with its name.
+func (t Textual) formatField(ctx context.Context, fd protoreflect.FieldDescriptor, v protoreflect.Value) ([]Screen, error) {
+	title := fieldTitle(string(fd.Name()))
+
+	switch {
+	case fd.IsList():
+		list := v.List()
+		if fd.Message() != nil && isCoin(fd.Message().FullName()) {
+			msgs := make([]protoreflect.Message, list.Len())
+			for i := range msgs {
+				msgs[i] = list.Get(i).Message()
+			}
+			text, err := t.formatCoinMessages(ctx, msgs)
+			if err != nil {
+				return nil, err
+			}
+			return []Screen{{Text: fmt.Sprintf("%s: %s", title, text)}}, nil
+		}
+
+		var screens []Screen
+		for i := 0; i < list.Len(); i++ {
+			elem, err := t.formatScalar(ctx, fd, list.Get(i))
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, titled(fmt.Sprintf("%s (%d/%d)", title, i+1, list.Len()), elem)...)
+		}
+		return append(screens, Screen{Text: fmt.Sprintf("End of %s", title)}), nil
+
+	case fd.IsMap():
+		m := v.Map()
+		keys := make([]protoreflect.MapKey, 0, m.Len())
+		m.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+			keys = append(keys, k)
+			return true
+		})
+		sort.Slice(keys, func(i, j int) bool { return lessMapKey(keys[i], keys[j]) })
+
+		var screens []Screen
+		for _, k := range keys {
+			elem, err := t.formatScalar(ctx, fd.MapValue(), m.Get(k))
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, titled(fmt.Sprintf("%s (%s)", title, k.String()), elem)...)
+		}
+		return append(screens, Screen{Text: fmt.Sprintf("End of %s", title)}), nil
+
+	default:
+		value, err := t.formatScalar(ctx, fd, v)
+		if err != nil {
+			return nil, err
+		}
+		return titled(title, value), nil
+	}
+}
+
+// formatScalar renders a single value of the field, taking into account the cosmos scalar
+// of the string fields.
+func (t Textual) formatScalar(ctx context.Context, fd protoreflect.FieldDescriptor, v protoreflect.Value) ([]Screen, error) {
+	if fd.Kind() != protoreflect.StringKind {
+		return t.formatValue(ctx, fd, v)
+	}
+
+	var text string
+	var err error
+	switch fieldScalar(fd) {
+	case scalarInt:
+		if !isDigits(strings.TrimPrefix(v.String(), "-")) {
+			return nil, fmt.Errorf("invalid integer %q in field %s", v.String(), fd.FullName())
+		}
+		text = formatInteger(v.String())
+	case scalarDec:
+		text, err = formatLegacyDec(v.String())
+	default:
+		text = v.String()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []Screen{{Text: text}}, nil
+}
+
+// formatCoinMessages renders Coin or DecCoin messages on a single line.
+func (t Textual) formatCoinMessages(ctx context.Context, msgs []protoreflect.Message) (string, error) {
+	coins := make([]sdk.DecCoin, len(msgs))
+	for i, msg := range msgs {
+		fields := msg.Descriptor().Fields()
+		denom := msg.Get(fields.ByName("denom")).String()
+		amount := msg.Get(fields.ByName("amount")).String()
+
+		var dec sdk.Dec
+		var err error
+		if msg.Descriptor().FullName() == decCoinName {
+			// the amount of a DecCoin is a cosmos.Dec
+			dec, err = sdk.NewDecFromStr(shiftDecimal(amount, sdk.Precision))
+		} else {
+			dec, err = sdk.NewDecFromStr(amount)
+		}
+		if err != nil {
+			return "", fmt.Errorf("invalid amount %q of coin %s: %w", amount, denom, err)
+		}
+		coins[i] = sdk.DecCoin{Denom: denom, Amount: dec}
+	}
+	return t.FormatDecCoins(ctx, coins)
+}
+
+// titled prefixes the first screen of a value with the title, and indents the following
+// screens under it.
+func titled(title string, value []Screen) []Screen {
+	if len(value) == 0 {
+		return []Screen{{Text: title + ":"}}
+	}
+	screens := make([]Screen, len(value))
+	copy(screens, value)
+	screens[0].Text = fmt.Sprintf("%s: %s", title, screens[0].Text)
+	return screens
+}
+
+// indent returns the screens indented by the number of levels.
+func indent(screens []Screen, levels int) []Screen {
+	indented := make([]Screen, len(screens))
+	for i, screen := range screens {
+		screen.Indent += levels
+		indented[i] = screen
+	}
+	return indented
+}
+
+func secondsAndNanos(msg protoreflect.Message) (int64, int32) {
+	fields := msg.Descriptor().Fields()
+	return msg.Get(fields.ByName("seconds")).Int(), int32(msg.Get(fields.ByName("nanos")).Int())
+}
+
+func isCoin(name protoreflect.FullName) bool {
+	return name == coinName || name == decCoinName
+}
+
+func hasDedicatedRendering(name protoreflect.FullName) bool {
+	switch name {
+	case anyName, timestampName, durationName, coinName, decCoinName:
+		return true
+	}
+	return false
+}
+
+// fieldScalar returns the cosmos scalar of the field, if any.
+func fieldScalar(fd protoreflect.FieldDescriptor) string {
+	opts := fd.Options()
+	if opts == nil || !proto.HasExtension(opts, cosmos_proto.E_Scalar) {
+		return ""
+	}
+	scalar, _ := proto.GetExtension(opts, cosmos_proto.E_Scalar).(string)
+	return scalar
+}
+
+func lessMapKey(a, b protoreflect.MapKey) bool {
+	switch a.Interface().(type) {
+	case string:
+		return a.String() < b.String()
+	case bool:
+		return !a.Bool() && b.Bool()
+	case int32, int64:
+		return a.Int() < b.Int()
+	default:
+		return a.Uint() < b.Uint()
+	}
+}
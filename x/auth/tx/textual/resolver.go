@@ -0,0 +1,187 @@
+package textual
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// typeResolver resolves the message types by their names, from the protobuf registry of
+// google.golang.org/protobuf or, for the types registered with gogoproto only, by building
+// their descriptors from the file descriptors registered with gogoproto.
+type typeResolver struct {
+	mtx   sync.Mutex
+	files *protoregistry.Files
+	types map[protoreflect.FullName]protoreflect.MessageType
+}
+
+func newTypeResolver() *typeResolver {
+	return &typeResolver{
+		files: new(protoregistry.Files),
+		types: make(map[protoreflect.FullName]protoreflect.MessageType),
+	}
+}
+
+// unmarshal decodes the message of the type URL.
+func (r *typeResolver) unmarshal(typeURL string, bz []byte) (protoreflect.Message, error) {
+	mt, err := r.findMessageByURL(typeURL)
+	if err != nil {
+		return nil, err
+	}
+	msg := mt.New()
+	if err := proto.Unmarshal(bz, msg.Interface()); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s: %w", typeURL, err)
+	}
+	return msg, nil
+}
+
+func (r *typeResolver) findMessageByURL(typeURL string) (protoreflect.MessageType, error) {
+	name := typeURL
+	if i := strings.LastIndexByte(typeURL, '/'); i >= 0 {
+		name = typeURL[i+1:]
+	}
+	return r.findMessageByName(protoreflect.FullName(name))
+}
+
+func (r *typeResolver) findMessageByName(name protoreflect.FullName) (protoreflect.MessageType, error) {
+	if mt, err := protoregistry.GlobalTypes.FindMessageByName(name); err == nil {
+		return mt, nil
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if mt, ok := r.types[name]; ok {
+		return mt, nil
+	}
+	md, err := r.findMessageDescriptor(name)
+	if err != nil {
+		return nil, err
+	}
+	mt := dynamicpb.NewMessageType(md)
+	r.types[name] = mt
+	return mt, nil
+}
+
+// findMessageDescriptor returns the descriptor of the message, building its file from the
+// gogoproto registry if needed.
+func (r *typeResolver) findMessageDescriptor(name protoreflect.FullName) (protoreflect.MessageDescriptor, error) {
+	if md, ok := findMessageDescriptor(protoregistry.GlobalFiles, name); ok {
+		return md, nil
+	}
+	if md, ok := findMessageDescriptor(r.files, name); ok {
+		return md, nil
+	}
+
+	typ := gogoproto.MessageType(string(name))
+	if typ == nil {
+		return nil, fmt.Errorf("unknown message type %s", name)
+	}
+	msg, ok := reflect.New(typ.Elem()).Interface().(interface{ Descriptor() ([]byte, []int) })
+	if !ok {
+		return nil, fmt.Errorf("no file descriptor for message type %s", name)
+	}
+	gz, _ := msg.Descriptor()
+	fdp, err := decompressFileDescriptor(gz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file descriptor of message type %s: %w", name, err)
+	}
+	if _, err := r.buildFile(fdp); err != nil {
+		return nil, err
+	}
+	if md, ok := findMessageDescriptor(r.files, name); ok {
+		return md, nil
+	}
+	return nil, fmt.Errorf("message type %s not found in %s", name, fdp.GetName())
+}
+
+// buildFile builds the file descriptor and the ones of its dependencies which are not in the
+// protobuf registry, and registers them in the resolver files.
+func (r *typeResolver) buildFile(fdp *descriptorpb.FileDescriptorProto) (protoreflect.FileDescriptor, error) {
+	if fd, err := r.files.FindFileByPath(fdp.GetName()); err == nil {
+		return fd, nil
+	}
+
+	for _, dep := range fdp.GetDependency() {
+		if _, err := protoregistry.GlobalFiles.FindFileByPath(dep); err == nil {
+			continue
+		}
+		gz := gogoproto.FileDescriptor(dep)
+		if gz == nil {
+			// resolved as a placeholder
+			continue
+		}
+		depProto, err := decompressFileDescriptor(gz)
+		if err != nil {
+			return nil, fmt.Errorf("invalid file descriptor %s: %w", dep, err)
+		}
+		if _, err := r.buildFile(depProto); err != nil {
+			return nil, err
+		}
+	}
+
+	fd, err := protodesc.FileOptions{AllowUnresolvable: true}.New(fdp, filesResolver{r.files})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build file descriptor %s: %w", fdp.GetName(), err)
+	}
+	if err := r.files.RegisterFile(fd); err != nil {
+		return nil, err
+	}
+	return fd, nil
+}
+
+// filesResolver resolves the descriptors from the built files, then from the protobuf
+// registry.
+type filesResolver struct {
+	files *protoregistry.Files
+}
+
+func (f filesResolver) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	if fd, err := f.files.FindFileByPath(path); err == nil {
+		return fd, nil
+	}
+	return protoregistry.GlobalFiles.FindFileByPath(path)
+}
+
+func (f filesResolver) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	if d, err := f.files.FindDescriptorByName(name); err == nil {
+		return d, nil
+	}
+	return protoregistry.GlobalFiles.FindDescriptorByName(name)
+}
+
+func findMessageDescriptor(files *protoregistry.Files, name protoreflect.FullName) (protoreflect.MessageDescriptor, bool) {
+	d, err := files.FindDescriptorByName(name)
+	if err != nil {
+		return nil, false
+	}
+	md, ok := d.(protoreflect.MessageDescriptor)
+	return md, ok
+}
+
+func decompressFileDescriptor(gz []byte) (*descriptorpb.FileDescriptorProto, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		return nil, err
+	}
+	bz, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, err
+	}
+	fdp := new(descriptorpb.FileDescriptorProto)
+	if err := proto.Unmarshal(bz, fdp); err != nil {
+		return nil, err
+	}
+	return fdp, nil
+}
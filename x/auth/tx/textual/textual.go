@@ -0,0 +1,88 @@
+// Package textual renders transactions as human-readable screens for SIGN_MODE_TEXTUAL,
+// following ADR-050. The values are rendered from their protobuf reflection: the integers
+// with thousands separators, the coins in the display denom of their bank metadata, the
+// timestamps in RFC 3339 and the messages field by field.
+package textual
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Screen is a line of text displayed to the signer. Indent is the nesting level of the value
+// it renders, and Expert screens may be hidden by wallets unless in expert mode.
+type Screen struct {
+	Text   string
+	Indent int
+	Expert bool
+}
+
+// CoinMetadataQueryFn returns the bank metadata of a base denom, or nil if the denom has no
+// metadata, in which case the coins of the denom are rendered in the base denom.
+//
+// The metadata must be the same for the signer and the verifier of a transaction, i.e. be
+// queried from the chain state.
+type CoinMetadataQueryFn func(ctx context.Context, denom string) (*banktypes.Metadata, error)
+
+// Textual renders values and transactions as screens.
+type Textual struct {
+	coinMetadataQuerier CoinMetadataQueryFn
+	resolver            *typeResolver
+}
+
+// NewTextual returns a Textual querying the coin metadata with the given function, which may
+// be nil to render the coins in their base denom.
+func NewTextual(coinMetadataQuerier CoinMetadataQueryFn) Textual {
+	return Textual{
+		coinMetadataQuerier: coinMetadataQuerier,
+		resolver:            newTypeResolver(),
+	}
+}
+
+// FormatMessage renders a message as screens, its fields being indented by one level.
+func (t Textual) FormatMessage(ctx context.Context, msg protoreflect.Message) ([]Screen, error) {
+	return t.formatMessage(ctx, msg)
+}
+
+// formatValue renders a singular value of the field.
+func (t Textual) formatValue(ctx context.Context, fd protoreflect.FieldDescriptor, v protoreflect.Value) ([]Screen, error) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return t.formatMessage(ctx, v.Message())
+
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return []Screen{{Text: string(ev.Name())}}, nil
+		}
+		return []Screen{{Text: formatInteger(fmt.Sprint(int32(v.Enum())))}}, nil
+
+	case protoreflect.BoolKind:
+		if v.Bool() {
+			return []Screen{{Text: "True"}}, nil
+		}
+		return []Screen{{Text: "False"}}, nil
+
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return []Screen{{Text: formatInteger(fmt.Sprint(v.Int()))}}, nil
+
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return []Screen{{Text: formatInteger(fmt.Sprint(v.Uint()))}}, nil
+
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return []Screen{{Text: fmt.Sprint(v.Float())}}, nil
+
+	case protoreflect.StringKind:
+		return []Screen{{Text: v.String()}}, nil
+
+	case protoreflect.BytesKind:
+		return []Screen{{Text: formatBytes(v.Bytes())}}, nil
+
+	default:
+		return nil, fmt.Errorf("cannot render field %s of kind %s", fd.FullName(), fd.Kind())
+	}
+}
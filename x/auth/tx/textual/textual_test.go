@@ -0,0 +1,197 @@
+package textual_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var atomMetadata = banktypes.Metadata{
+	Base:    "uatom",
+	Display: "atom",
+	DenomUnits: []*banktypes.DenomUnit{
+		{Denom: "uatom", Exponent: 0},
+		{Denom: "matom", Exponent: 3},
+		{Denom: "atom", Exponent: 6},
+	},
+}
+
+func queryAtomMetadata(_ context.Context, denom string) (*banktypes.Metadata, error) {
+	if denom == atomMetadata.Base {
+		return &atomMetadata, nil
+	}
+	return nil, nil
+}
+
+func TestFormatCoins(t *testing.T) {
+	txt := textual.NewTextual(queryAtomMetadata)
+	ctx := context.Background()
+
+	testCases := []struct {
+		coins    sdk.Coins
+		expected string
+	}{
+		{nil, "zero"},
+		{sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)), "0.000001 atom"},
+		{sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)), "1.5 atom"},
+		{sdk.NewCoins(sdk.NewInt64Coin("uatom", 1234000000000)), "1'234'000 atom"},
+		{sdk.NewCoins(sdk.NewInt64Coin("uatom", 20), sdk.NewInt64Coin("stake", 1000)), "1'000 stake, 0.00002 atom"},
+	}
+	for _, tc := range testCases {
+		text, err := txt.FormatCoins(ctx, tc.coins)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, text)
+	}
+
+	// the base denom is rendered without metadata
+	text, err := textual.NewTextual(nil).FormatCoins(ctx, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)))
+	require.NoError(t, err)
+	require.Equal(t, "1'500'000 uatom", text)
+
+	text, err = txt.FormatDecCoins(ctx, sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("2.5"))))
+	require.NoError(t, err)
+	require.Equal(t, "0.0000025 atom", text)
+}
+
+func TestFormatMessage(t *testing.T) {
+	txt := textual.NewTextual(nil)
+	ctx := context.Background()
+
+	screens, err := txt.FormatMessage(ctx, timestamppb.New(time.Date(2022, 6, 1, 12, 30, 0, 500, time.UTC)).ProtoReflect())
+	require.NoError(t, err)
+	require.Equal(t, []textual.Screen{{Text: "2022-06-01T12:30:00.0000005Z"}}, screens)
+
+	durations := map[time.Duration]string{
+		0:                        "0 seconds",
+		time.Second:              "1 second",
+		-1500 * time.Millisecond: "-1.5 seconds",
+		26*time.Hour + 3*time.Minute + time.Second: "1 day, 2 hours, 3 minutes, 1 second",
+		48 * time.Hour: "2 days",
+	}
+	for d, expected := range durations {
+		screens, err := txt.FormatMessage(ctx, durationpb.New(d).ProtoReflect())
+		require.NoError(t, err)
+		require.Equal(t, []textual.Screen{{Text: expected}}, screens)
+	}
+}
+
+func TestRenderTx(t *testing.T) {
+	txt := textual.NewTextual(queryAtomMetadata)
+	pubKey := secp256k1.GenPrivKey().PubKey()
+
+	msgSend, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{
+		FromAddress: "cosmos1from",
+		ToAddress:   "cosmos1to",
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)),
+	})
+	require.NoError(t, err)
+	msgMultiSend, err := codectypes.NewAnyWithValue(&banktypes.MsgMultiSend{
+		Inputs: []banktypes.Input{{Address: "cosmos1from", Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))}},
+	})
+	require.NoError(t, err)
+
+	body := tx.TxBody{Messages: []*codectypes.Any{msgSend, msgMultiSend}, Memo: "hello", TimeoutHeight: 1000}
+	bodyBz, err := body.Marshal()
+	require.NoError(t, err)
+	authInfo := tx.AuthInfo{Fee: &tx.Fee{Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000)), GasLimit: 200000}}
+	authInfoBz, err := authInfo.Marshal()
+	require.NoError(t, err)
+
+	data := signing.SignerData{
+		Address:       "cosmos1from",
+		ChainID:       "test-chain",
+		AccountNumber: 12,
+		Sequence:      3,
+		PubKey:        pubKey,
+	}
+	screens, err := txt.RenderTx(context.Background(), data, bodyBz, authInfoBz)
+	require.NoError(t, err)
+
+	expected := []textual.Screen{
+		{Text: "Chain id: test-chain"},
+		{Text: "Account number: 12"},
+		{Text: "Sequence: 3"},
+		{Text: "Address: cosmos1from"},
+		{Text: "Public key: /cosmos.crypto.secp256k1.PubKey", Expert: true},
+		{Text: "This transaction has 2 Messages"},
+		{Text: "Message (1/2): /cosmos.bank.v1beta1.MsgSend"},
+		{Text: "From address: cosmos1from", Indent: 1},
+		{Text: "To address: cosmos1to", Indent: 1},
+		{Text: "Amount: 1.5 atom", Indent: 1},
+		{Text: "Message (2/2): /cosmos.bank.v1beta1.MsgMultiSend"},
+		{Text: "Inputs (1/1): Input object", Indent: 1},
+		{Text: "Address: cosmos1from", Indent: 2},
+		{Text: "Coins: 1 stake", Indent: 2},
+		{Text: "End of Inputs", Indent: 1},
+		{Text: "End of Messages"},
+		{Text: "Memo: hello"},
+		{Text: "Fees: 0.002 atom"},
+		{Text: "Gas limit: 200'000", Expert: true},
+		{Text: "Timeout height: 1'000", Expert: true},
+	}
+	require.Equal(t, expected, screens[:len(screens)-1])
+	require.True(t, screens[len(screens)-1].Expert)
+	require.Contains(t, screens[len(screens)-1].Text, "Hash of raw bytes: ")
+
+	signBytes, err := txt.GetSignBytes(context.Background(), data, bodyBz, authInfoBz)
+	require.NoError(t, err)
+	require.Equal(t, textual.EncodeScreens(screens), signBytes)
+
+	// the sign bytes depend on the coin metadata
+	otherSignBytes, err := textual.NewTextual(nil).GetSignBytes(context.Background(), data, bodyBz, authInfoBz)
+	require.NoError(t, err)
+	require.NotEqual(t, signBytes, otherSignBytes)
+}
+
+func TestEncodeScreens(t *testing.T) {
+	bz := textual.EncodeScreens([]textual.Screen{
+		{Text: "a"},
+		{Text: "b", Indent: 2, Expert: true},
+		{},
+	})
+	require.Equal(t, []byte{
+		0x83,                  // array(3)
+		0xa1, 0x01, 0x61, 'a', // {1: "a"}
+		0xa3, 0x01, 0x61, 'b', 0x02, 0x02, 0x03, 0xf5, // {1: "b", 2: 2, 3: true}
+		0xa0, // {}
+	}, bz)
+}
+
+func TestFormatGogoMessage(t *testing.T) {
+	txt := textual.NewTextual(nil)
+	rate := sdk.MustNewDecFromStr("0.05")
+	msg, err := codectypes.NewAnyWithValue(&stakingtypes.MsgEditValidator{
+		Description:      stakingtypes.Description{Moniker: "val"},
+		ValidatorAddress: "cosmosvaloper1val",
+		CommissionRate:   &rate,
+	})
+	require.NoError(t, err)
+
+	body := tx.TxBody{Messages: []*codectypes.Any{msg}}
+	bodyBz, err := body.Marshal()
+	require.NoError(t, err)
+	screens, err := txt.RenderTx(context.Background(), signing.SignerData{ChainID: "test-chain"}, bodyBz, nil)
+	require.NoError(t, err)
+	require.Equal(t, []textual.Screen{
+		{Text: "This transaction has 1 Message"},
+		{Text: "Message (1/1): /cosmos.staking.v1beta1.MsgEditValidator"},
+		{Text: "Description: Description object", Indent: 1},
+		{Text: "Moniker: val", Indent: 2},
+		{Text: "Validator address: cosmosvaloper1val", Indent: 1},
+		{Text: "Commission rate: 0.05", Indent: 1},
+		{Text: "End of Messages"},
+	}, screens[4:len(screens)-1])
+}
@@ -0,0 +1,152 @@
+package textual
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	gogoproto "github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetSignBytes returns the SIGN_MODE_TEXTUAL sign bytes of the transaction, i.e. the CBOR
+// encoding of its screens.
+func (t Textual) GetSignBytes(ctx context.Context, data signing.SignerData, bodyBz, authInfoBz []byte) ([]byte, error) {
+	screens, err := t.RenderTx(ctx, data, bodyBz, authInfoBz)
+	if err != nil {
+		return nil, err
+	}
+	return EncodeScreens(screens), nil
+}
+
+// RenderTx renders the transaction with the given body and auth info bytes as screens for the
+// signer. The screens end with the hash of the bytes, so that the signature covers the exact
+// bytes of the transaction.
+func (t Textual) RenderTx(ctx context.Context, data signing.SignerData, bodyBz, authInfoBz []byte) ([]Screen, error) {
+	var body tx.TxBody
+	if err := body.Unmarshal(bodyBz); err != nil {
+		return nil, err
+	}
+	var authInfo tx.AuthInfo
+	if err := authInfo.Unmarshal(authInfoBz); err != nil {
+		return nil, err
+	}
+
+	screens := []Screen{
+		{Text: fmt.Sprintf("Chain id: %s", data.ChainID)},
+		{Text: fmt.Sprintf("Account number: %s", formatInteger(fmt.Sprint(data.AccountNumber)))},
+		{Text: fmt.Sprintf("Sequence: %s", formatInteger(fmt.Sprint(data.Sequence)))},
+		{Text: fmt.Sprintf("Address: %s", data.Address)},
+	}
+	if data.PubKey != nil {
+		screens = append(screens, Screen{Text: fmt.Sprintf("Public key: /%s", gogoproto.MessageName(data.PubKey)), Expert: true})
+	}
+
+	n := len(body.Messages)
+	if n == 1 {
+		screens = append(screens, Screen{Text: "This transaction has 1 Message"})
+	} else {
+		screens = append(screens, Screen{Text: fmt.Sprintf("This transaction has %d Messages", n)})
+	}
+	for i, anyMsg := range body.Messages {
+		msg, err := t.resolver.unmarshal(anyMsg.TypeUrl, anyMsg.Value)
+		if err != nil {
+			return nil, err
+		}
+		msgScreens, err := t.formatTypedMessage(ctx, anyMsg.TypeUrl, msg)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, titled(fmt.Sprintf("Message (%d/%d)", i+1, n), msgScreens)...)
+	}
+	screens = append(screens, Screen{Text: "End of Messages"})
+
+	if body.Memo != "" {
+		screens = append(screens, Screen{Text: fmt.Sprintf("Memo: %s", body.Memo)})
+	}
+
+	if fee := authInfo.Fee; fee != nil {
+		fees, err := t.FormatCoins(ctx, fee.Amount)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, Screen{Text: fmt.Sprintf("Fees: %s", fees)})
+		if fee.Payer != "" {
+			screens = append(screens, Screen{Text: fmt.Sprintf("Fee payer: %s", fee.Payer), Expert: true})
+		}
+		if fee.Granter != "" {
+			screens = append(screens, Screen{Text: fmt.Sprintf("Fee granter: %s", fee.Granter), Expert: true})
+		}
+	}
+
+	if tip := authInfo.Tip; tip != nil {
+		tipAmount, err := t.FormatCoins(ctx, tip.Amount)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens,
+			Screen{Text: fmt.Sprintf("Tip: %s", tipAmount)},
+			Screen{Text: fmt.Sprintf("Tipper: %s", tip.Tipper), Expert: true},
+		)
+	}
+
+	if fee := authInfo.Fee; fee != nil && fee.GasLimit != 0 {
+		screens = append(screens, Screen{Text: fmt.Sprintf("Gas limit: %s", formatInteger(fmt.Sprint(fee.GasLimit))), Expert: true})
+	}
+	if body.TimeoutHeight != 0 {
+		screens = append(screens, Screen{Text: fmt.Sprintf("Timeout height: %s", formatInteger(fmt.Sprint(body.TimeoutHeight))), Expert: true})
+	}
+	if body.Unordered {
+		screens = append(screens, Screen{Text: "Unordered: True", Expert: true})
+	}
+
+	hash := sha256.New()
+	hash.Write(bodyBz)
+	hash.Write(authInfoBz)
+	screens = append(screens, Screen{Text: fmt.Sprintf("Hash of raw bytes: %X", hash.Sum(nil)), Expert: true})
+
+	return screens, nil
+}
+
+// NewKeeperCoinMetadataQueryFn returns a CoinMetadataQueryFn reading the coin metadata from
+// the bank keeper, for the verification of the signatures. The context must wrap an
+// sdk.Context.
+func NewKeeperCoinMetadataQueryFn(k interface {
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+},
+) CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+		sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context)
+		if !ok {
+			return nil, fmt.Errorf("expected an sdk.Context to query the metadata of %s", denom)
+		}
+		metadata, found := k.GetDenomMetaData(sdkCtx, denom)
+		if !found {
+			return nil, nil
+		}
+		return &metadata, nil
+	}
+}
+
+// NewGRPCCoinMetadataQueryFn returns a CoinMetadataQueryFn querying the coin metadata from a
+// node, for the signers.
+func NewGRPCCoinMetadataQueryFn(conn gogogrpc.ClientConn) CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+		res, err := banktypes.NewQueryClient(conn).DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: denom})
+		if err != nil {
+			if status.Code(err) == codes.NotFound || sdkerrors.ErrKeyNotFound.Is(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return &res.Metadata, nil
+	}
+}
@@ -0,0 +1,149 @@
+package textual
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxHexBytes is the maximum length of the bytes rendered in hex, the longer bytes being
+// rendered as their hash.
+const maxHexBytes = 32
+
+// formatInteger renders an integer string with a thousands separator, e.g. 1'234'567.
+func formatInteger(v string) string {
+	sign := ""
+	if strings.HasPrefix(v, "-") {
+		sign, v = "-", v[1:]
+	}
+
+	var sb strings.Builder
+	sb.WriteString(sign)
+	for i, c := range v {
+		if i > 0 && (len(v)-i)%3 == 0 {
+			sb.WriteByte('\'')
+		}
+		sb.WriteRune(c)
+	}
+	return sb.String()
+}
+
+// formatDecimal renders a decimal string with a thousands separator in its integer part and
+// without the trailing zeros of its fractional part, e.g. 1'234.56.
+func formatDecimal(v string) (string, error) {
+	intPart, fracPart := v, ""
+	if i := strings.IndexByte(v, '.'); i >= 0 {
+		intPart, fracPart = v[:i], v[i+1:]
+	}
+	digits := strings.TrimPrefix(intPart, "-")
+	if digits == "" || !isDigits(digits) || !isDigits(fracPart) {
+		return "", fmt.Errorf("invalid decimal %q", v)
+	}
+
+	fracPart = strings.TrimRight(fracPart, "0")
+	if fracPart == "" {
+		return formatInteger(intPart), nil
+	}
+	return formatInteger(intPart) + "." + fracPart, nil
+}
+
+// formatLegacyDec renders a cosmos.Dec, which is encoded as its integer value scaled by
+// 10^Precision.
+func formatLegacyDec(v string) (string, error) {
+	dec, err := sdk.NewDecFromStr(shiftDecimal(v, sdk.Precision))
+	if err != nil {
+		return "", err
+	}
+	return formatDecimal(dec.String())
+}
+
+// shiftDecimal divides the decimal string by 10^n.
+func shiftDecimal(v string, n int) string {
+	sign := ""
+	if strings.HasPrefix(v, "-") {
+		sign, v = "-", v[1:]
+	}
+	intPart, fracPart := v, ""
+	if i := strings.IndexByte(v, '.'); i >= 0 {
+		intPart, fracPart = v[:i], v[i+1:]
+	}
+	if len(intPart) <= n {
+		intPart = strings.Repeat("0", n-len(intPart)+1) + intPart
+	}
+	fracPart = intPart[len(intPart)-n:] + fracPart
+	intPart = intPart[:len(intPart)-n]
+	if fracPart == "" {
+		return sign + intPart
+	}
+	return sign + intPart + "." + fracPart
+}
+
+func isDigits(v string) bool {
+	for _, c := range v {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// formatBytes renders short bytes in uppercase hex, and the longer ones as their SHA-256 hash.
+func formatBytes(bz []byte) string {
+	if len(bz) <= maxHexBytes {
+		return fmt.Sprintf("%X", bz)
+	}
+	hash := sha256.Sum256(bz)
+	return fmt.Sprintf("SHA-256=%X", hash[:])
+}
+
+// formatTimestamp renders a timestamp in RFC 3339 in UTC.
+func formatTimestamp(seconds int64, nanos int32) string {
+	return time.Unix(seconds, int64(nanos)).UTC().Format(time.RFC3339Nano)
+}
+
+// formatDuration renders a duration in days, hours, minutes and seconds, omitting the zero
+// units, e.g. 1 day, 2 hours, 4.5 seconds.
+func formatDuration(seconds int64, nanos int32) string {
+	sign := ""
+	if seconds < 0 || nanos < 0 {
+		sign, seconds, nanos = "-", -seconds, -nanos
+	}
+
+	var parts []string
+	units := []struct {
+		name    string
+		seconds int64
+	}{{"day", 24 * 60 * 60}, {"hour", 60 * 60}, {"minute", 60}}
+	for _, unit := range units {
+		if n := seconds / unit.seconds; n > 0 {
+			parts = append(parts, pluralize(formatInteger(fmt.Sprint(n)), unit.name, n == 1))
+			seconds %= unit.seconds
+		}
+	}
+	if seconds > 0 || nanos > 0 || len(parts) == 0 {
+		secs := shiftDecimal(fmt.Sprintf("%d%09d", seconds, nanos), 9)
+		secs = strings.TrimRight(strings.TrimRight(secs, "0"), ".")
+		parts = append(parts, pluralize(secs, "second", secs == "1"))
+	}
+	return sign + strings.Join(parts, ", ")
+}
+
+func pluralize(n, unit string, one bool) string {
+	if one {
+		return n + " " + unit
+	}
+	return n + " " + unit + "s"
+}
+
+// fieldTitle returns the title of a field rendered from its name, e.g. From address for
+// from_address.
+func fieldTitle(name string) string {
+	title := strings.ReplaceAll(name, "_", " ")
+	if title == "" {
+		return title
+	}
+	return strings.ToUpper(title[:1]) + title[1:]
+}
@@ -0,0 +1,83 @@
+package tx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type metadataKey struct{}
+
+func TestTextualModeHandler(t *testing.T) {
+	privKey, pubkey, addr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	metadata := banktypes.Metadata{
+		Base:       "uatom",
+		Display:    "atom",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uatom"}, {Denom: "atom", Exponent: 6}},
+	}
+	queryMetadata := func(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+		if ctx.Value(metadataKey{}) == nil {
+			return nil, nil
+		}
+		return &metadata, nil
+	}
+	txConfig := NewTxConfigWithTextual(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}, queryMetadata)
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetMemo("sometestmemo")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uatom", 150)))
+	txBuilder.SetGasLimit(20000)
+	require.NoError(t, txBuilder.SetSignatures(signingtypes.SignatureV2{
+		PubKey:   pubkey,
+		Data:     &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_TEXTUAL},
+		Sequence: 2,
+	}))
+
+	modeHandler := txConfig.SignModeHandler()
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_TEXTUAL, modeHandler.DefaultMode())
+	require.Len(t, modeHandler.Modes(), 1)
+
+	signingData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      2,
+		PubKey:        pubkey,
+	}
+	ctx := context.WithValue(context.Background(), metadataKey{}, true)
+	signBytes, err := signing.GetSignBytesWithContext(ctx, modeHandler, signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	w := txBuilder.(*wrapper)
+	screens, err := textual.NewTextual(queryMetadata).RenderTx(ctx, signingData, w.getBodyBytes(), w.getAuthInfoBytes())
+	require.NoError(t, err)
+	require.Equal(t, textual.EncodeScreens(screens), signBytes)
+	require.Contains(t, screens, textual.Screen{Text: "Fees: 0.00015 atom"})
+	require.Contains(t, screens, textual.Screen{Text: "Message (1/1): /testdata.TestMsg"})
+
+	// the signature is verified in the context of its signing
+	sig, err := privKey.Sign(signBytes)
+	require.NoError(t, err)
+	sigData := &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_TEXTUAL, Signature: sig}
+	require.NoError(t, signing.VerifySignature(ctx, pubkey, signingData, sigData, modeHandler, txBuilder.GetTx()))
+	require.Error(t, signing.VerifySignature(context.Background(), pubkey, signingData, sigData, modeHandler, txBuilder.GetTx()))
+
+	_, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, txBuilder.GetTx())
+	require.Error(t, err)
+	_, err = signModeTextualHandler{}.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, new(nonProtoTx))
+	require.Error(t, err)
+}
@@ -25,6 +25,9 @@ var (
 	// AddressStoreKeyPrefix prefix for account-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
 
+	// UnorderedTxsKeyPrefix prefix for the unordered txs included before their timeout height
+	UnorderedTxsKeyPrefix = []byte{0x02}
+
 	// param key for global account number
 	GlobalAccountNumberKey = []byte("globalAccountNumber")
 
@@ -41,3 +44,10 @@ func AddressStoreKey(addr sdk.AccAddress) []byte {
 func AccountNumberStoreKey(accountNumber uint64) []byte {
 	return append(AccountNumberStoreKeyPrefix, sdk.Uint64ToBigEndian(accountNumber)...)
 }
+
+// UnorderedTxKey returns the key of an unordered tx, by timeout height then tx hash, so that the
+// txs are pruned in the order of their timeout height.
+func UnorderedTxKey(timeoutHeight uint64, txHash []byte) []byte {
+	key := append(UnorderedTxsKeyPrefix, sdk.Uint64ToBigEndian(timeoutHeight)...)
+	return append(key, txHash...)
+}
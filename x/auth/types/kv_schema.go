@@ -0,0 +1,34 @@
+package types
+
+import (
+	gogotypes "github.com/gogo/protobuf/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+)
+
+// KVSchema returns the key/value schema of the auth store.
+func KVSchema() []schema.Entry {
+	return []schema.Entry{
+		{Name: "params", Prefix: ParamsKey, Value: schema.ProtoValue(&Params{})},
+		{
+			Name:   "account",
+			Prefix: AddressStoreKeyPrefix,
+			Key:    []schema.KeyField{schema.Field("address", schema.AccAddressKey)},
+			Value:  schema.ProtoValue(&codectypes.Any{}),
+		},
+		{
+			Name:   "unordered_tx",
+			Prefix: UnorderedTxsKeyPrefix,
+			Key:    []schema.KeyField{schema.Field("timeout_height", schema.Uint64Key), schema.Field("tx_hash", schema.BytesKey)},
+			Value:  schema.BytesValue,
+		},
+		{Name: "global_account_number", Prefix: GlobalAccountNumberKey, Value: schema.ProtoValue(&gogotypes.UInt64Value{})},
+		{
+			Name:   "account_number",
+			Prefix: AccountNumberStoreKeyPrefix,
+			Key:    []schema.KeyField{schema.Field("number", schema.Uint64Key)},
+			Value:  schema.AccAddressValue,
+		},
+	}
+}
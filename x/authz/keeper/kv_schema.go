@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// KVSchema returns the key/value schema of the authz store.
+func KVSchema() []schema.Entry {
+	granter := schema.Field("granter", schema.LengthPrefixed(schema.AccAddressKey))
+	grantee := schema.Field("grantee", schema.LengthPrefixed(schema.AccAddressKey))
+	return []schema.Entry{
+		{
+			Name:   "grant",
+			Prefix: GrantKey,
+			Key:    []schema.KeyField{granter, grantee, schema.Field("msg_type_url", schema.StringKey)},
+			Value:  schema.ProtoValue(&authz.Grant{}),
+		},
+		{
+			Name:   "grant_queue",
+			Prefix: GrantQueuePrefix,
+			Key: []schema.KeyField{
+				schema.Field("expiration", schema.Fixed(len(sdk.FormatTimeBytes(time.Time{})), schema.TimeKey)),
+				granter,
+				grantee,
+			},
+			Value: schema.ProtoValue(&authz.GrantQueueItem{}),
+		},
+	}
+}
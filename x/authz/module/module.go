@@ -21,6 +21,7 @@ import (
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/authz"
@@ -33,6 +34,7 @@ var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+	_ module.HasKVSchema         = AppModuleBasic{}
 )
 
 // AppModuleBasic defines the basic application module used by the authz module.
@@ -67,6 +69,11 @@ func (AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	authz.RegisterInterfaces(registry)
 }
 
+// RegisterKVSchema registers the key/value schema of the authz store.
+func (AppModuleBasic) RegisterKVSchema(registry *schema.Registry) {
+	registry.Register(keeper.StoreKey, keeper.KVSchema()...)
+}
+
 // DefaultGenesis returns default genesis state as raw bytes for the authz
 // module.
 func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
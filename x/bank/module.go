@@ -24,6 +24,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/bank/client/cli"
@@ -37,6 +38,7 @@ var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+	_ module.HasKVSchema         = AppModuleBasic{}
 )
 
 // AppModuleBasic defines the basic application module used by the bank module.
@@ -93,6 +95,11 @@ func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry)
 	v040.RegisterInterfaces(registry)
 }
 
+// RegisterKVSchema registers the key/value schema of the bank store.
+func (AppModuleBasic) RegisterKVSchema(registry *schema.Registry) {
+	registry.Register(types.StoreKey, types.KVSchema()...)
+}
+
 // AppModule implements an application module for the bank module.
 type AppModule struct {
 	AppModuleBasic
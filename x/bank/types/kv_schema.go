@@ -0,0 +1,47 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+)
+
+// KVSchema returns the key/value schema of the bank store.
+func KVSchema() []schema.Entry {
+	return []schema.Entry{
+		{
+			Name:   "supply",
+			Prefix: SupplyKey,
+			Key:    []schema.KeyField{schema.Field("denom", schema.StringKey)},
+			Value:  schema.IntValue,
+		},
+		{
+			Name:   "denom_metadata",
+			Prefix: DenomMetadataPrefix,
+			Key:    []schema.KeyField{schema.Field("denom", schema.StringKey)},
+			Value:  schema.ProtoValue(&Metadata{}),
+		},
+		{
+			Name:   "balance",
+			Prefix: BalancesPrefix,
+			Key: []schema.KeyField{
+				schema.Field("address", schema.LengthPrefixed(schema.AccAddressKey)),
+				schema.Field("denom", schema.StringKey),
+			},
+			Value: schema.IntValue,
+		},
+		{
+			Name:   "denom_address",
+			Prefix: DenomAddressPrefix,
+			Key: []schema.KeyField{
+				schema.Field("denom", schema.NullTerminated(schema.StringKey)),
+				schema.Field("address", schema.LengthPrefixed(schema.AccAddressKey)),
+			},
+			Value: schema.BytesValue,
+		},
+		{
+			Name:   "send_enabled",
+			Prefix: SendEnabledPrefix,
+			Key:    []schema.KeyField{schema.Field("denom", schema.StringKey)},
+			Value:  schema.BoolValue,
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestKVSchema(t *testing.T) {
+	registry := schema.NewRegistry(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()))
+	registry.Register(types.StoreKey, types.KVSchema()...)
+
+	addr := sdk.AccAddress("addr________________")
+	amount, err := sdk.NewInt(42).Marshal()
+	require.NoError(t, err)
+	metadata, err := (&types.Metadata{Base: "stake", Display: "stake"}).Marshal()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		key      []byte
+		value    []byte
+		expected string
+	}{
+		{
+			"supply",
+			append(types.SupplyKey, "stake"...),
+			amount,
+			`{"entry":"supply","key":{"denom":"stake"},"value":"42"}`,
+		},
+		{
+			"denom metadata",
+			append(types.DenomMetadataPrefix, "stake"...),
+			metadata,
+			`{"entry":"denom_metadata","key":{"denom":"stake"},"value":{"description":"","denom_units":[],"base":"stake","display":"stake","name":"","symbol":"","uri":"","uri_hash":""}}`,
+		},
+		{
+			"balance",
+			append(types.CreateAccountBalancesPrefix(addr), "stake"...),
+			amount,
+			`{"entry":"balance","key":{"address":"` + addr.String() + `","denom":"stake"},"value":"42"}`,
+		},
+		{
+			"denom address",
+			append(types.CreateDenomAddressPrefix("stake"), address.MustLengthPrefix(addr)...),
+			[]byte{0},
+			`{"entry":"denom_address","key":{"denom":"stake","address":"` + addr.String() + `"},"value":"00"}`,
+		},
+		{
+			"send enabled",
+			types.CreateSendEnabledKey("stake"),
+			[]byte{types.FalseB},
+			`{"entry":"send_enabled","key":{"denom":"stake"},"value":false}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			decoded, err := registry.Decode(types.StoreKey, tc.key, tc.value)
+			require.NoError(t, err)
+			bz, err := json.Marshal(decoded)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, string(bz))
+		})
+	}
+}
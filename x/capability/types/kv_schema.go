@@ -0,0 +1,19 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+)
+
+// KVSchema returns the key/value schema of the capability store. The in-memory store
+// has no schema.
+func KVSchema() []schema.Entry {
+	return []schema.Entry{
+		{Name: "index", Prefix: KeyIndex, Value: schema.Uint64Value},
+		{
+			Name:   "owners",
+			Prefix: KeyPrefixIndexCapability,
+			Key:    []schema.KeyField{schema.Field("index", schema.Uint64Key)},
+			Value:  schema.ProtoValue(&CapabilityOwners{}),
+		},
+	}
+}
@@ -22,6 +22,7 @@ import (
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis/client/cli"
@@ -38,6 +39,7 @@ const ConsensusVersion = 2
 var (
 	_ module.AppModule      = AppModule{}
 	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.HasKVSchema    = AppModuleBasic{}
 )
 
 // Module init related flags
@@ -91,6 +93,11 @@ func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry)
 	types.RegisterInterfaces(registry)
 }
 
+// RegisterKVSchema registers the key/value schema of the crisis store.
+func (AppModuleBasic) RegisterKVSchema(registry *schema.Registry) {
+	registry.Register(types.StoreKey, types.KVSchema()...)
+}
+
 // AppModule implements an application module for the crisis module.
 type AppModule struct {
 	AppModuleBasic
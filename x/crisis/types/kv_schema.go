@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+)
+
+// KVSchema returns the key/value schema of the crisis store.
+func KVSchema() []schema.Entry {
+	return []schema.Entry{
+		{Name: "constant_fee", Prefix: ConstantFeeKey, Value: schema.ProtoValue(&sdk.Coin{})},
+	}
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+)
+
+// KVSchema returns the key/value schema of the distribution store.
+func KVSchema() []schema.Entry {
+	validator := schema.Field("validator", schema.LengthPrefixed(schema.ValAddressKey))
+	return []schema.Entry{
+		{Name: "fee_pool", Prefix: FeePoolKey, Value: schema.ProtoValue(&FeePool{})},
+		{Name: "previous_proposer", Prefix: ProposerKey, Value: schema.ProtoValue(&gogotypes.BytesValue{})},
+		{
+			Name:   "validator_outstanding_rewards",
+			Prefix: ValidatorOutstandingRewardsPrefix,
+			Key:    []schema.KeyField{validator},
+			Value:  schema.ProtoValue(&ValidatorOutstandingRewards{}),
+		},
+		{
+			Name:   "delegator_withdraw_address",
+			Prefix: DelegatorWithdrawAddrPrefix,
+			Key:    []schema.KeyField{schema.Field("delegator", schema.LengthPrefixed(schema.AccAddressKey))},
+			Value:  schema.AccAddressValue,
+		},
+		{
+			Name:   "delegator_starting_info",
+			Prefix: DelegatorStartingInfoPrefix,
+			Key:    []schema.KeyField{validator, schema.Field("delegator", schema.LengthPrefixed(schema.AccAddressKey))},
+			Value:  schema.ProtoValue(&DelegatorStartingInfo{}),
+		},
+		{
+			Name:   "validator_historical_rewards",
+			Prefix: ValidatorHistoricalRewardsPrefix,
+			Key:    []schema.KeyField{validator, schema.Field("period", schema.LittleEndianUint64Key)},
+			Value:  schema.ProtoValue(&ValidatorHistoricalRewards{}),
+		},
+		{
+			Name:   "validator_current_rewards",
+			Prefix: ValidatorCurrentRewardsPrefix,
+			Key:    []schema.KeyField{validator},
+			Value:  schema.ProtoValue(&ValidatorCurrentRewards{}),
+		},
+		{
+			Name:   "validator_accumulated_commission",
+			Prefix: ValidatorAccumulatedCommissionPrefix,
+			Key:    []schema.KeyField{validator},
+			Value:  schema.ProtoValue(&ValidatorAccumulatedCommission{}),
+		},
+		{
+			Name:   "validator_slash_event",
+			Prefix: ValidatorSlashEventPrefix,
+			Key: []schema.KeyField{
+				validator,
+				schema.Field("height", schema.Uint64Key),
+				schema.Field("period", schema.Uint64Key),
+			},
+			Value: schema.ProtoValue(&ValidatorSlashEvent{}),
+		},
+		{Name: "params", Prefix: ParamsKey, Value: schema.ProtoValue(&Params{})},
+	}
+}
@@ -0,0 +1,29 @@
+package epoching
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// BeginBlocker advances the named epochs whose current epoch ended.
+func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.AdvanceEpochInfos(ctx)
+}
+
+// EndBlocker executes the queued msgs and starts a new epoch when the current
+// block is the last block of the current epoch.
+func EndBlocker(ctx sdk.Context, k *keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if ctx.BlockHeight() < k.GetEpochEndHeight(ctx) {
+		return
+	}
+
+	k.EndEpoch(ctx)
+}
@@ -0,0 +1,78 @@
+package epoching_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestSimAppStakingMsgs(t *testing.T) {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, _, addr2 := testdata.KeyTestPubAddr()
+	genCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(42, sdk.DefaultPowerReduction))
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction))
+
+	accs := authtypes.GenesisAccounts{
+		&authtypes.BaseAccount{Address: addr1.String()},
+		&authtypes.BaseAccount{Address: addr2.String()},
+	}
+	balances := []banktypes.Balance{
+		{Address: addr1.String(), Coins: sdk.Coins{genCoin}},
+		{Address: addr2.String(), Coins: sdk.Coins{genCoin}},
+	}
+
+	app := simapp.SetupWithGenesisAccounts(t, accs, balances...)
+	simapp.SetEpochLength(t, app, 3)
+	// as when SimApp is started with epoching.FlagRejectUnwrappedStakingMsgs
+	app.MsgServiceRouter().SetMsgFilter(epoching.RejectUnwrappedStakingMsgs)
+
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+	validators := app.StakingKeeper.GetAllValidators(ctx)
+	require.Len(t, validators, 1)
+	valAddr := validators[0].GetOperator()
+	delegateMsg := stakingtypes.NewMsgDelegate(addr2, valAddr, bondCoin)
+	txGen := simapp.MakeTestEncodingConfig().TxConfig
+
+	// the MsgServiceRouter rejects the unwrapped staking msgs
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err := simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, []sdk.Msg{delegateMsg}, "", []uint64{1}, []uint64{0}, false, false, priv2)
+	require.ErrorContains(t, err, "must be wrapped in an epoching msg")
+	simapp.CheckBalance(t, app, addr2, sdk.Coins{genCoin})
+
+	// the wrapped msg is queued, with its tokens escrowed, until the end of the epoch
+	header = tmproto.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err = simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, []sdk.Msg{types.NewMsgWrappedDelegate(delegateMsg)}, "", []uint64{1}, []uint64{1}, true, true, priv2)
+	require.NoError(t, err)
+	simapp.CheckBalance(t, app, addr2, sdk.Coins{genCoin.Sub(bondCoin)})
+
+	ctx = app.BaseApp.NewContext(true, tmproto.Header{})
+	require.Len(t, app.EpochingKeeper.GetAllQueuedMsgs(ctx), 1)
+	_, found := app.StakingKeeper.GetDelegation(ctx, addr2, valAddr)
+	require.False(t, found)
+
+	// the queued msg is executed by the EndBlocker of the last block of the epoch
+	for endHeight := app.EpochingKeeper.GetEpochEndHeight(ctx); app.LastBlockHeight() < endHeight; {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	ctx = app.BaseApp.NewContext(true, tmproto.Header{})
+	require.Empty(t, app.EpochingKeeper.GetAllQueuedMsgs(ctx))
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addr2, valAddr)
+	require.True(t, found)
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(sdk.IntEq(t, bondCoin.Amount, validator.TokensFromShares(delegation.Shares).TruncateInt()))
+}
@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// GetQueryCmd returns the cli query commands for the epoching module.
+func GetQueryCmd() *cobra.Command {
+	epochingQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the epoching module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	epochingQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryCurrentEpoch(),
+		GetCmdQueryQueuedMsgs(),
+		GetCmdQueryEpochInfos(),
+		GetCmdQueryEpochInfo(),
+	)
+
+	return epochingQueryCmd
+}
+
+// GetCmdQueryParams implements a command to return the current epoching
+// parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current epoching parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCurrentEpoch implements a command to return the current epoch.
+func GetCmdQueryCurrentEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "current-epoch",
+		Short: "Query the current epoch number and boundaries",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CurrentEpoch(cmd.Context(), &types.QueryCurrentEpochRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryQueuedMsgs implements a command to return the msgs waiting to be
+// executed at the end of an epoch.
+func GetCmdQueryQueuedMsgs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queued-msgs",
+		Short: "Query the messages queued for execution at the end of the epoch",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueuedMsgs(cmd.Context(), &types.QueryQueuedMsgsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "queued-msgs")
+
+	return cmd
+}
+
+// GetCmdQueryEpochInfos implements a command to return all the named epochs.
+func GetCmdQueryEpochInfos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-infos",
+		Short: "Query all the named epochs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EpochInfos(cmd.Context(), &types.QueryEpochInfosRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "epoch-infos")
+
+	return cmd
+}
+
+// GetCmdQueryEpochInfo implements a command to return the current state of a
+// named epoch.
+func GetCmdQueryEpochInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "epoch-info [identifier]",
+		Short:   "Query the current state of a named epoch",
+		Example: fmt.Sprintf("%s query epoching epoch-info day", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EpochInfo(cmd.Context(), &types.QueryEpochInfoRequest{Identifier: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Epoch)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
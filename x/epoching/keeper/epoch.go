@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// EndEpoch executes the msgs queued for the current epoch, removes them from
+// the queue and starts a new epoch at the next block height.
+//
+// Every msg is executed in its own cached context: a msg that fails has no
+// effect on state and does not prevent the remaining msgs from being executed.
+// The tokens escrowed for a msg that fails, including one that can't be
+// unpacked or routed, are refunded to their owner.
+func (k Keeper) EndEpoch(ctx sdk.Context) {
+	logger := k.Logger(ctx)
+	epochNumber := k.GetEpochNumber(ctx)
+
+	for _, queued := range k.GetEpochMsgs(ctx, epochNumber) {
+		msgType := queued.Msg.GetTypeUrl()
+
+		events, err := k.executeQueuedMsg(ctx, queued)
+		if err != nil {
+			logger.Info(
+				"failed to execute queued msg",
+				"epoch", epochNumber,
+				"action_id", queued.Id,
+				"msg_type", msgType,
+				"err", err,
+			)
+
+			if err := k.releaseEscrow(ctx, queued.Id); err != nil {
+				logger.Error("failed to refund the escrow of queued msg", "epoch", epochNumber, "action_id", queued.Id, "err", err)
+			}
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeExecuteMsg,
+					sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", epochNumber)),
+					sdk.NewAttribute(types.AttributeKeyActionID, fmt.Sprintf("%d", queued.Id)),
+					sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+					sdk.NewAttribute(types.AttributeKeyResult, types.AttributeValueFailure),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+		} else {
+			ctx.EventManager().EmitEvents(events)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeExecuteMsg,
+					sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", epochNumber)),
+					sdk.NewAttribute(types.AttributeKeyActionID, fmt.Sprintf("%d", queued.Id)),
+					sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+					sdk.NewAttribute(types.AttributeKeyResult, types.AttributeValueSuccess),
+				),
+			)
+		}
+
+		k.dequeueEpochMsg(ctx, queued)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochEnd,
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", epochNumber)),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+
+	k.IncreaseEpochNumber(ctx)
+	k.SetEpochStartHeight(ctx, ctx.BlockHeight()+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochStart,
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", epochNumber+1)),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight()+1)),
+		),
+	)
+
+	logger.Info("epoch ended", "epoch", epochNumber, "height", ctx.BlockHeight())
+}
+
+// executeQueuedMsg routes a queued msg to its handler in a cached context.
+// The tokens escrowed for the msg are first returned to their owner in the same
+// context, so that the msg bonds them if it succeeds. State changes, including
+// the release of the escrow, are only written if the msg succeeds, in which case
+// the emitted events are returned.
+func (k Keeper) executeQueuedMsg(ctx sdk.Context, queued types.QueuedMsg) (sdk.Events, error) {
+	msg, err := queued.GetMsg()
+	if err != nil {
+		return nil, err
+	}
+
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return nil, sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.releaseEscrow(cacheCtx, queued.Id); err != nil {
+		return nil, err
+	}
+
+	res, err := handler(types.WithQueuedMsgExecution(cacheCtx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	writeCache()
+
+	events := make(sdk.Events, len(res.GetEvents()))
+	for i, event := range res.GetEvents() {
+		events[i] = sdk.Event(event)
+	}
+
+	return events, nil
+}
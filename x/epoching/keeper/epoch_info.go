@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// SetEpochInfo stores the given named epoch.
+func (k Keeper) SetEpochInfo(ctx sdk.Context, info types.EpochInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EpochInfoKey(info.Identifier), k.cdc.MustMarshal(&info))
+}
+
+// GetEpochInfo returns the named epoch with the given identifier.
+func (k Keeper) GetEpochInfo(ctx sdk.Context, identifier string) (types.EpochInfo, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.EpochInfoKey(identifier))
+	if bz == nil {
+		return types.EpochInfo{}, false
+	}
+
+	var info types.EpochInfo
+	k.cdc.MustUnmarshal(bz, &info)
+
+	return info, true
+}
+
+// AddEpochInfo registers a new named epoch. A zero start time makes the epoch
+// start at the current block time.
+func (k Keeper) AddEpochInfo(ctx sdk.Context, info types.EpochInfo) error {
+	if err := info.Validate(); err != nil {
+		return err
+	}
+
+	if _, found := k.GetEpochInfo(ctx, info.Identifier); found {
+		return types.ErrEpochInfoExists.Wrap(info.Identifier)
+	}
+
+	if info.StartTime.IsZero() {
+		info.StartTime = ctx.BlockTime()
+	}
+
+	if info.CurrentEpochStartHeight == 0 {
+		info.CurrentEpochStartHeight = ctx.BlockHeight()
+	}
+
+	k.SetEpochInfo(ctx, info)
+	return nil
+}
+
+// DeleteEpochInfo removes the named epoch with the given identifier.
+func (k Keeper) DeleteEpochInfo(ctx sdk.Context, identifier string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.EpochInfoKey(identifier))
+}
+
+// IterateEpochInfos iterates over the named epochs ordered by identifier.
+// Iteration stops when cb returns true.
+func (k Keeper) IterateEpochInfos(ctx sdk.Context, cb func(info types.EpochInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.EpochInfoPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.EpochInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+
+		if cb(info) {
+			break
+		}
+	}
+}
+
+// AllEpochInfos returns all the named epochs.
+func (k Keeper) AllEpochInfos(ctx sdk.Context) []types.EpochInfo {
+	epochs := []types.EpochInfo{}
+	k.IterateEpochInfos(ctx, func(info types.EpochInfo) bool {
+		epochs = append(epochs, info)
+		return false
+	})
+
+	return epochs
+}
+
+// AdvanceEpochInfos starts the named epochs whose start time is reached and
+// moves the running ones whose duration elapsed to their next epoch, calling
+// the AfterEpochEnd and BeforeEpochStart hooks. A named epoch advances at most
+// once per block, so epochs missed while the chain was halted are caught up
+// one block at a time.
+func (k Keeper) AdvanceEpochInfos(ctx sdk.Context) {
+	var due []types.EpochInfo
+	k.IterateEpochInfos(ctx, func(info types.EpochInfo) bool {
+		if info.EpochCountingStarted && !ctx.BlockTime().Before(info.EndTime()) ||
+			!info.EpochCountingStarted && !ctx.BlockTime().Before(info.StartTime) {
+			due = append(due, info)
+		}
+		return false
+	})
+
+	for _, info := range due {
+		k.advanceEpochInfo(ctx, info)
+	}
+}
+
+func (k Keeper) advanceEpochInfo(ctx sdk.Context, info types.EpochInfo) {
+	if info.EpochCountingStarted {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEpochEnd,
+				sdk.NewAttribute(types.AttributeKeyIdentifier, info.Identifier),
+				sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", info.CurrentEpoch)),
+				sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+			),
+		)
+		k.callHooks(ctx, "AfterEpochEnd", info, func(ctx sdk.Context, hooks types.EpochHooks) error {
+			return hooks.AfterEpochEnd(ctx, info.Identifier, info.CurrentEpoch)
+		})
+
+		info.CurrentEpochStartTime = info.EndTime()
+		info.CurrentEpoch++
+	} else {
+		info.EpochCountingStarted = true
+		info.CurrentEpochStartTime = info.StartTime
+		info.CurrentEpoch = 1
+	}
+
+	info.CurrentEpochStartHeight = ctx.BlockHeight()
+	k.SetEpochInfo(ctx, info)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochStart,
+			sdk.NewAttribute(types.AttributeKeyIdentifier, info.Identifier),
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", info.CurrentEpoch)),
+			sdk.NewAttribute(types.AttributeKeyStartTime, info.CurrentEpochStartTime.UTC().Format(sdk.SortableTimeFormat)),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+	k.callHooks(ctx, "BeforeEpochStart", info, func(ctx sdk.Context, hooks types.EpochHooks) error {
+		return hooks.BeforeEpochStart(ctx, info.Identifier, info.CurrentEpoch)
+	})
+}
+
+// callHooks runs a hook of every subscriber, each in its own cached context.
+// The state changes of a subscriber whose hook fails are discarded and the
+// error is logged, so that a faulty subscriber can neither halt the chain nor
+// revert the changes of the other subscribers.
+func (k Keeper) callHooks(ctx sdk.Context, name string, info types.EpochInfo, hook func(ctx sdk.Context, hooks types.EpochHooks) error) {
+	if k.hooks == nil {
+		return
+	}
+
+	subscribers, ok := k.hooks.(types.MultiEpochHooks)
+	if !ok {
+		subscribers = types.NewMultiEpochHooks(k.hooks)
+	}
+
+	for i, subscriber := range subscribers {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := hook(cacheCtx, subscriber); err != nil {
+			k.Logger(ctx).Error(
+				"epoch hook failed",
+				"hook", name,
+				"subscriber", i,
+				"identifier", info.Identifier,
+				"epoch", info.CurrentEpoch,
+				"err", err,
+			)
+			continue
+		}
+
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+var hookStoreKey = []byte("hook")
+
+// recordingHooks records the hook calls and writes the last one in the
+// epoching store. The hooks of the epoch named fail return an error after
+// writing.
+type recordingHooks struct {
+	storeKey storetypes.StoreKey
+	fail     string
+	calls    []string
+}
+
+func (h *recordingHooks) record(ctx sdk.Context, call string, identifier string) error {
+	ctx.KVStore(h.storeKey).Set(hookStoreKey, []byte(call))
+	if identifier == h.fail {
+		return errors.New("hook failure")
+	}
+
+	h.calls = append(h.calls, call)
+	return nil
+}
+
+func (h *recordingHooks) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64) error {
+	return h.record(ctx, fmt.Sprintf("end %s %d", identifier, epochNumber), identifier)
+}
+
+func (h *recordingHooks) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64) error {
+	return h.record(ctx, fmt.Sprintf("start %s %d", identifier, epochNumber), identifier)
+}
+
+func (s *KeeperTestSuite) TestAddEpochInfo() {
+	s.Require().Len(s.epochingKeeper.AllEpochInfos(s.ctx), len(types.DefaultEpochInfos()))
+
+	day, found := s.epochingKeeper.GetEpochInfo(s.ctx, types.DayEpochIdentifier)
+	s.Require().True(found)
+	s.Require().Equal(s.ctx.BlockTime(), day.StartTime)
+	s.Require().False(day.EpochCountingStarted)
+
+	err := s.epochingKeeper.AddEpochInfo(s.ctx, types.NewEpochInfo(types.DayEpochIdentifier, time.Time{}, time.Hour))
+	s.Require().ErrorIs(err, types.ErrEpochInfoExists)
+
+	err = s.epochingKeeper.AddEpochInfo(s.ctx, types.NewEpochInfo("hour", time.Time{}, 0))
+	s.Require().ErrorIs(err, types.ErrInvalidEpochInfo)
+
+	s.epochingKeeper.DeleteEpochInfo(s.ctx, types.DayEpochIdentifier)
+	_, found = s.epochingKeeper.GetEpochInfo(s.ctx, types.DayEpochIdentifier)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestAdvanceEpochInfos() {
+	hooks := &recordingHooks{storeKey: s.storeKey}
+	s.epochingKeeper.SetHooks(hooks)
+	s.epochingKeeper.DeleteEpochInfo(s.ctx, types.WeekEpochIdentifier)
+
+	start := s.ctx.BlockTime()
+	blockAt := func(height int64, t time.Time) sdk.Context {
+		ctx := s.ctx.WithBlockHeight(height).WithBlockTime(t).WithEventManager(sdk.NewEventManager())
+		epoching.BeginBlocker(ctx, s.epochingKeeper)
+		return ctx
+	}
+
+	// the first epoch starts at the start time
+	blockAt(1, start)
+	s.Require().Equal([]string{"start day 1"}, hooks.calls)
+
+	blockAt(2, start.Add(23*time.Hour))
+	s.Require().Len(hooks.calls, 1)
+
+	ctx := blockAt(3, start.Add(24*time.Hour))
+	s.Require().Equal([]string{"start day 1", "end day 1", "start day 2"}, hooks.calls)
+
+	day, found := s.epochingKeeper.GetEpochInfo(ctx, types.DayEpochIdentifier)
+	s.Require().True(found)
+	s.Require().Equal(int64(2), day.CurrentEpoch)
+	s.Require().Equal(start.Add(24*time.Hour), day.CurrentEpochStartTime)
+	s.Require().Equal(int64(3), day.CurrentEpochStartHeight)
+
+	var eventTypes []string
+	for _, event := range ctx.EventManager().Events() {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	s.Require().Equal([]string{types.EventTypeEpochEnd, types.EventTypeEpochStart}, eventTypes)
+
+	// missed epochs are caught up one block at a time
+	blockAt(4, start.Add(72*time.Hour))
+	blockAt(5, start.Add(73*time.Hour))
+	blockAt(6, start.Add(74*time.Hour))
+	day, _ = s.epochingKeeper.GetEpochInfo(ctx, types.DayEpochIdentifier)
+	s.Require().Equal(int64(4), day.CurrentEpoch)
+	s.Require().Equal(start.Add(72*time.Hour), day.CurrentEpochStartTime)
+}
+
+func (s *KeeperTestSuite) TestAdvanceEpochInfosHookFailure() {
+	hooks := &recordingHooks{storeKey: s.storeKey, fail: types.WeekEpochIdentifier}
+	s.epochingKeeper.SetHooks(hooks)
+
+	ctx := s.ctx.WithBlockHeight(1)
+	epoching.BeginBlocker(ctx, s.epochingKeeper)
+
+	// the failing hook of the week epoch is reverted, the day one is kept and
+	// both epochs start
+	s.Require().Equal([]string{"start day 1"}, hooks.calls)
+	s.Require().Equal([]byte("start day 1"), ctx.KVStore(s.storeKey).Get(hookStoreKey))
+
+	week, _ := s.epochingKeeper.GetEpochInfo(ctx, types.WeekEpochIdentifier)
+	s.Require().True(week.EpochCountingStarted)
+}
+
+func (s *KeeperTestSuite) TestAdvanceEpochInfosSubscriberFailure() {
+	hooks := &recordingHooks{storeKey: s.storeKey}
+	failingHooks := &recordingHooks{storeKey: s.storeKey, fail: types.DayEpochIdentifier}
+	s.epochingKeeper.SetHooks(types.NewMultiEpochHooks(hooks, failingHooks))
+	s.epochingKeeper.DeleteEpochInfo(s.ctx, types.WeekEpochIdentifier)
+
+	ctx := s.ctx.WithBlockHeight(1)
+	epoching.BeginBlocker(ctx, s.epochingKeeper)
+
+	// only the changes of the failing subscriber are reverted
+	s.Require().Equal([]string{"start day 1"}, hooks.calls)
+	s.Require().Empty(failingHooks.calls)
+	s.Require().Equal([]byte("start day 1"), ctx.KVStore(s.storeKey).Get(hookStoreKey))
+}
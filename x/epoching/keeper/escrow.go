@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// escrowOf returns the address and the coins which are escrowed in the module
+// account while the msg is queued, i.e. the tokens a delegation or the
+// self-delegation of a new validator bonds.
+func escrowOf(msg sdk.Msg) (string, sdk.Coins, bool) {
+	switch msg := msg.(type) {
+	case *stakingtypes.MsgDelegate:
+		return msg.DelegatorAddress, sdk.NewCoins(msg.Amount), true
+	case *stakingtypes.MsgCreateValidator:
+		return msg.DelegatorAddress, sdk.NewCoins(msg.Value), true
+	default:
+		return "", nil, false
+	}
+}
+
+// escrow moves the tokens bonded by a msg to the module account until the msg
+// is executed, so that they can't be spent, nor bonded twice, in the meantime.
+func (k Keeper) escrow(ctx sdk.Context, msg sdk.Msg) error {
+	address, coins, ok := escrowOf(msg)
+	if !ok {
+		return nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, coins)
+}
+
+// setEscrow records the tokens escrowed for the queued msg with the given
+// action ID, so that they can be returned to their owner even if the msg
+// can't be unpacked anymore when the epoch ends.
+func (k Keeper) setEscrow(ctx sdk.Context, actionID uint64, msg sdk.Msg) {
+	address, coins, ok := escrowOf(msg)
+	if !ok {
+		return
+	}
+
+	escrow := banktypes.Balance{Address: address, Coins: coins}
+	ctx.KVStore(k.storeKey).Set(types.EscrowKey(actionID), k.cdc.MustMarshal(&escrow))
+}
+
+// getEscrow returns the tokens escrowed for the queued msg with the given
+// action ID.
+func (k Keeper) getEscrow(ctx sdk.Context, actionID uint64) (banktypes.Balance, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.EscrowKey(actionID))
+	if bz == nil {
+		return banktypes.Balance{}, false
+	}
+
+	var escrow banktypes.Balance
+	k.cdc.MustUnmarshal(bz, &escrow)
+	return escrow, true
+}
+
+// releaseEscrow returns the tokens escrowed for the queued msg with the given
+// action ID to their owner. The escrow record itself is deleted with the
+// queued msg.
+func (k Keeper) releaseEscrow(ctx sdk.Context, actionID uint64) error {
+	escrow, found := k.getEscrow(ctx, actionID)
+	if !found {
+		return nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(escrow.Address)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, escrow.Coins)
+}
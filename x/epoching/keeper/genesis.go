@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// InitGenesis initializes the epoching module's state from a given genesis
+// state. A zero epoch start height makes the first epoch start at the genesis
+// block. Queued msgs are queued for the current epoch. Named epochs with a
+// zero start time start at the genesis time.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	k.SetEpochNumber(ctx, data.EpochNumber)
+
+	startHeight := data.EpochStartHeight
+	if startHeight == 0 {
+		startHeight = ctx.BlockHeight()
+		if startHeight < 1 {
+			startHeight = 1
+		}
+	}
+	k.SetEpochStartHeight(ctx, startHeight)
+
+	for _, any := range data.QueuedMsgs {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			panic(types.ErrInvalidQueuedMsg.Wrapf("expected sdk.Msg, got %T", any.GetCachedValue()))
+		}
+
+		if _, err := k.QueueMsgForEpoch(ctx, data.EpochNumber, msg); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, info := range data.Epochs {
+		if err := k.AddEpochInfo(ctx, info); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the epoching module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	queued := k.GetAllQueuedMsgs(ctx)
+	msgs := make([]*codectypes.Any, len(queued))
+	for i, q := range queued {
+		msgs[i] = q.Msg
+	}
+
+	return types.NewGenesisState(
+		k.GetParams(ctx),
+		k.GetEpochNumber(ctx),
+		k.GetEpochStartHeight(ctx),
+		msgs,
+		k.AllEpochInfos(ctx),
+	)
+}
@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestImportExportGenesis() {
+	msg := stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	any, err := codectypes.NewAnyWithValue(msg)
+	s.Require().NoError(err)
+
+	startTime := time.Unix(500, 0).UTC()
+	epochs := []types.EpochInfo{
+		types.NewEpochInfo("hour", startTime, time.Hour),
+		types.NewEpochInfo("minute", time.Time{}, time.Minute),
+	}
+	genState := types.NewGenesisState(types.NewParams(7), 3, 15, []*codectypes.Any{any}, epochs)
+	s.epochingKeeper.InitGenesis(s.ctx, genState)
+
+	s.Require().Equal(int64(3), s.epochingKeeper.GetEpochNumber(s.ctx))
+	s.Require().Equal(int64(15), s.epochingKeeper.GetEpochStartHeight(s.ctx))
+	s.Require().Equal(int64(21), s.epochingKeeper.GetEpochEndHeight(s.ctx))
+	s.Require().Len(s.epochingKeeper.GetEpochMsgs(s.ctx, 3), 1)
+
+	exported := s.epochingKeeper.ExportGenesis(s.ctx)
+	s.Require().Equal(genState.Params, exported.Params)
+	s.Require().Equal(genState.EpochNumber, exported.EpochNumber)
+	s.Require().Equal(genState.EpochStartHeight, exported.EpochStartHeight)
+	s.Require().Len(exported.QueuedMsgs, 1)
+	s.Require().Equal(msg, exported.QueuedMsgs[0].GetCachedValue())
+
+	// the default epochs are registered by SetupTest, the minute epoch starts
+	// at the genesis time
+	s.Require().Len(exported.Epochs, 4)
+	hour, found := s.epochingKeeper.GetEpochInfo(s.ctx, "hour")
+	s.Require().True(found)
+	s.Require().Equal(startTime, hour.StartTime)
+	minute, found := s.epochingKeeper.GetEpochInfo(s.ctx, "minute")
+	s.Require().True(found)
+	s.Require().Equal(s.ctx.BlockTime(), minute.StartTime)
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the epoching module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// CurrentEpoch returns the number and the boundaries of the current epoch.
+func (k Keeper) CurrentEpoch(c context.Context, _ *types.QueryCurrentEpochRequest) (*types.QueryCurrentEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryCurrentEpochResponse{
+		EpochNumber:      k.GetEpochNumber(ctx),
+		StartHeight:      k.GetEpochStartHeight(ctx),
+		EndHeight:        k.GetEpochEndHeight(ctx),
+		EstimatedEndTime: k.GetNextEpochTime(ctx),
+	}, nil
+}
+
+// QueuedMsgs returns the msgs waiting to be executed at the end of an epoch.
+func (k Keeper) QueuedMsgs(c context.Context, req *types.QueryQueuedMsgsRequest) (*types.QueryQueuedMsgsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochActionQueuePrefix)
+
+	var msgs []types.QueuedMsg
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var queued types.QueuedMsg
+		if err := k.cdc.Unmarshal(value, &queued); err != nil {
+			return err
+		}
+
+		msgs = append(msgs, queued)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryQueuedMsgsResponse{Msgs: msgs, Pagination: pageRes}, nil
+}
+
+// EpochInfos returns all the named epochs.
+func (k Keeper) EpochInfos(c context.Context, req *types.QueryEpochInfosRequest) (*types.QueryEpochInfosResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochInfoPrefix)
+
+	var epochs []types.EpochInfo
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var info types.EpochInfo
+		if err := k.cdc.Unmarshal(value, &info); err != nil {
+			return err
+		}
+
+		epochs = append(epochs, info)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEpochInfosResponse{Epochs: epochs, Pagination: pageRes}, nil
+}
+
+// EpochInfo returns the current state of a named epoch.
+func (k Keeper) EpochInfo(c context.Context, req *types.QueryEpochInfoRequest) (*types.QueryEpochInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Identifier == "" {
+		return nil, status.Error(codes.InvalidArgument, "epoch identifier cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	info, found := k.GetEpochInfo(ctx, req.Identifier)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "epoch %s not found", req.Identifier)
+	}
+
+	return &types.QueryEpochInfoResponse{Epoch: info}, nil
+}
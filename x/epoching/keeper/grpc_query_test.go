@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	params, err := s.epochingKeeper.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), params.Params)
+
+	epoch, err := s.epochingKeeper.CurrentEpoch(s.ctx, &types.QueryCurrentEpochRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(0), epoch.EpochNumber)
+	s.Require().Equal(int64(1), epoch.StartHeight)
+	s.Require().Equal(types.DefaultEpochLength, epoch.EndHeight)
+	s.Require().Equal(s.epochingKeeper.GetNextEpochTime(s.ctx), epoch.EstimatedEndTime)
+
+	for i := 0; i < 3; i++ {
+		msg := stakingtypes.NewMsgDelegate(s.addrs[i], s.valAddrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+		_, err := s.epochingKeeper.QueueMsgForEpoch(s.ctx, 0, msg)
+		s.Require().NoError(err)
+	}
+
+	_, err = s.epochingKeeper.QueuedMsgs(s.ctx, nil)
+	s.Require().Error(err)
+
+	res, err := s.epochingKeeper.QueuedMsgs(s.ctx, &types.QueryQueuedMsgsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Msgs, 2)
+	s.Require().Equal(uint64(3), res.Pagination.Total)
+	s.Require().Equal(uint64(1), res.Msgs[0].Id)
+
+	res, err = s.epochingKeeper.QueuedMsgs(s.ctx, &types.QueryQueuedMsgsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Msgs, 1)
+	s.Require().Equal(uint64(3), res.Msgs[0].Id)
+}
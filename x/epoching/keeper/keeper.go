@@ -1,171 +1,250 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
-	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	db "github.com/tendermint/tm-db"
-)
-
-const (
-	DefaultEpochActionID = 1
-	DefaultEpochNumber   = 0
-)
-
-var (
-	NextEpochActionID      = []byte{0x11}
-	EpochNumberID          = []byte{0x12}
-	EpochActionQueuePrefix = []byte{0x13} // prefix for the epoch
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
 )
 
-// Keeper of the store
+// Keeper of the epoching store
 type Keeper struct {
 	storeKey storetypes.StoreKey
 	cdc      codec.BinaryCodec
+	router   *baseapp.MsgServiceRouter
+
+	stakingKeeper types.StakingKeeper
+	bankKeeper    types.BankKeeper
+	hooks         types.EpochHooks
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
 	// Used to calculate the estimated next epoch time.
 	// This is local to every node
 	// TODO: remove in favor of consensus param when its added
 	commitTimeout time.Duration
 }
 
-// NewKeeper creates a epoch queue manager
-func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, commitTimeout time.Duration) Keeper {
-	return Keeper{
+// NewKeeper creates a new epoching Keeper instance. The router is used to
+// execute the queued messages at the end of every epoch.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	router *baseapp.MsgServiceRouter,
+	sk types.StakingKeeper,
+	bk types.BankKeeper,
+	authority string,
+	commitTimeout time.Duration,
+) *Keeper {
+	return &Keeper{
 		storeKey:      key,
 		cdc:           cdc,
+		router:        router,
+		stakingKeeper: sk,
+		bankKeeper:    bk,
+		authority:     authority,
 		commitTimeout: commitTimeout,
 	}
 }
 
-// GetNewActionID returns ID to be used for next epoch
-func (k Keeper) GetNewActionID(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-
-	bz := store.Get(NextEpochActionID)
-	if bz == nil {
-		// return default action ID to 1
-		return DefaultEpochActionID
+// SetHooks sets the hooks for named epochs
+func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set epoching hooks twice")
 	}
-	id := sdk.BigEndianToUint64(bz)
 
-	// increment next action ID
-	store.Set(NextEpochActionID, sdk.Uint64ToBigEndian(id+1))
+	k.hooks = eh
 
-	return id
+	return k
 }
 
-// ActionStoreKey returns action store key from ID
-func ActionStoreKey(epochNumber int64, actionID uint64) []byte {
-	return append(EpochActionQueuePrefix, byte(epochNumber), byte(actionID))
+// GetAuthority returns the x/epoching module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
 
-// QueueMsgForEpoch save the actions that need to be executed on next epoch
-func (k Keeper) QueueMsgForEpoch(ctx sdk.Context, epochNumber int64, msg sdk.Msg) {
-	store := ctx.KVStore(k.storeKey)
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
 
-	bz, err := k.cdc.MarshalInterface(msg)
+// SetParams sets the x/epoching module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
 	if err != nil {
-		panic(err)
+		return err
 	}
+	store.Set(types.ParamsKey, bz)
 
-	actionID := k.GetNewActionID(ctx)
-	store.Set(ActionStoreKey(epochNumber, actionID), bz)
+	return nil
 }
 
-// RestoreEpochAction restore the actions that need to be executed on next epoch
-func (k Keeper) RestoreEpochAction(ctx sdk.Context, epochNumber int64, action *codectypes.Any) {
+// GetParams returns the current x/epoching module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
 
-	// reference from TestMarshalAny(t *testing.T)
-	bz, err := k.cdc.MarshalInterface(action)
-	if err != nil {
-		panic(err)
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// GetNewActionID returns ID to be used for the next queued action and
+// increments the stored counter.
+func (k Keeper) GetNewActionID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	id := uint64(types.DefaultEpochActionID)
+	if bz := store.Get(types.NextEpochActionID); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
 	}
 
+	// increment next action ID
+	store.Set(types.NextEpochActionID, sdk.Uint64ToBigEndian(id+1))
+
+	return id
+}
+
+// QueueMsgForEpoch saves a msg that needs to be executed at the end of the
+// given epoch and returns its action ID.
+func (k Keeper) QueueMsgForEpoch(ctx sdk.Context, epochNumber int64, msg sdk.Msg) (uint64, error) {
 	actionID := k.GetNewActionID(ctx)
-	store.Set(ActionStoreKey(epochNumber, actionID), bz)
+
+	queued, err := types.NewQueuedMsg(actionID, epochNumber, ctx.BlockHeight(), msg)
+	if err != nil {
+		return 0, err
+	}
+
+	bz, err := k.cdc.Marshal(&queued)
+	if err != nil {
+		return 0, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ActionStoreKey(epochNumber, actionID), bz)
+	k.setEscrow(ctx, actionID, msg)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueueMsg,
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", epochNumber)),
+			sdk.NewAttribute(types.AttributeKeyActionID, fmt.Sprintf("%d", actionID)),
+			sdk.NewAttribute(types.AttributeKeyMsgType, sdk.MsgTypeURL(msg)),
+		),
+	)
+
+	return actionID, nil
 }
 
-// GetEpochMsg gets a msg by ID
-func (k Keeper) GetEpochMsg(ctx sdk.Context, epochNumber int64, actionID uint64) sdk.Msg {
+// GetEpochMsg gets a queued msg by epoch number and action ID.
+func (k Keeper) GetEpochMsg(ctx sdk.Context, epochNumber int64, actionID uint64) (types.QueuedMsg, bool) {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(ActionStoreKey(epochNumber, actionID))
+	bz := store.Get(types.ActionStoreKey(epochNumber, actionID))
 	if bz == nil {
-		return nil
+		return types.QueuedMsg{}, false
 	}
 
-	var action sdk.Msg
-	k.cdc.UnmarshalInterface(bz, &action)
-
-	return action
+	return k.unmarshalQueuedMsg(bz), true
 }
 
-// GetEpochActions get all actions
-func (k Keeper) GetEpochActions(ctx sdk.Context) []sdk.Msg {
-	actions := []sdk.Msg{}
-	iterator := k.GetEpochActionsIterator(ctx)
+// IterateEpochMsgs iterates over the msgs queued for the given epoch in the
+// order in which they were queued. Iteration stops when cb returns true.
+func (k Keeper) IterateEpochMsgs(ctx sdk.Context, epochNumber int64, cb func(queued types.QueuedMsg) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.EpochActionsPrefix(epochNumber))
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		var action sdk.Msg
-		bz := iterator.Value()
-		k.cdc.UnmarshalInterface(bz, &action)
-		actions = append(actions, action)
+		if cb(k.unmarshalQueuedMsg(iterator.Value())) {
+			break
+		}
 	}
-
-	return actions
 }
 
-// GetEpochActionsIterator returns iterator for EpochActions
-func (k Keeper) GetEpochActionsIterator(ctx sdk.Context) db.Iterator {
-	return sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), EpochActionQueuePrefix)
+// GetEpochMsgs returns all the msgs queued for the given epoch.
+func (k Keeper) GetEpochMsgs(ctx sdk.Context, epochNumber int64) []types.QueuedMsg {
+	msgs := []types.QueuedMsg{}
+	k.IterateEpochMsgs(ctx, epochNumber, func(queued types.QueuedMsg) bool {
+		msgs = append(msgs, queued)
+		return false
+	})
+
+	return msgs
 }
 
-// DequeueEpochActions dequeue all the actions store on epoch
-func (k Keeper) DequeueEpochActions(ctx sdk.Context) {
+// GetAllQueuedMsgs returns the msgs queued for all epochs.
+func (k Keeper) GetAllQueuedMsgs(ctx sdk.Context) []types.QueuedMsg {
 	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, EpochActionQueuePrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.EpochActionQueuePrefix)
 	defer iterator.Close()
 
+	msgs := []types.QueuedMsg{}
 	for ; iterator.Valid(); iterator.Next() {
-		key := iterator.Key()
-		store.Delete(key)
+		msgs = append(msgs, k.unmarshalQueuedMsg(iterator.Value()))
 	}
+
+	return msgs
 }
 
-// DeleteByKey delete item by key
-func (k Keeper) DeleteByKey(ctx sdk.Context, key []byte) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(key)
+// DequeueEpochMsgs deletes all the msgs queued for the given epoch, along with
+// the records of their escrowed tokens.
+func (k Keeper) DequeueEpochMsgs(ctx sdk.Context, epochNumber int64) {
+	for _, queued := range k.GetEpochMsgs(ctx, epochNumber) {
+		k.dequeueEpochMsg(ctx, queued)
+	}
 }
 
-// GetEpochActionByIterator get action by iterator
-func (k Keeper) GetEpochActionByIterator(iterator db.Iterator) sdk.Msg {
-	bz := iterator.Value()
+// dequeueEpochMsg deletes a queued msg along with the record of its escrowed
+// tokens.
+func (k Keeper) dequeueEpochMsg(ctx sdk.Context, queued types.QueuedMsg) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ActionStoreKey(queued.EpochNumber, queued.Id))
+	store.Delete(types.EscrowKey(queued.Id))
+}
 
-	var action sdk.Msg
-	k.cdc.UnmarshalInterface(bz, &action)
+// unmarshalQueuedMsg decodes a stored queued msg. A msg which can't be
+// unpacked, e.g. as its type is no longer registered, is returned without its
+// cached value, so that GetMsg fails for it alone instead of the whole queue
+// becoming unreadable.
+func (k Keeper) unmarshalQueuedMsg(bz []byte) types.QueuedMsg {
+	var queued types.QueuedMsg
+	if err := queued.Unmarshal(bz); err != nil {
+		panic(err)
+	}
 
-	return action
+	_ = queued.UnpackInterfaces(k.cdc)
+	return queued
 }
 
 // SetEpochNumber set epoch number
 func (k Keeper) SetEpochNumber(ctx sdk.Context, epochNumber int64) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(EpochNumberID, sdk.Uint64ToBigEndian(uint64(epochNumber)))
+	store.Set(types.EpochNumberID, sdk.Uint64ToBigEndian(uint64(epochNumber)))
 }
 
 // GetEpochNumber fetches epoch number
 func (k Keeper) GetEpochNumber(ctx sdk.Context) int64 {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(EpochNumberID)
+	bz := store.Get(types.EpochNumberID)
 	if bz == nil {
-		return DefaultEpochNumber
+		return types.DefaultEpochNumber
 	}
 
 	return int64(sdk.BigEndianToUint64(bz))
@@ -177,16 +256,38 @@ func (k Keeper) IncreaseEpochNumber(ctx sdk.Context) {
 	k.SetEpochNumber(ctx, epochNumber+1)
 }
 
-// GetNextEpochHeight returns next epoch block height
-func (k Keeper) GetNextEpochHeight(ctx sdk.Context, epochInterval int64) int64 {
-	currentHeight := ctx.BlockHeight()
-	return currentHeight + (epochInterval - currentHeight%epochInterval)
+// SetEpochStartHeight sets the block height at which the current epoch
+// started.
+func (k Keeper) SetEpochStartHeight(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EpochStartHeightID, sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// GetEpochStartHeight returns the block height at which the current epoch
+// started.
+func (k Keeper) GetEpochStartHeight(ctx sdk.Context) int64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.EpochStartHeightID)
+	if bz == nil {
+		return 0
+	}
+
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+// GetEpochEndHeight returns the block height at the end of which the current
+// epoch ends.
+func (k Keeper) GetEpochEndHeight(ctx sdk.Context) int64 {
+	return k.GetEpochStartHeight(ctx) + k.GetParams(ctx).EpochLength - 1
 }
 
-// GetNextEpochTime returns estimated next epoch time
-func (k Keeper) GetNextEpochTime(ctx sdk.Context, epochInterval int64) time.Time {
-	currentTime := ctx.BlockTime()
-	currentHeight := ctx.BlockHeight()
+// GetNextEpochTime returns estimated time at which the current epoch ends.
+func (k Keeper) GetNextEpochTime(ctx sdk.Context) time.Time {
+	remaining := k.GetEpochEndHeight(ctx) - ctx.BlockHeight()
+	if remaining < 0 {
+		remaining = 0
+	}
 
-	return currentTime.Add(k.commitTimeout * time.Duration(k.GetNextEpochHeight(ctx, epochInterval)-currentHeight))
+	return ctx.BlockTime().Add(k.commitTimeout * time.Duration(remaining))
 }
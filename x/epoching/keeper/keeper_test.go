@@ -0,0 +1,232 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	epochingtestutil "github.com/cosmos/cosmos-sdk/x/epoching/testutil"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// stakingMsgServer is a staking MsgServer which records the delegations it
+// executes and fails the ones of more than maxAmount tokens.
+type stakingMsgServer struct {
+	stakingtypes.UnimplementedMsgServer
+
+	maxAmount   sdk.Int
+	delegations []*stakingtypes.MsgDelegate
+}
+
+func (s *stakingMsgServer) Delegate(_ context.Context, msg *stakingtypes.MsgDelegate) (*stakingtypes.MsgDelegateResponse, error) {
+	if msg.Amount.Amount.GT(s.maxAmount) {
+		return nil, stakingtypes.ErrInsufficientShares
+	}
+
+	s.delegations = append(s.delegations, msg)
+	return &stakingtypes.MsgDelegateResponse{}, nil
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx            sdk.Context
+	storeKey       *storetypes.KVStoreKey
+	epochingKeeper *keeper.Keeper
+	stakingKeeper  *epochingtestutil.MockStakingKeeper
+	bankKeeper     *epochingtestutil.MockBankKeeper
+	stakingServer  *stakingMsgServer
+	msgServer      types.MsgServer
+
+	addrs    []sdk.AccAddress
+	valAddrs []sdk.ValAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(epoching.AppModuleBasic{})
+	stakingtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	s.storeKey = key
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, sdk.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithBlockHeight(1).WithBlockTime(time.Unix(1000, 0))
+
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	s.stakingServer = &stakingMsgServer{maxAmount: sdk.NewInt(1000)}
+	stakingtypes.RegisterMsgServer(router, s.stakingServer)
+	router.SetMsgFilter(epoching.RejectUnwrappedStakingMsgs)
+
+	ctrl := gomock.NewController(s.T())
+	s.stakingKeeper = epochingtestutil.NewMockStakingKeeper(ctrl)
+	s.bankKeeper = epochingtestutil.NewMockBankKeeper(ctrl)
+
+	s.epochingKeeper = keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		router,
+		s.stakingKeeper,
+		s.bankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		time.Second,
+	)
+	s.epochingKeeper.InitGenesis(s.ctx, types.DefaultGenesisState())
+
+	s.msgServer = keeper.NewMsgServerImpl(s.epochingKeeper)
+
+	for i := 0; i < 3; i++ {
+		_, _, addr := testdata.KeyTestPubAddr()
+		s.addrs = append(s.addrs, addr)
+		s.valAddrs = append(s.valAddrs, sdk.ValAddress(addr))
+	}
+}
+
+func (s *KeeperTestSuite) TestParams() {
+	s.Require().Equal(types.DefaultParams(), s.epochingKeeper.GetParams(s.ctx))
+
+	s.Require().Error(s.epochingKeeper.SetParams(s.ctx, types.NewParams(0)))
+	s.Require().NoError(s.epochingKeeper.SetParams(s.ctx, types.NewParams(5)))
+	s.Require().Equal(types.NewParams(5), s.epochingKeeper.GetParams(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestQueueMsgForEpoch() {
+	msg1 := stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	msg2 := stakingtypes.NewMsgUndelegate(s.addrs[1], s.valAddrs[1], sdk.NewInt64Coin(sdk.DefaultBondDenom, 20))
+
+	id1, err := s.epochingKeeper.QueueMsgForEpoch(s.ctx, 0, msg1)
+	s.Require().NoError(err)
+	id2, err := s.epochingKeeper.QueueMsgForEpoch(s.ctx, 0, msg2)
+	s.Require().NoError(err)
+	id3, err := s.epochingKeeper.QueueMsgForEpoch(s.ctx, 1, msg1)
+	s.Require().NoError(err)
+
+	s.Require().Equal([]uint64{1, 2, 3}, []uint64{id1, id2, id3})
+
+	queued, found := s.epochingKeeper.GetEpochMsg(s.ctx, 0, id2)
+	s.Require().True(found)
+	s.Require().Equal(int64(0), queued.EpochNumber)
+	s.Require().Equal(int64(1), queued.Height)
+	msg, err := queued.GetMsg()
+	s.Require().NoError(err)
+	s.Require().Equal(msg2, msg)
+
+	_, found = s.epochingKeeper.GetEpochMsg(s.ctx, 1, id2)
+	s.Require().False(found)
+
+	epoch0 := s.epochingKeeper.GetEpochMsgs(s.ctx, 0)
+	s.Require().Len(epoch0, 2)
+	s.Require().Equal(id1, epoch0[0].Id)
+	s.Require().Equal(id2, epoch0[1].Id)
+	s.Require().Len(s.epochingKeeper.GetAllQueuedMsgs(s.ctx), 3)
+
+	s.epochingKeeper.DequeueEpochMsgs(s.ctx, 0)
+	s.Require().Empty(s.epochingKeeper.GetEpochMsgs(s.ctx, 0))
+	s.Require().Len(s.epochingKeeper.GetEpochMsgs(s.ctx, 1), 1)
+}
+
+func (s *KeeperTestSuite) TestEpochBoundaries() {
+	s.Require().Equal(int64(0), s.epochingKeeper.GetEpochNumber(s.ctx))
+	s.Require().Equal(int64(1), s.epochingKeeper.GetEpochStartHeight(s.ctx))
+	s.Require().Equal(types.DefaultEpochLength, s.epochingKeeper.GetEpochEndHeight(s.ctx))
+	s.Require().Equal(
+		s.ctx.BlockTime().Add(time.Duration(types.DefaultEpochLength-1)*time.Second),
+		s.epochingKeeper.GetNextEpochTime(s.ctx),
+	)
+}
+
+func (s *KeeperTestSuite) TestEndBlocker() {
+	ok := stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	failing := stakingtypes.NewMsgDelegate(s.addrs[1], s.valAddrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000))
+
+	_, err := s.epochingKeeper.QueueMsgForEpoch(s.ctx, 0, failing)
+	s.Require().NoError(err)
+	_, err = s.epochingKeeper.QueueMsgForEpoch(s.ctx, 0, ok)
+	s.Require().NoError(err)
+
+	// nothing happens before the last block of the epoch
+	for h := int64(1); h < types.DefaultEpochLength; h++ {
+		epoching.EndBlocker(s.ctx.WithBlockHeight(h), s.epochingKeeper)
+	}
+	s.Require().Empty(s.stakingServer.delegations)
+	s.Require().Equal(int64(0), s.epochingKeeper.GetEpochNumber(s.ctx))
+
+	// the escrowed tokens are returned before every delegation is executed,
+	// in the context of the delegation, and the failing one is refunded again
+	// once its context is discarded; the filter of the router lets the queued
+	// msgs through
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.addrs[1], sdk.NewCoins(failing.Amount)).Return(nil).Times(2)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.addrs[0], sdk.NewCoins(ok.Amount)).Return(nil)
+	ctx := s.ctx.WithBlockHeight(types.DefaultEpochLength).WithEventManager(sdk.NewEventManager())
+	epoching.EndBlocker(ctx, s.epochingKeeper)
+
+	s.Require().Equal([]*stakingtypes.MsgDelegate{ok}, s.stakingServer.delegations)
+	s.Require().Empty(s.epochingKeeper.GetAllQueuedMsgs(ctx))
+	s.Require().Equal(int64(1), s.epochingKeeper.GetEpochNumber(ctx))
+	s.Require().Equal(types.DefaultEpochLength+1, s.epochingKeeper.GetEpochStartHeight(ctx))
+	s.Require().Equal(2*types.DefaultEpochLength, s.epochingKeeper.GetEpochEndHeight(ctx))
+
+	var results []string
+	var epochEvents []string
+	for _, event := range ctx.EventManager().Events() {
+		switch event.Type {
+		case types.EventTypeExecuteMsg:
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == types.AttributeKeyResult {
+					results = append(results, string(attr.Value))
+				}
+			}
+		case types.EventTypeEpochEnd, types.EventTypeEpochStart:
+			epochEvents = append(epochEvents, event.Type)
+		}
+	}
+	s.Require().Equal([]string{types.AttributeValueFailure, types.AttributeValueSuccess}, results)
+	s.Require().Equal([]string{types.EventTypeEpochEnd, types.EventTypeEpochStart}, epochEvents)
+}
+
+func (s *KeeperTestSuite) TestEndBlockerUnpackFailure() {
+	msg := stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	id, err := s.epochingKeeper.QueueMsgForEpoch(s.ctx, 0, msg)
+	s.Require().NoError(err)
+
+	// the type of the queued msg is no longer registered
+	queued, found := s.epochingKeeper.GetEpochMsg(s.ctx, 0, id)
+	s.Require().True(found)
+	queued.Msg = &codectypes.Any{TypeUrl: "/cosmos.unknown.MsgFoo", Value: queued.Msg.Value}
+	bz, err := queued.Marshal()
+	s.Require().NoError(err)
+	store := s.ctx.KVStore(s.storeKey)
+	store.Set(types.ActionStoreKey(0, id), bz)
+
+	queued, found = s.epochingKeeper.GetEpochMsg(s.ctx, 0, id)
+	s.Require().True(found)
+	_, err = queued.GetMsg()
+	s.Require().Error(err)
+
+	// the escrowed tokens are refunded and the msg is dequeued
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.addrs[0], sdk.NewCoins(msg.Amount)).Return(nil)
+	ctx := s.ctx.WithBlockHeight(types.DefaultEpochLength).WithEventManager(sdk.NewEventManager())
+	epoching.EndBlocker(ctx, s.epochingKeeper)
+
+	s.Require().Empty(s.stakingServer.delegations)
+	s.Require().Empty(s.epochingKeeper.GetAllQueuedMsgs(ctx))
+	s.Require().Nil(store.Get(types.EscrowKey(id)))
+}
@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/epoching MsgServer interface.
+func NewMsgServerImpl(k *Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// WrappedDelegate queues a MsgDelegate for execution at the end of the current
+// epoch. The delegated tokens are escrowed in the module account until then.
+func (ms msgServer) WrappedDelegate(goCtx context.Context, msg *types.MsgWrappedDelegate) (*types.MsgWrappedDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.checkValidatorExists(ctx, msg.Msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	if err := ms.checkBondDenom(ctx, msg.Msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := ms.escrow(ctx, msg.Msg); err != nil {
+		return nil, err
+	}
+
+	id, err := ms.QueueMsgForEpoch(ctx, ms.GetEpochNumber(ctx), msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedDelegateResponse{Id: id}, nil
+}
+
+// WrappedUndelegate queues a MsgUndelegate for execution at the end of the
+// current epoch.
+func (ms msgServer) WrappedUndelegate(goCtx context.Context, msg *types.MsgWrappedUndelegate) (*types.MsgWrappedUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.checkDelegationExists(ctx, msg.Msg.DelegatorAddress, msg.Msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	if err := ms.checkBondDenom(ctx, msg.Msg.Amount); err != nil {
+		return nil, err
+	}
+
+	id, err := ms.QueueMsgForEpoch(ctx, ms.GetEpochNumber(ctx), msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedUndelegateResponse{Id: id}, nil
+}
+
+// WrappedBeginRedelegate queues a MsgBeginRedelegate for execution at the end
+// of the current epoch.
+func (ms msgServer) WrappedBeginRedelegate(goCtx context.Context, msg *types.MsgWrappedBeginRedelegate) (*types.MsgWrappedBeginRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.checkDelegationExists(ctx, msg.Msg.DelegatorAddress, msg.Msg.ValidatorSrcAddress); err != nil {
+		return nil, err
+	}
+
+	if err := ms.checkValidatorExists(ctx, msg.Msg.ValidatorDstAddress); err != nil {
+		return nil, err
+	}
+
+	if err := ms.checkBondDenom(ctx, msg.Msg.Amount); err != nil {
+		return nil, err
+	}
+
+	id, err := ms.QueueMsgForEpoch(ctx, ms.GetEpochNumber(ctx), msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedBeginRedelegateResponse{Id: id}, nil
+}
+
+// WrappedCreateValidator queues a MsgCreateValidator for execution at the end
+// of the current epoch. The self-delegated tokens are escrowed in the module
+// account until then.
+func (ms msgServer) WrappedCreateValidator(goCtx context.Context, msg *types.MsgWrappedCreateValidator) (*types.MsgWrappedCreateValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := ms.stakingKeeper.GetValidator(ctx, valAddr); found {
+		return nil, stakingtypes.ErrValidatorOwnerExists
+	}
+
+	if err := ms.checkBondDenom(ctx, msg.Msg.Value); err != nil {
+		return nil, err
+	}
+
+	if err := ms.escrow(ctx, msg.Msg); err != nil {
+		return nil, err
+	}
+
+	id, err := ms.QueueMsgForEpoch(ctx, ms.GetEpochNumber(ctx), msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedCreateValidatorResponse{Id: id}, nil
+}
+
+// UpdateParams updates the x/epoching module parameters.
+func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// checkValidatorExists returns an error if no validator exists for the given
+// operator address.
+func (ms msgServer) checkValidatorExists(ctx sdk.Context, validatorAddress string) error {
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if _, found := ms.stakingKeeper.GetValidator(ctx, valAddr); !found {
+		return stakingtypes.ErrNoValidatorFound
+	}
+
+	return nil
+}
+
+// checkDelegationExists returns an error if the delegator has no delegation
+// to the given validator.
+func (ms msgServer) checkDelegationExists(ctx sdk.Context, delegatorAddress, validatorAddress string) error {
+	delAddr, err := sdk.AccAddressFromBech32(delegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if _, found := ms.stakingKeeper.GetDelegation(ctx, delAddr, valAddr); !found {
+		return stakingtypes.ErrNoDelegation
+	}
+
+	return nil
+}
+
+// checkBondDenom returns an error if the coin is not of the staking bond
+// denomination.
+func (ms msgServer) checkBondDenom(ctx sdk.Context, coin sdk.Coin) error {
+	bondDenom := ms.stakingKeeper.BondDenom(ctx)
+	if coin.Denom != bondDenom {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", coin.Denom, bondDenom,
+		)
+	}
+
+	return nil
+}
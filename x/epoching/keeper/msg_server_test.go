@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestWrappedDelegate() {
+	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+
+	testCases := []struct {
+		name      string
+		msg       *stakingtypes.MsgDelegate
+		malleate  func()
+		expectErr bool
+	}{
+		{
+			name: "validator not found",
+			msg:  stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddrs[0], amount),
+			malleate: func() {
+				s.stakingKeeper.EXPECT().GetValidator(gomock.Any(), s.valAddrs[0]).Return(stakingtypes.Validator{}, false)
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid denom",
+			msg:  stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddrs[0], sdk.NewInt64Coin("foo", 100)),
+			malleate: func() {
+				s.stakingKeeper.EXPECT().GetValidator(gomock.Any(), s.valAddrs[0]).Return(stakingtypes.Validator{}, true)
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom)
+			},
+			expectErr: true,
+		},
+		{
+			name: "insufficient funds",
+			msg:  stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddrs[0], amount),
+			malleate: func() {
+				s.stakingKeeper.EXPECT().GetValidator(gomock.Any(), s.valAddrs[0]).Return(stakingtypes.Validator{}, true)
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom)
+				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), s.addrs[0], types.ModuleName, sdk.NewCoins(amount)).Return(sdkerrors.ErrInsufficientFunds)
+			},
+			expectErr: true,
+		},
+		{
+			name: "success",
+			msg:  stakingtypes.NewMsgDelegate(s.addrs[0], s.valAddrs[0], amount),
+			malleate: func() {
+				s.stakingKeeper.EXPECT().GetValidator(gomock.Any(), s.valAddrs[0]).Return(stakingtypes.Validator{}, true)
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom)
+				// the delegated tokens are escrowed until the end of the epoch
+				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), s.addrs[0], types.ModuleName, sdk.NewCoins(amount)).Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.malleate()
+
+			res, err := s.msgServer.WrappedDelegate(s.ctx, types.NewMsgWrappedDelegate(tc.msg))
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			queued, found := s.epochingKeeper.GetEpochMsg(s.ctx, s.epochingKeeper.GetEpochNumber(s.ctx), res.Id)
+			s.Require().True(found)
+			msg, err := queued.GetMsg()
+			s.Require().NoError(err)
+			s.Require().Equal(tc.msg, msg)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestWrappedUndelegate() {
+	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	msg := stakingtypes.NewMsgUndelegate(s.addrs[0], s.valAddrs[0], amount)
+
+	s.stakingKeeper.EXPECT().GetDelegation(gomock.Any(), s.addrs[0], s.valAddrs[0]).Return(stakingtypes.Delegation{}, false)
+	_, err := s.msgServer.WrappedUndelegate(s.ctx, types.NewMsgWrappedUndelegate(msg))
+	s.Require().ErrorIs(err, stakingtypes.ErrNoDelegation)
+
+	s.stakingKeeper.EXPECT().GetDelegation(gomock.Any(), s.addrs[0], s.valAddrs[0]).Return(stakingtypes.Delegation{}, true)
+	s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom)
+	res, err := s.msgServer.WrappedUndelegate(s.ctx, types.NewMsgWrappedUndelegate(msg))
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), res.Id)
+}
+
+func (s *KeeperTestSuite) TestWrappedBeginRedelegate() {
+	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	msg := stakingtypes.NewMsgBeginRedelegate(s.addrs[0], s.valAddrs[0], s.valAddrs[1], amount)
+
+	s.stakingKeeper.EXPECT().GetDelegation(gomock.Any(), s.addrs[0], s.valAddrs[0]).Return(stakingtypes.Delegation{}, true)
+	s.stakingKeeper.EXPECT().GetValidator(gomock.Any(), s.valAddrs[1]).Return(stakingtypes.Validator{}, false)
+	_, err := s.msgServer.WrappedBeginRedelegate(s.ctx, types.NewMsgWrappedBeginRedelegate(msg))
+	s.Require().ErrorIs(err, stakingtypes.ErrNoValidatorFound)
+
+	s.stakingKeeper.EXPECT().GetDelegation(gomock.Any(), s.addrs[0], s.valAddrs[0]).Return(stakingtypes.Delegation{}, true)
+	s.stakingKeeper.EXPECT().GetValidator(gomock.Any(), s.valAddrs[1]).Return(stakingtypes.Validator{}, true)
+	s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom)
+	_, err = s.msgServer.WrappedBeginRedelegate(s.ctx, types.NewMsgWrappedBeginRedelegate(msg))
+	s.Require().NoError(err)
+	s.Require().Len(s.epochingKeeper.GetEpochMsgs(s.ctx, 0), 1)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Authority: s.addrs[0].String(),
+		Params:    types.NewParams(20),
+	})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Authority: s.epochingKeeper.GetAuthority(),
+		Params:    types.NewParams(20),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(20), s.epochingKeeper.GetParams(s.ctx).EpochLength)
+}
@@ -0,0 +1,29 @@
+package epoching
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// RejectUnwrappedStakingMsgs is a baseapp.MsgFilter rejecting the staking msgs
+// which change the validator set, unless they are queued msgs executed at the
+// end of an epoch. Apps which want all validator set changes to happen at
+// epoch boundaries should set it on their MsgServiceRouter, which also routes
+// the msgs executed through x/authz, x/gov or x/group. The genesis txs are not
+// checked, since the validators of the genesis are created by raw
+// MsgCreateValidator gentxs.
+func RejectUnwrappedStakingMsgs(ctx sdk.Context, msg sdk.Msg) error {
+	if ctx.BlockHeight() == 0 || types.IsQueuedMsgExecution(ctx) {
+		return nil
+	}
+
+	switch msg.(type) {
+	case *stakingtypes.MsgDelegate, *stakingtypes.MsgUndelegate,
+		*stakingtypes.MsgBeginRedelegate, *stakingtypes.MsgCreateValidator:
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s must be wrapped in an epoching msg", sdk.MsgTypeURL(msg))
+	default:
+		return nil
+	}
+}
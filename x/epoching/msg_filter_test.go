@@ -0,0 +1,45 @@
+package epoching_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestRejectUnwrappedStakingMsgs(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	delegate := stakingtypes.NewMsgDelegate(addr, sdk.ValAddress(addr), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+	ctx := sdk.Context{}.WithContext(context.Background()).WithBlockHeight(1)
+
+	testCases := []struct {
+		name      string
+		ctx       sdk.Context
+		msg       sdk.Msg
+		expectErr bool
+	}{
+		{"wrapped msg", ctx, types.NewMsgWrappedDelegate(delegate), false},
+		{"non staking msg", ctx, testdata.NewTestMsg(addr), false},
+		{"unwrapped msg", ctx, delegate, true},
+		{"queued msg executed at the end of the epoch", types.WithQueuedMsgExecution(ctx), delegate, false},
+		// the gentxs create the genesis validators with raw staking msgs
+		{"genesis msg", sdk.Context{}.WithBlockHeight(0), delegate, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := epoching.RejectUnwrappedStakingMsgs(tc.ctx, tc.msg)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
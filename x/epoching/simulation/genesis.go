@@ -0,0 +1,39 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// Simulation parameter constants
+const EpochLength = "epoch_length"
+
+// GenEpochLength randomized EpochLength
+func GenEpochLength(r *rand.Rand) int64 {
+	return int64(r.Intn(20) + 1)
+}
+
+// RandomizedGenState generates a random GenesisState for epoching.
+func RandomizedGenState(simState *module.SimulationState) {
+	var epochLength int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EpochLength, &epochLength, simState.Rand,
+		func(r *rand.Rand) { epochLength = GenEpochLength(r) },
+	)
+
+	epochingGenesis := types.DefaultGenesisState()
+	epochingGenesis.Params = types.NewParams(epochLength)
+
+	bz, err := json.MarshalIndent(&epochingGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated epoching parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(epochingGenesis)
+}
@@ -0,0 +1,361 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec // these are not hardcoded credentials
+const (
+	OpWeightMsgWrappedCreateValidator = "op_weight_msg_wrapped_create_validator"
+	OpWeightMsgWrappedDelegate        = "op_weight_msg_wrapped_delegate"
+	OpWeightMsgWrappedUndelegate      = "op_weight_msg_wrapped_undelegate"
+	OpWeightMsgWrappedBeginRedelegate = "op_weight_msg_wrapped_begin_redelegate"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper,
+	bk types.BankKeeper, sk types.StakingKeeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgWrappedCreateValidator int
+		weightMsgWrappedDelegate        int
+		weightMsgWrappedUndelegate      int
+		weightMsgWrappedBeginRedelegate int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgWrappedCreateValidator, &weightMsgWrappedCreateValidator, nil,
+		func(_ *rand.Rand) {
+			weightMsgWrappedCreateValidator = simappparams.DefaultWeightMsgCreateValidator
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgWrappedDelegate, &weightMsgWrappedDelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgWrappedDelegate = simappparams.DefaultWeightMsgDelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgWrappedUndelegate, &weightMsgWrappedUndelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgWrappedUndelegate = simappparams.DefaultWeightMsgUndelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgWrappedBeginRedelegate, &weightMsgWrappedBeginRedelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgWrappedBeginRedelegate = simappparams.DefaultWeightMsgBeginRedelegate
+		},
+	)
+
+	stakingKeeper := sk.(*stakingkeeper.Keeper)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgWrappedCreateValidator,
+			SimulateMsgWrappedCreateValidator(ak, bk, stakingKeeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgWrappedDelegate,
+			SimulateMsgWrappedDelegate(ak, bk, stakingKeeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgWrappedUndelegate,
+			SimulateMsgWrappedUndelegate(ak, bk, stakingKeeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgWrappedBeginRedelegate,
+			SimulateMsgWrappedBeginRedelegate(ak, bk, stakingKeeper),
+		),
+	}
+}
+
+// SimulateMsgWrappedCreateValidator generates a MsgWrappedCreateValidator with
+// random values
+func SimulateMsgWrappedCreateValidator(ak types.AccountKeeper, bk types.BankKeeper, sk *stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		address := sdk.ValAddress(simAccount.Address)
+
+		// ensure the validator doesn't exist already
+		if _, found := sk.GetValidator(ctx, address); found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedCreateValidator, "validator already exists"), nil, nil
+		}
+
+		denom := sk.BondDenom(ctx)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		// the msg server requires the self delegation to be spendable
+		balance := spendable.AmountOf(denom)
+		if !balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedCreateValidator, "balance is negative"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedCreateValidator, "unable to generate positive amount"), nil, err
+		}
+
+		selfDelegation := sdk.NewCoin(denom, amount)
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable.Sub(selfDelegation))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedCreateValidator, "unable to generate fees"), nil, err
+		}
+
+		description := stakingtypes.NewDescription(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 10),
+		)
+
+		maxCommission := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 100)), 2)
+		commission := stakingtypes.NewCommissionRates(
+			simtypes.RandomDecAmount(r, maxCommission),
+			maxCommission,
+			simtypes.RandomDecAmount(r, maxCommission),
+		)
+
+		createValidator, err := stakingtypes.NewMsgCreateValidator(address, simAccount.ConsKey.PubKey(), selfDelegation, description, commission, sdk.OneInt())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedCreateValidator, "unable to create CreateValidator message"), nil, err
+		}
+
+		msg := types.NewMsgWrappedCreateValidator(createValidator)
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:           nil,
+			Msg:           msg,
+			MsgType:       msg.Type(),
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			ModuleName:    types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTx(txCtx, fees)
+	}
+}
+
+// SimulateMsgWrappedDelegate generates a MsgWrappedDelegate with random values
+func SimulateMsgWrappedDelegate(ak types.AccountKeeper, bk types.BankKeeper, sk *stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom := sk.BondDenom(ctx)
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		val, ok := stakingkeeper.RandomValidator(r, sk, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedDelegate, "unable to pick a validator"), nil, nil
+		}
+
+		if val.InvalidExRate() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedDelegate, "validator's invalid echange rate"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		// the msg server requires the delegated amount to be spendable
+		amount := spendable.AmountOf(denom)
+		if !amount.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedDelegate, "balance is negative"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedDelegate, "unable to generate positive amount"), nil, err
+		}
+
+		bondAmt := sdk.NewCoin(denom, amount)
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable.Sub(bondAmt))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedDelegate, "unable to generate fees"), nil, err
+		}
+
+		msg := types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(simAccount.Address, val.GetOperator(), bondAmt))
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:           nil,
+			Msg:           msg,
+			MsgType:       msg.Type(),
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			ModuleName:    types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTx(txCtx, fees)
+	}
+}
+
+// SimulateMsgWrappedUndelegate generates a MsgWrappedUndelegate with random
+// values
+func SimulateMsgWrappedUndelegate(ak types.AccountKeeper, bk types.BankKeeper, sk *stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		validator, ok := stakingkeeper.RandomValidator(r, sk, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedUndelegate, "validator is not ok"), nil, nil
+		}
+
+		valAddr := validator.GetOperator()
+		delegations := sk.GetValidatorDelegations(ctx, valAddr)
+		if delegations == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedUndelegate, "keeper does have any delegation entries"), nil, nil
+		}
+
+		// get random delegator from validator
+		delegation := delegations[r.Intn(len(delegations))]
+		delAddr := delegation.GetDelegatorAddr()
+
+		if sk.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedUndelegate, "keeper does have a max unbonding delegation entries"), nil, nil
+		}
+
+		totalBond := validator.TokensFromShares(delegation.GetShares()).TruncateInt()
+		if !totalBond.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedUndelegate, "total bond is negative"), nil, nil
+		}
+
+		unbondAmt, err := simtypes.RandPositiveInt(r, totalBond)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedUndelegate, "invalid unbond amount"), nil, err
+		}
+
+		msg := types.NewMsgWrappedUndelegate(stakingtypes.NewMsgUndelegate(
+			delAddr, valAddr, sdk.NewCoin(sk.BondDenom(ctx), unbondAmt),
+		))
+
+		// need to retrieve the simulation account associated with delegation to retrieve PrivKey
+		simAccount, found := simtypes.FindAccount(accs, delAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "account private key is nil"), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
+		}
+
+		account := ak.GetAccount(ctx, delAddr)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgWrappedBeginRedelegate generates a MsgWrappedBeginRedelegate with
+// random values
+func SimulateMsgWrappedBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, sk *stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		srcVal, ok := stakingkeeper.RandomValidator(r, sk, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedBeginRedelegate, "unable to pick validator"), nil, nil
+		}
+
+		srcAddr := srcVal.GetOperator()
+		delegations := sk.GetValidatorDelegations(ctx, srcAddr)
+		if delegations == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedBeginRedelegate, "keeper does have any delegation entries"), nil, nil
+		}
+
+		// get random delegator from src validator
+		delegation := delegations[r.Intn(len(delegations))]
+		delAddr := delegation.GetDelegatorAddr()
+
+		if sk.HasReceivingRedelegation(ctx, delAddr, srcAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedBeginRedelegate, "receveing redelegation is not allowed"), nil, nil // skip
+		}
+
+		destVal, ok := stakingkeeper.RandomValidator(r, sk, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedBeginRedelegate, "unable to pick validator"), nil, nil
+		}
+
+		destAddr := destVal.GetOperator()
+		if srcAddr.Equals(destAddr) || destVal.InvalidExRate() || sk.HasMaxRedelegationEntries(ctx, delAddr, srcAddr, destAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedBeginRedelegate, "checks failed"), nil, nil
+		}
+
+		totalBond := srcVal.TokensFromShares(delegation.GetShares()).TruncateInt()
+		if !totalBond.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedBeginRedelegate, "total bond is negative"), nil, nil
+		}
+
+		redAmt, err := simtypes.RandPositiveInt(r, totalBond)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWrappedBeginRedelegate, "unable to generate positive amount"), nil, err
+		}
+
+		msg := types.NewMsgWrappedBeginRedelegate(stakingtypes.NewMsgBeginRedelegate(
+			delAddr, srcAddr, destAddr, sdk.NewCoin(sk.BondDenom(ctx), redAmt),
+		))
+
+		// need to retrieve the simulation account associated with delegation to retrieve PrivKey
+		simAccount, found := simtypes.FindAccount(accs, delAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "account private key is nil"), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
+		}
+
+		account := ak.GetAccount(ctx, delAddr)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	stakingtypes.RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+
+	// Register all Amino interfaces and concrete types on the authz Amino codec
+	// so that this can later be used to properly serialize MsgGrant and MsgExec
+	// instances.
+	RegisterLegacyAminoCodec(authzcodec.Amino)
+}
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/epoching/Params", nil)
+	legacy.RegisterAminoMsg(cdc, &MsgWrappedDelegate{}, "cosmos-sdk/MsgWrappedDelegate")
+	legacy.RegisterAminoMsg(cdc, &MsgWrappedUndelegate{}, "cosmos-sdk/MsgWrappedUndelegate")
+	legacy.RegisterAminoMsg(cdc, &MsgWrappedBeginRedelegate{}, "cosmos-sdk/MsgWrappedBeginRedelegate")
+	legacy.RegisterAminoMsg(cdc, &MsgWrappedCreateValidator{}, "cosmos-sdk/MsgWrappedCreateValidator")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/epoching/MsgUpdateParams")
+}
+
+// RegisterInterfaces registers the x/epoching interfaces types with the interface registry
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgWrappedDelegate{},
+		&MsgWrappedUndelegate{},
+		&MsgWrappedBeginRedelegate{},
+		&MsgWrappedCreateValidator{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
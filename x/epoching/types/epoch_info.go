@@ -0,0 +1,56 @@
+package types
+
+import (
+	"strings"
+	"time"
+)
+
+// Identifiers of the default epochs.
+const (
+	DayEpochIdentifier  = "day"
+	WeekEpochIdentifier = "week"
+)
+
+// NewEpochInfo creates a new EpochInfo which starts counting at startTime. A
+// zero startTime makes the epoch start at the genesis time.
+func NewEpochInfo(identifier string, startTime time.Time, duration time.Duration) EpochInfo {
+	return EpochInfo{
+		Identifier: identifier,
+		StartTime:  startTime,
+		Duration:   duration,
+	}
+}
+
+// DefaultEpochInfos returns the default daily and weekly epochs.
+func DefaultEpochInfos() []EpochInfo {
+	return []EpochInfo{
+		NewEpochInfo(DayEpochIdentifier, time.Time{}, 24*time.Hour),
+		NewEpochInfo(WeekEpochIdentifier, time.Time{}, 7*24*time.Hour),
+	}
+}
+
+// Validate performs a stateless validation of the epoch info.
+func (info EpochInfo) Validate() error {
+	if strings.TrimSpace(info.Identifier) == "" {
+		return ErrInvalidEpochInfo.Wrap("epoch identifier cannot be blank")
+	}
+
+	if info.Duration <= 0 {
+		return ErrInvalidEpochInfo.Wrapf("epoch %s: duration must be positive: %s", info.Identifier, info.Duration)
+	}
+
+	if info.CurrentEpoch < 0 {
+		return ErrInvalidEpochInfo.Wrapf("epoch %s: current epoch cannot be negative: %d", info.Identifier, info.CurrentEpoch)
+	}
+
+	if info.CurrentEpochStartHeight < 0 {
+		return ErrInvalidEpochInfo.Wrapf("epoch %s: current epoch start height cannot be negative: %d", info.Identifier, info.CurrentEpochStartHeight)
+	}
+
+	return nil
+}
+
+// EndTime returns the time at which the current epoch ends.
+func (info EpochInfo) EndTime() time.Time {
+	return info.CurrentEpochStartTime.Add(info.Duration)
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/epoching module sentinel errors
+var (
+	ErrInvalidEpochLength = sdkerrors.Register(ModuleName, 2, "invalid epoch length")
+	ErrInvalidQueuedMsg   = sdkerrors.Register(ModuleName, 3, "invalid queued message")
+	ErrInvalidEpochInfo   = sdkerrors.Register(ModuleName, 4, "invalid epoch info")
+	ErrEpochInfoNotFound  = sdkerrors.Register(ModuleName, 5, "epoch info not found")
+	ErrEpochInfoExists    = sdkerrors.Register(ModuleName, 6, "epoch info already exists")
+)
@@ -0,0 +1,21 @@
+package types
+
+// epoching module event types
+const (
+	EventTypeQueueMsg   = "queue_msg"
+	EventTypeExecuteMsg = "execute_queued_msg"
+	EventTypeEpochEnd   = "epoch_end"
+	EventTypeEpochStart = "epoch_start"
+
+	AttributeKeyEpochNumber = "epoch_number"
+	AttributeKeyIdentifier  = "identifier"
+	AttributeKeyStartTime   = "start_time"
+	AttributeKeyHeight      = "height"
+	AttributeKeyActionID    = "action_id"
+	AttributeKeyMsgType     = "msg_type"
+	AttributeKeyResult      = "result"
+	AttributeKeyError       = "error"
+
+	AttributeValueSuccess = "success"
+	AttributeValueFailure = "failure"
+)
@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingKeeper defines the expected staking keeper
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	GetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (delegation stakingtypes.Delegation, found bool)
+}
+
+// AccountKeeper defines the expected account keeper (noalias)
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI // only used for simulation
+}
+
+// BankKeeper defines the expected interface needed to escrow the tokens of
+// queued delegations.
+type BankKeeper interface {
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins // only used for simulation
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// EpochHooks event hooks for named epochs (noalias)
+type EpochHooks interface {
+	AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error    // Must be called when an epoch ends
+	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error // Must be called when an epoch starts
+}
+
+// EpochHooksWrapper is a wrapper for modules to inject EpochHooks using depinject.
+type EpochHooksWrapper struct{ EpochHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (EpochHooksWrapper) IsOnePerModuleType() {}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new genesis state for the epoching module.
+func NewGenesisState(params Params, epochNumber, epochStartHeight int64, queuedMsgs []*codectypes.Any, epochs []EpochInfo) *GenesisState {
+	return &GenesisState{
+		Params:           params,
+		EpochNumber:      epochNumber,
+		EpochStartHeight: epochStartHeight,
+		QueuedMsgs:       queuedMsgs,
+		Epochs:           epochs,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), DefaultEpochNumber, 0, nil, DefaultEpochInfos())
+}
+
+// ValidateGenesis validates the epoching genesis parameters.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if data.EpochNumber < 0 {
+		return fmt.Errorf("epoch number cannot be negative: %d", data.EpochNumber)
+	}
+
+	if data.EpochStartHeight < 0 {
+		return fmt.Errorf("epoch start height cannot be negative: %d", data.EpochStartHeight)
+	}
+
+	for i, any := range data.QueuedMsgs {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return ErrInvalidQueuedMsg.Wrapf("queued message %d is not a sdk.Msg", i)
+		}
+
+		if err := msg.ValidateBasic(); err != nil {
+			return ErrInvalidQueuedMsg.Wrapf("queued message %d: %s", i, err)
+		}
+	}
+
+	identifiers := make(map[string]bool, len(data.Epochs))
+	for _, info := range data.Epochs {
+		if identifiers[info.Identifier] {
+			return ErrInvalidEpochInfo.Wrapf("duplicate epoch identifier: %s", info.Identifier)
+		}
+		identifiers[info.Identifier] = true
+
+		if err := info.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, data.QueuedMsgs)
+}
@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	valid, err := codectypes.NewAnyWithValue(stakingtypes.NewMsgDelegate(addr, sdk.ValAddress(addr), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	require.NoError(t, err)
+	invalid, err := codectypes.NewAnyWithValue(stakingtypes.NewMsgDelegate(addr, sdk.ValAddress(addr), sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		genState  *types.GenesisState
+		expectErr bool
+	}{
+		{"default", types.DefaultGenesisState(), false},
+		{"with queued msg", types.NewGenesisState(types.DefaultParams(), 1, 10, []*codectypes.Any{valid}, nil), false},
+		{"invalid epoch length", types.NewGenesisState(types.NewParams(0), 0, 0, nil, nil), true},
+		{"negative epoch number", types.NewGenesisState(types.DefaultParams(), -1, 0, nil, nil), true},
+		{"negative start height", types.NewGenesisState(types.DefaultParams(), 0, -1, nil, nil), true},
+		{"with epochs", types.NewGenesisState(types.DefaultParams(), 0, 0, nil, types.DefaultEpochInfos()), false},
+		{
+			"duplicate epoch identifier",
+			types.NewGenesisState(types.DefaultParams(), 0, 0, nil, []types.EpochInfo{
+				types.NewEpochInfo("day", time.Time{}, time.Hour),
+				types.NewEpochInfo("day", time.Time{}, time.Minute),
+			}),
+			true,
+		},
+		{"blank epoch identifier", types.NewGenesisState(types.DefaultParams(), 0, 0, nil, []types.EpochInfo{types.NewEpochInfo(" ", time.Time{}, time.Hour)}), true},
+		{"zero epoch duration", types.NewGenesisState(types.DefaultParams(), 0, 0, nil, []types.EpochInfo{types.NewEpochInfo("day", time.Time{}, 0)}), true},
+		{"invalid queued msg", types.NewGenesisState(types.DefaultParams(), 0, 0, []*codectypes.Any{invalid}, nil), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateGenesis(*tc.genState)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ EpochHooks = MultiEpochHooks{}
+
+// combine multiple epoch hooks, all hook functions are run in array sequence
+type MultiEpochHooks []EpochHooks
+
+func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
+	return hooks
+}
+
+// AfterEpochEnd runs the AfterEpochEnd hook of every hook in sequence and
+// stops at the first error.
+func (h MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	for i := range h {
+		if err := h[i].AfterEpochEnd(ctx, epochIdentifier, epochNumber); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BeforeEpochStart runs the BeforeEpochStart hook of every hook in sequence
+// and stops at the first error.
+func (h MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	for i := range h {
+		if err := h[i].BeforeEpochStart(ctx, epochIdentifier, epochNumber); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "epoching"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+)
+
+const (
+	DefaultEpochActionID = 1
+	DefaultEpochNumber   = 0
+)
+
+var (
+	NextEpochActionID      = []byte{0x11}
+	EpochNumberID          = []byte{0x12}
+	EpochActionQueuePrefix = []byte{0x13} // prefix for the epoch
+	ParamsKey              = []byte{0x14}
+	EpochStartHeightID     = []byte{0x15}
+	EpochInfoPrefix        = []byte{0x16} // prefix for the named epochs
+	EscrowPrefix           = []byte{0x17} // prefix for the tokens escrowed for the queued msgs
+)
+
+// EpochActionsPrefix returns the prefix under which the actions queued for
+// the given epoch are stored.
+func EpochActionsPrefix(epochNumber int64) []byte {
+	return append(append([]byte{}, EpochActionQueuePrefix...), sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+}
+
+// ActionStoreKey returns action store key from ID
+func ActionStoreKey(epochNumber int64, actionID uint64) []byte {
+	return append(EpochActionsPrefix(epochNumber), sdk.Uint64ToBigEndian(actionID)...)
+}
+
+// EpochInfoKey returns the store key of the named epoch with the given
+// identifier.
+func EpochInfoKey(identifier string) []byte {
+	return append(append([]byte{}, EpochInfoPrefix...), []byte(identifier)...)
+}
+
+// EscrowKey returns the store key of the tokens escrowed for the queued msg
+// with the given action ID.
+func EscrowKey(actionID uint64) []byte {
+	return append(append([]byte{}, EscrowPrefix...), sdk.Uint64ToBigEndian(actionID)...)
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// KVSchema returns the key/value schema of the epoching store.
+func KVSchema() []schema.Entry {
+	return []schema.Entry{
+		{Name: "next_action_id", Prefix: NextEpochActionID, Value: schema.Uint64Value},
+		{Name: "epoch_number", Prefix: EpochNumberID, Value: schema.Uint64Value},
+		{
+			Name:   "queued_msg",
+			Prefix: EpochActionQueuePrefix,
+			Key:    []schema.KeyField{schema.Field("epoch_number", schema.Uint64Key), schema.Field("action_id", schema.Uint64Key)},
+			Value:  schema.ProtoValue(&QueuedMsg{}),
+		},
+		{Name: "params", Prefix: ParamsKey, Value: schema.ProtoValue(&Params{})},
+		{Name: "epoch_start_height", Prefix: EpochStartHeightID, Value: schema.Uint64Value},
+		{
+			Name:   "epoch_info",
+			Prefix: EpochInfoPrefix,
+			Key:    []schema.KeyField{schema.Field("identifier", schema.StringKey)},
+			Value:  schema.ProtoValue(&EpochInfo{}),
+		},
+		{
+			Name:   "escrow",
+			Prefix: EscrowPrefix,
+			Key:    []schema.KeyField{schema.Field("action_id", schema.Uint64Key)},
+			Value:  schema.ProtoValue(&banktypes.Balance{}),
+		},
+	}
+}
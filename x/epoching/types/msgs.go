@@ -0,0 +1,196 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// epoching message types
+const (
+	TypeMsgWrappedDelegate        = "wrapped_delegate"
+	TypeMsgWrappedUndelegate      = "wrapped_begin_unbonding"
+	TypeMsgWrappedBeginRedelegate = "wrapped_begin_redelegate"
+	TypeMsgWrappedCreateValidator = "wrapped_create_validator"
+)
+
+var (
+	_ sdk.Msg            = &MsgWrappedDelegate{}
+	_ sdk.Msg            = &MsgWrappedUndelegate{}
+	_ sdk.Msg            = &MsgWrappedBeginRedelegate{}
+	_ sdk.Msg            = &MsgWrappedCreateValidator{}
+	_ sdk.Msg            = &MsgUpdateParams{}
+	_ legacytx.LegacyMsg = &MsgWrappedDelegate{}
+	_ legacytx.LegacyMsg = &MsgWrappedUndelegate{}
+	_ legacytx.LegacyMsg = &MsgWrappedBeginRedelegate{}
+	_ legacytx.LegacyMsg = &MsgWrappedCreateValidator{}
+
+	_ codectypes.UnpackInterfacesMessage = (*MsgWrappedCreateValidator)(nil)
+)
+
+// NewMsgWrappedDelegate creates a new MsgWrappedDelegate instance.
+func NewMsgWrappedDelegate(msg *stakingtypes.MsgDelegate) *MsgWrappedDelegate {
+	return &MsgWrappedDelegate{Msg: msg}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgWrappedDelegate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgWrappedDelegate) Type() string { return TypeMsgWrappedDelegate }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgWrappedDelegate) GetSigners() []sdk.AccAddress {
+	if msg.Msg == nil {
+		return nil
+	}
+
+	return msg.Msg.GetSigners()
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgWrappedDelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedDelegate) ValidateBasic() error {
+	if msg.Msg == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty wrapped msg")
+	}
+
+	return msg.Msg.ValidateBasic()
+}
+
+// NewMsgWrappedUndelegate creates a new MsgWrappedUndelegate instance.
+func NewMsgWrappedUndelegate(msg *stakingtypes.MsgUndelegate) *MsgWrappedUndelegate {
+	return &MsgWrappedUndelegate{Msg: msg}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgWrappedUndelegate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgWrappedUndelegate) Type() string { return TypeMsgWrappedUndelegate }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgWrappedUndelegate) GetSigners() []sdk.AccAddress {
+	if msg.Msg == nil {
+		return nil
+	}
+
+	return msg.Msg.GetSigners()
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgWrappedUndelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedUndelegate) ValidateBasic() error {
+	if msg.Msg == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty wrapped msg")
+	}
+
+	return msg.Msg.ValidateBasic()
+}
+
+// NewMsgWrappedBeginRedelegate creates a new MsgWrappedBeginRedelegate instance.
+func NewMsgWrappedBeginRedelegate(msg *stakingtypes.MsgBeginRedelegate) *MsgWrappedBeginRedelegate {
+	return &MsgWrappedBeginRedelegate{Msg: msg}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgWrappedBeginRedelegate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgWrappedBeginRedelegate) Type() string { return TypeMsgWrappedBeginRedelegate }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgWrappedBeginRedelegate) GetSigners() []sdk.AccAddress {
+	if msg.Msg == nil {
+		return nil
+	}
+
+	return msg.Msg.GetSigners()
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgWrappedBeginRedelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedBeginRedelegate) ValidateBasic() error {
+	if msg.Msg == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty wrapped msg")
+	}
+
+	return msg.Msg.ValidateBasic()
+}
+
+// NewMsgWrappedCreateValidator creates a new MsgWrappedCreateValidator instance.
+func NewMsgWrappedCreateValidator(msg *stakingtypes.MsgCreateValidator) *MsgWrappedCreateValidator {
+	return &MsgWrappedCreateValidator{Msg: msg}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgWrappedCreateValidator) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgWrappedCreateValidator) Type() string { return TypeMsgWrappedCreateValidator }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgWrappedCreateValidator) GetSigners() []sdk.AccAddress {
+	if msg.Msg == nil {
+		return nil
+	}
+
+	return msg.Msg.GetSigners()
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgWrappedCreateValidator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedCreateValidator) ValidateBasic() error {
+	if msg.Msg == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty wrapped msg")
+	}
+
+	return msg.Msg.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgWrappedCreateValidator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if msg.Msg == nil {
+		return nil
+	}
+
+	return msg.Msg.UnpackInterfaces(unpacker)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultEpochLength is the default number of blocks in an epoch.
+const DefaultEpochLength int64 = 10
+
+// NewParams creates a new Params instance.
+func NewParams(epochLength int64) Params {
+	return Params{
+		EpochLength: epochLength,
+	}
+}
+
+// DefaultParams returns default x/epoching module parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultEpochLength)
+}
+
+// Validate performs basic validation of the epoching parameters.
+func (p Params) Validate() error {
+	if p.EpochLength <= 0 {
+		return ErrInvalidEpochLength.Wrap(fmt.Sprintf("epoch length must be positive: %d", p.EpochLength))
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = QueuedMsg{}
+
+// NewQueuedMsg creates a new QueuedMsg instance.
+func NewQueuedMsg(id uint64, epochNumber, height int64, msg sdk.Msg) (QueuedMsg, error) {
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return QueuedMsg{}, err
+	}
+
+	return QueuedMsg{
+		Id:          id,
+		EpochNumber: epochNumber,
+		Height:      height,
+		Msg:         any,
+	}, nil
+}
+
+// GetMsg returns the queued sdk.Msg.
+func (m QueuedMsg) GetMsg() (sdk.Msg, error) {
+	msg, ok := m.Msg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", (sdk.Msg)(nil), m.Msg.GetCachedValue())
+	}
+
+	return msg, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueuedMsg) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(m.Msg, &msg)
+}
+
+// queuedMsgExecutionKey is the context key marking the execution of the
+// queued msgs at the end of an epoch.
+type queuedMsgExecutionKey struct{}
+
+// WithQueuedMsgExecution returns a context marking the msgs handled with it as
+// queued msgs executed at the end of an epoch.
+func WithQueuedMsgExecution(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(queuedMsgExecutionKey{}, true)
+}
+
+// IsQueuedMsgExecution returns whether the msgs handled with the context are
+// queued msgs executed at the end of an epoch.
+func IsQueuedMsgExecution(ctx sdk.Context) bool {
+	executing, _ := ctx.Value(queuedMsgExecutionKey{}).(bool)
+	return executing
+}
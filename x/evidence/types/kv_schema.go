@@ -0,0 +1,18 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+)
+
+// KVSchema returns the key/value schema of the evidence store.
+func KVSchema() []schema.Entry {
+	return []schema.Entry{
+		{
+			Name:   "evidence",
+			Prefix: KeyPrefixEvidence,
+			Key:    []schema.KeyField{schema.Field("hash", schema.BytesKey)},
+			Value:  schema.ProtoValue(&codectypes.Any{}),
+		},
+	}
+}
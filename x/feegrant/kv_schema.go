@@ -0,0 +1,32 @@
+package feegrant
+
+import (
+	time "time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+)
+
+// KVSchema returns the key/value schema of the feegrant store.
+func KVSchema() []schema.Entry {
+	grantee := schema.Field("grantee", schema.LengthPrefixed(schema.AccAddressKey))
+	granter := schema.Field("granter", schema.LengthPrefixed(schema.AccAddressKey))
+	return []schema.Entry{
+		{
+			Name:   "allowance",
+			Prefix: FeeAllowanceKeyPrefix,
+			Key:    []schema.KeyField{grantee, granter},
+			Value:  schema.ProtoValue(&Grant{}),
+		},
+		{
+			Name:   "allowance_queue",
+			Prefix: FeeAllowanceQueueKeyPrefix,
+			Key: []schema.KeyField{
+				schema.Field("expiration", schema.Fixed(len(sdk.FormatTimeBytes(time.Time{})), schema.TimeKey)),
+				grantee,
+				granter,
+			},
+			Value: schema.BytesValue,
+		},
+	}
+}
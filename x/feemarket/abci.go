@@ -0,0 +1,25 @@
+package feemarket
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// EndBlocker burns the base fees of the block and adjusts the base fee of the
+// next block according to the gas used by the block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	var gasUsed uint64
+	if gasMeter := ctx.BlockGasMeter(); gasMeter != nil {
+		gasUsed = gasMeter.GasConsumedToLimit()
+	}
+
+	if err := k.UpdateBaseFee(ctx, gasUsed); err != nil {
+		panic(err)
+	}
+}
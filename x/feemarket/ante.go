@@ -0,0 +1,75 @@
+package feemarket
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+)
+
+// priorityScale is the number of priority units per unit of the fee denom paid
+// per unit of gas, so that the tips below one unit per gas are still ordered.
+const priorityScale = 1_000_000
+
+// NewTxFeeChecker returns an ante.TxFeeChecker enforcing the base fee in
+// consensus: the value of the fee of a tx, with the amounts in the accepted fee
+// denoms converted to the fee denom, must be at least the base fee times its
+// gas limit, and the fees in the other denoms are rejected. During CheckTx, the
+// minimum gas price of the validator for the fee denom applies instead if it is
+// higher. The tx priority is the tip per unit of gas, i.e. the gas price paid
+// above the base fee, in millionths of the fee denom. When the fee
+// market is disabled, and for the genesis txs, the fees are checked by
+// ante.CheckTxFeeWithValidatorMinGasPrices.
+func NewTxFeeChecker(k keeper.Keeper) ante.TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		params := k.GetParams(ctx)
+		if !params.Enabled || ctx.BlockHeight() == 0 {
+			return ante.CheckTxFeeWithValidatorMinGasPrices(ctx, tx)
+		}
+
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+
+		fee := feeTx.GetFee()
+		gas := sdk.NewDecFromInt(sdk.NewIntFromUint64(feeTx.GetGas()))
+
+		baseFee := k.RequiredGasPrice(ctx, params)
+		gasPrice := baseFee
+		if ctx.IsCheckTx() {
+			if minGasPrice := ctx.MinGasPrices().AmountOf(params.FeeDenom); minGasPrice.GT(gasPrice) {
+				gasPrice = minGasPrice
+			}
+		}
+
+		// the fees paid in the accepted fee denoms are converted to the fee denom
+		paid, err := k.FeeValue(ctx, params, fee)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		// fee = ceil(gasPrice * gasLimit)
+		required := sdk.NewCoin(params.FeeDenom, gasPrice.Mul(gas).Ceil().TruncateInt())
+		if paid.LT(sdk.NewDecFromInt(required.Amount)) {
+			return nil, 0, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", fee, required)
+		}
+
+		// the txs are ordered by the price they pay per unit of gas, so that large
+		// txs paying a low price do not outrank small txs paying a higher one
+		priority := int64(0)
+		if gas.IsPositive() {
+			tipPerGas := paid.Quo(gas).Sub(baseFee).MulInt64(priorityScale).TruncateInt()
+			if tipPerGas.IsPositive() {
+				priority = int64(math.MaxInt64)
+				if tipPerGas.IsInt64() {
+					priority = tipPerGas.Int64()
+				}
+			}
+		}
+
+		return fee, priority, nil
+	}
+}
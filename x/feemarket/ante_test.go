@@ -0,0 +1,220 @@
+package feemarket_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	feemarkettestutil "github.com/cosmos/cosmos-sdk/x/feemarket/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// feeTx is a minimal sdk.FeeTx carrying a fee and a gas limit.
+type feeTx struct {
+	sdk.FeeTx
+	fee sdk.Coins
+	gas uint64
+}
+
+func (tx feeTx) GetFee() sdk.Coins { return tx.fee }
+func (tx feeTx) GetGas() uint64    { return tx.gas }
+
+func setupKeeper(t *testing.T, oracle types.ConversionRateOracle) (sdk.Context, keeper.Keeper) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(feemarket.AppModuleBasic{})
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Height: 1})
+
+	ctrl := gomock.NewController(t)
+	accountKeeper := feemarkettestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(authtypes.NewModuleAddress(types.ModuleName))
+
+	k := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		feemarkettestutil.NewMockBankKeeper(ctrl),
+		oracle,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	params.AcceptedFeeDenoms = []types.FeeDenomRate{
+		types.NewFeeDenomRate("atom", sdk.NewDec(2)),
+		types.NewFeeDenomRate("usdc", sdk.NewDecWithPrec(5, 1)),
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+	k.SetBaseFee(ctx, sdk.NewDecWithPrec(5, 1))
+
+	return ctx, k
+}
+
+func TestTxFeeChecker(t *testing.T) {
+	ctx, k := setupKeeper(t, nil)
+	checker := feemarket.NewTxFeeChecker(k)
+	denom := sdk.DefaultBondDenom
+
+	tests := []struct {
+		name        string
+		ctx         sdk.Context
+		fee         sdk.Coins
+		expErr      error
+		expPriority int64
+	}{
+		{
+			name:        "fee equal to the base fee",
+			ctx:         ctx,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin(denom, 500)),
+			expPriority: 0,
+		},
+		{
+			name:        "fee above the base fee",
+			ctx:         ctx,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin(denom, 700)),
+			expPriority: 200000,
+		},
+		{
+			name:   "fee below the base fee",
+			ctx:    ctx,
+			fee:    sdk.NewCoins(sdk.NewInt64Coin(denom, 499)),
+			expErr: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:   "fee in a denom which is not accepted",
+			ctx:    ctx,
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("eth", 1000)),
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name:   "fee with a denom which is not accepted",
+			ctx:    ctx,
+			fee:    sdk.NewCoins(sdk.NewInt64Coin(denom, 1000), sdk.NewInt64Coin("eth", 1)),
+			expErr: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name:        "fee in an accepted denom",
+			ctx:         ctx,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("atom", 250)),
+			expPriority: 0,
+		},
+		{
+			name:   "fee below the base fee in an accepted denom",
+			ctx:    ctx,
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("usdc", 999)),
+			expErr: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:        "fee in several accepted denoms",
+			ctx:         ctx,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin("atom", 200), sdk.NewInt64Coin("usdc", 300)),
+			expPriority: 150000,
+		},
+		{
+			name:        "fee below the min gas price during DeliverTx",
+			ctx:         ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin(denom, sdk.NewInt(1)))),
+			fee:         sdk.NewCoins(sdk.NewInt64Coin(denom, 500)),
+			expPriority: 0,
+		},
+		{
+			name:   "fee below the min gas price during CheckTx",
+			ctx:    ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin(denom, sdk.NewInt(1)))),
+			fee:    sdk.NewCoins(sdk.NewInt64Coin(denom, 500)),
+			expErr: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:        "fee above the min gas price during CheckTx",
+			ctx:         ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin(denom, sdk.NewInt(1)))),
+			fee:         sdk.NewCoins(sdk.NewInt64Coin(denom, 1000)),
+			expPriority: 500000,
+		},
+		{
+			name:        "huge tip",
+			ctx:         ctx,
+			fee:         sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromUint64(math.MaxUint64))),
+			expPriority: math.MaxInt64,
+		},
+		{
+			name:        "no fee at genesis",
+			ctx:         ctx.WithBlockHeight(0),
+			fee:         sdk.NewCoins(),
+			expPriority: 0,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fee, priority, err := checker(tc.ctx, feeTx{fee: tc.fee, gas: 1000})
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.fee, fee)
+			require.Equal(t, tc.expPriority, priority)
+		})
+	}
+}
+
+func TestTxFeeCheckerPriorityPerGas(t *testing.T) {
+	ctx, k := setupKeeper(t, nil)
+	checker := feemarket.NewTxFeeChecker(k)
+	denom := sdk.DefaultBondDenom
+
+	// a large tx paying a higher total tip at a lower price per unit of gas
+	_, large, err := checker(ctx, feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin(denom, 60000)), gas: 100000})
+	require.NoError(t, err)
+	_, small, err := checker(ctx, feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin(denom, 1000)), gas: 1000})
+	require.NoError(t, err)
+
+	require.Equal(t, int64(100000), large)
+	require.Equal(t, int64(500000), small)
+	require.Less(t, large, small)
+}
+
+func TestTxFeeCheckerOracle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	oracle := feemarkettestutil.NewMockConversionRateOracle(ctrl)
+	oracle.EXPECT().GetConversionRate(gomock.Any(), "atom").Return(sdk.NewDec(5), true).AnyTimes()
+	oracle.EXPECT().GetConversionRate(gomock.Any(), "usdc").Return(sdk.Dec{}, false).AnyTimes()
+
+	ctx, k := setupKeeper(t, oracle)
+	checker := feemarket.NewTxFeeChecker(k)
+
+	// the rate of the oracle overrides the one of the params
+	_, priority, err := checker(ctx, feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("atom", 200)), gas: 1000})
+	require.NoError(t, err)
+	require.Equal(t, int64(500000), priority)
+
+	// the rate of the params is used when the oracle has none
+	_, _, err = checker(ctx, feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("usdc", 999)), gas: 1000})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	_, _, err = checker(ctx, feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("usdc", 1000)), gas: 1000})
+	require.NoError(t, err)
+}
+
+func TestTxFeeCheckerDisabled(t *testing.T) {
+	ctx, k := setupKeeper(t, nil)
+	params := k.GetParams(ctx)
+	params.Enabled = false
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// the fees are checked against the min gas prices of the validator only
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	_, priority, err := feemarket.NewTxFeeChecker(k)(ctx, feeTx{fee: fee, gas: 1000})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), priority)
+}
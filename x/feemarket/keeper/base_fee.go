@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// UpdateBaseFee burns the base fees paid for the gas used by the block, if
+// BurnBaseFee is set, and adjusts the base fee of the next block according to
+// the gas used. It does nothing if the fee market is disabled.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context, gasUsed uint64) error {
+	params := k.GetParams(ctx)
+	if !params.Enabled {
+		return nil
+	}
+
+	baseFee := types.EffectiveBaseFee(params, k.GetBaseFee(ctx))
+
+	var burnt sdk.Coins
+	if params.BurnBaseFee {
+		var err error
+		burnt, err = k.burnBaseFees(ctx, params, baseFee, gasUsed)
+		if err != nil {
+			return err
+		}
+	}
+
+	nextBaseFee := types.NextBaseFee(params, baseFee, gasUsed)
+	k.SetBaseFee(ctx, nextBaseFee)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBaseFee,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, nextBaseFee.String()),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, sdk.NewIntFromUint64(gasUsed).String()),
+			sdk.NewAttribute(types.AttributeKeyBurntAmount, burnt.String()),
+		),
+	)
+
+	return nil
+}
+
+// burnBaseFees burns the base fees paid for the gas used by the block from the
+// fee collector, in the fee denom first, then in the accepted fee denoms
+// converted at their rates. The gas used by the txs failing before the fees are
+// deducted is also counted in the block gas, hence the burnt amount is bounded
+// by the collected fees.
+func (k Keeper) burnBaseFees(ctx sdk.Context, params types.Params, baseFee sdk.Dec, gasUsed uint64) (sdk.Coins, error) {
+	feeCollector := k.authKeeper.GetModuleAddress(k.feeCollectorName)
+	denoms := []string{params.FeeDenom}
+	for _, fdr := range params.AcceptedFeeDenoms {
+		denoms = append(denoms, fdr.Denom)
+	}
+
+	remaining := baseFee.MulInt(sdk.NewIntFromUint64(gasUsed))
+	burnt := sdk.NewCoins()
+	for _, denom := range denoms {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		rate, _ := k.ConversionRate(ctx, params, denom)
+		collected := k.bankKeeper.GetBalance(ctx, feeCollector, denom)
+		amount := sdk.MinInt(remaining.Quo(rate).TruncateInt(), collected.Amount)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		burnt = burnt.Add(sdk.NewCoin(denom, amount))
+		remaining = remaining.Sub(rate.MulInt(amount))
+	}
+	if burnt.IsZero() {
+		return burnt, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, burnt); err != nil {
+		return burnt, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnt); err != nil {
+		return burnt, err
+	}
+
+	return burnt, nil
+}
+
+// RequiredGasPrice returns the minimum gas price of the txs of the current block,
+// in the fee denom, which is the base fee bounded below by MinBaseFee.
+func (k Keeper) RequiredGasPrice(ctx sdk.Context, params types.Params) sdk.Dec {
+	return types.EffectiveBaseFee(params, k.GetBaseFee(ctx))
+}
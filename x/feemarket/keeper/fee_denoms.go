@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// ConversionRate returns the amount of fee denom paid by one unit of denom. The
+// rate of an accepted fee denom is given by the oracle if it has one, otherwise
+// by the params. It returns false if the fees cannot be paid in denom.
+func (k Keeper) ConversionRate(ctx sdk.Context, params types.Params, denom string) (sdk.Dec, bool) {
+	rate, ok := params.ConversionRate(denom)
+	if !ok || denom == params.FeeDenom || k.oracle == nil {
+		return rate, ok
+	}
+
+	if oracleRate, found := k.oracle.GetConversionRate(ctx, denom); found && oracleRate.IsPositive() {
+		return oracleRate, true
+	}
+
+	return rate, true
+}
+
+// FeeValue returns the value of fee in the fee denom, the sum of its amounts
+// converted at the rates of their denoms. It returns an error if fee contains a
+// denom which is not accepted.
+func (k Keeper) FeeValue(ctx sdk.Context, params types.Params, fee sdk.Coins) (sdk.Dec, error) {
+	value := sdk.ZeroDec()
+	for _, coin := range fee {
+		rate, ok := k.ConversionRate(ctx, params, coin.Denom)
+		if !ok {
+			return sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "fees cannot be paid in %s", coin.Denom)
+		}
+
+		value = value.Add(rate.MulInt(coin.Amount))
+	}
+
+	return value, nil
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the feemarket module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// BaseFee returns the base fee per unit of gas of the next block in the
+// requested denom, which is zero when the fee market is disabled.
+func (k Keeper) BaseFee(c context.Context, req *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	denom := req.Denom
+	if denom == "" {
+		denom = params.FeeDenom
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !params.Enabled {
+		return &types.QueryBaseFeeResponse{BaseFee: sdk.NewDecCoinFromDec(denom, sdk.ZeroDec())}, nil
+	}
+
+	rate, ok := k.ConversionRate(ctx, params, denom)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "fees cannot be paid in %s", denom)
+	}
+	baseFee := k.RequiredGasPrice(ctx, params).Quo(rate)
+
+	return &types.QueryBaseFeeResponse{BaseFee: sdk.NewDecCoinFromDec(denom, baseFee)}, nil
+}
@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, s.encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, s.feemarketKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	params, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(s.feemarketKeeper.GetParams(s.ctx), params.Params)
+
+	baseFee, err := queryClient.BaseFee(gocontext.Background(), &types.QueryBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(1)), baseFee.BaseFee)
+
+	// the base fee is converted to the requested denom
+	p := params.Params
+	p.AcceptedFeeDenoms = []types.FeeDenomRate{types.NewFeeDenomRate("atom", sdk.NewDec(4))}
+	s.Require().NoError(s.feemarketKeeper.SetParams(s.ctx, p))
+
+	baseFee, err = queryClient.BaseFee(gocontext.Background(), &types.QueryBaseFeeRequest{Denom: "atom"})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(25, 2)), baseFee.BaseFee)
+
+	_, err = queryClient.BaseFee(gocontext.Background(), &types.QueryBaseFeeRequest{Denom: "eth"})
+	s.Require().Error(err)
+
+	// the base fee is zero when the fee market is disabled
+	p.Enabled = false
+	s.Require().NoError(s.feemarketKeeper.SetParams(s.ctx, p))
+
+	baseFee, err = queryClient.BaseFee(gocontext.Background(), &types.QueryBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().True(baseFee.BaseFee.IsZero())
+}
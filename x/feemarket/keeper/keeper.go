@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc              codec.BinaryCodec
+	storeKey         storetypes.StoreKey
+	authKeeper       types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	oracle           types.ConversionRateOracle
+	feeCollectorName string
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new feemarket Keeper instance. The oracle may be nil, in
+// which case the conversion rates of the accepted fee denoms are the ones set
+// in the params.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	oracle types.ConversionRateOracle,
+	feeCollectorName string,
+	authority string,
+) Keeper {
+	// ensure feemarket module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the x/%s module account has not been set", types.ModuleName))
+	}
+
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
+		authKeeper:       ak,
+		bankKeeper:       bk,
+		oracle:           oracle,
+		feeCollectorName: feeCollectorName,
+		authority:        authority,
+	}
+}
+
+// GetAuthority returns the x/feemarket module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetBaseFee returns the stored base fee per unit of gas of the next block.
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BaseFeeKey)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var baseFee sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &baseFee)
+	return baseFee.Dec
+}
+
+// SetBaseFee sets the base fee per unit of gas of the next block.
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: baseFee})
+	store.Set(types.BaseFeeKey, bz)
+}
+
+// SetParams sets the x/feemarket module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&p)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// GetParams returns the current x/feemarket module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return p
+	}
+
+	k.cdc.MustUnmarshal(bz, &p)
+	return p
+}
@@ -0,0 +1,240 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	feemarkettestutil "github.com/cosmos/cosmos-sdk/x/feemarket/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var feeCollectorAddr = authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx             sdk.Context
+	feemarketKeeper keeper.Keeper
+	accountKeeper   *feemarkettestutil.MockAccountKeeper
+	bankKeeper      *feemarkettestutil.MockBankKeeper
+	msgServer       types.MsgServer
+	encCfg          moduletestutil.TestEncodingConfig
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.encCfg = moduletestutil.MakeTestEncodingConfig(feemarket.AppModuleBasic{})
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, sdk.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+
+	// gomock initializations
+	ctrl := gomock.NewController(s.T())
+	s.accountKeeper = feemarkettestutil.NewMockAccountKeeper(ctrl)
+	s.bankKeeper = feemarkettestutil.NewMockBankKeeper(ctrl)
+
+	s.accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(authtypes.NewModuleAddress(types.ModuleName))
+	s.accountKeeper.EXPECT().GetModuleAddress(authtypes.FeeCollectorName).Return(feeCollectorAddr).AnyTimes()
+
+	s.feemarketKeeper = keeper.NewKeeper(
+		s.encCfg.Codec,
+		key,
+		s.accountKeeper,
+		s.bankKeeper,
+		nil,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	s.Require().NoError(s.feemarketKeeper.SetParams(s.ctx, params))
+	s.feemarketKeeper.SetBaseFee(s.ctx, sdk.NewDec(1))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.feemarketKeeper)
+}
+
+func (s *KeeperTestSuite) TestParams() {
+	testCases := []struct {
+		name      string
+		input     types.Params
+		expectErr bool
+	}{
+		{
+			name:      "set invalid params",
+			input:     types.NewParams(true, sdk.DefaultBondDenom, sdk.ZeroDec(), types.DefaultTargetBlockGas, types.DefaultBaseFeeChangeDenominator, true, nil),
+			expectErr: true,
+		},
+		{
+			name:      "set full valid params",
+			input:     types.NewParams(true, "uatom", sdk.NewDecWithPrec(1, 2), 5_000_000, 4, false, nil),
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			expected := s.feemarketKeeper.GetParams(s.ctx)
+			err := s.feemarketKeeper.SetParams(s.ctx, tc.input)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				expected = tc.input
+				s.Require().NoError(err)
+			}
+
+			p := s.feemarketKeeper.GetParams(s.ctx)
+			s.Require().Equal(expected, p)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestBaseFee() {
+	s.feemarketKeeper.SetBaseFee(s.ctx, sdk.NewDecWithPrec(15, 1))
+	s.Require().Equal(sdk.NewDecWithPrec(15, 1), s.feemarketKeeper.GetBaseFee(s.ctx))
+
+	// the required gas price is bounded below by the min base fee
+	params := s.feemarketKeeper.GetParams(s.ctx)
+	s.feemarketKeeper.SetBaseFee(s.ctx, sdk.ZeroDec())
+	s.Require().Equal(params.MinBaseFee, s.feemarketKeeper.RequiredGasPrice(s.ctx, params))
+}
+
+func (s *KeeperTestSuite) TestUpdateBaseFee() {
+	params := s.feemarketKeeper.GetParams(s.ctx)
+	params.BurnBaseFee = false
+	s.Require().NoError(s.feemarketKeeper.SetParams(s.ctx, params))
+
+	testCases := []struct {
+		name       string
+		baseFee    sdk.Dec
+		gasUsed    uint64
+		expBaseFee sdk.Dec
+	}{
+		{
+			name:       "block at target",
+			baseFee:    sdk.NewDec(1),
+			gasUsed:    params.TargetBlockGas,
+			expBaseFee: sdk.NewDec(1),
+		},
+		{
+			name:       "full block",
+			baseFee:    sdk.NewDec(1),
+			gasUsed:    2 * params.TargetBlockGas,
+			expBaseFee: sdk.NewDecWithPrec(1125, 3),
+		},
+		{
+			name:       "empty block",
+			baseFee:    sdk.NewDec(1),
+			gasUsed:    0,
+			expBaseFee: sdk.NewDecWithPrec(875, 3),
+		},
+		{
+			name:       "empty block at min base fee",
+			baseFee:    params.MinBaseFee,
+			gasUsed:    0,
+			expBaseFee: params.MinBaseFee,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.feemarketKeeper.SetBaseFee(s.ctx, tc.baseFee)
+			s.Require().NoError(s.feemarketKeeper.UpdateBaseFee(s.ctx, tc.gasUsed))
+			s.Require().Equal(tc.expBaseFee, s.feemarketKeeper.GetBaseFee(s.ctx))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateBaseFeeBurn() {
+	params := s.feemarketKeeper.GetParams(s.ctx)
+	gasUsed := params.TargetBlockGas
+
+	// the base fees of the gas used are burnt
+	burnt := sdk.NewCoins(sdk.NewCoin(params.FeeDenom, sdk.NewIntFromUint64(gasUsed)))
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, feeCollectorAddr, params.FeeDenom).Return(sdk.NewCoin(params.FeeDenom, sdk.NewInt(20_000_000)))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, authtypes.FeeCollectorName, types.ModuleName, burnt).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(s.ctx, types.ModuleName, burnt).Return(nil)
+	s.Require().NoError(s.feemarketKeeper.UpdateBaseFee(s.ctx, gasUsed))
+
+	// the burnt amount is bounded by the collected fees
+	burnt = sdk.NewCoins(sdk.NewCoin(params.FeeDenom, sdk.NewInt(100)))
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, feeCollectorAddr, params.FeeDenom).Return(sdk.NewCoin(params.FeeDenom, sdk.NewInt(100)))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, authtypes.FeeCollectorName, types.ModuleName, burnt).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(s.ctx, types.ModuleName, burnt).Return(nil)
+	s.Require().NoError(s.feemarketKeeper.UpdateBaseFee(s.ctx, gasUsed))
+
+	// nothing is burnt when no fees were collected
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, feeCollectorAddr, params.FeeDenom).Return(sdk.NewCoin(params.FeeDenom, sdk.ZeroInt()))
+	s.Require().NoError(s.feemarketKeeper.UpdateBaseFee(s.ctx, gasUsed))
+}
+
+func (s *KeeperTestSuite) TestUpdateBaseFeeDisabled() {
+	params := s.feemarketKeeper.GetParams(s.ctx)
+	params.Enabled = false
+	s.Require().NoError(s.feemarketKeeper.SetParams(s.ctx, params))
+
+	s.Require().NoError(s.feemarketKeeper.UpdateBaseFee(s.ctx, 2*params.TargetBlockGas))
+	s.Require().Equal(sdk.NewDec(1), s.feemarketKeeper.GetBaseFee(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	params := types.NewParams(true, "uatom", sdk.NewDecWithPrec(1, 2), 5_000_000, 4, false, nil)
+	genesisState := types.NewGenesisState(params, sdk.NewDecWithPrec(3, 2))
+
+	s.accountKeeper.EXPECT().GetModuleAccount(s.ctx, types.ModuleName).Return(nil)
+	s.feemarketKeeper.InitGenesis(s.ctx, s.accountKeeper, genesisState)
+	s.Require().Equal(genesisState, s.feemarketKeeper.ExportGenesis(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestUpdateBaseFeeBurnAcceptedFeeDenoms() {
+	params := s.feemarketKeeper.GetParams(s.ctx)
+	params.AcceptedFeeDenoms = []types.FeeDenomRate{
+		types.NewFeeDenomRate("atom", sdk.NewDec(4)),
+		types.NewFeeDenomRate("usdc", sdk.NewDecWithPrec(5, 1)),
+	}
+	s.Require().NoError(s.feemarketKeeper.SetParams(s.ctx, params))
+
+	// the base fees are burnt in the fee denom first, then in the accepted fee
+	// denoms at their conversion rates
+	gasUsed := uint64(1000)
+	burnt := sdk.NewCoins(
+		sdk.NewInt64Coin(params.FeeDenom, 100),
+		sdk.NewInt64Coin("atom", 200),
+		sdk.NewInt64Coin("usdc", 200),
+	)
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, feeCollectorAddr, params.FeeDenom).Return(sdk.NewInt64Coin(params.FeeDenom, 100))
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, feeCollectorAddr, "atom").Return(sdk.NewInt64Coin("atom", 200))
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, feeCollectorAddr, "usdc").Return(sdk.NewInt64Coin("usdc", 1000))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, authtypes.FeeCollectorName, types.ModuleName, burnt).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(s.ctx, types.ModuleName, burnt).Return(nil)
+	s.Require().NoError(s.feemarketKeeper.UpdateBaseFee(s.ctx, gasUsed))
+}
+
+func (s *KeeperTestSuite) TestFeeValue() {
+	params := s.feemarketKeeper.GetParams(s.ctx)
+	params.AcceptedFeeDenoms = []types.FeeDenomRate{types.NewFeeDenomRate("atom", sdk.NewDecWithPrec(25, 1))}
+	s.Require().NoError(s.feemarketKeeper.SetParams(s.ctx, params))
+
+	value, err := s.feemarketKeeper.FeeValue(s.ctx, params, sdk.NewCoins(sdk.NewInt64Coin(params.FeeDenom, 10), sdk.NewInt64Coin("atom", 3)))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecWithPrec(175, 1), value)
+
+	_, err = s.feemarketKeeper.FeeValue(s.ctx, params, sdk.NewCoins(sdk.NewInt64Coin("eth", 1)))
+	s.Require().Error(err)
+}
@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name: "set invalid authority",
+			request: &types.MsgUpdateParams{
+				Authority: "foo",
+			},
+			expectErr: true,
+		},
+		{
+			name: "set invalid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.feemarketKeeper.GetAuthority(),
+				Params:    types.NewParams(true, sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 2), 0, 4, true, nil),
+			},
+			expectErr: true,
+		},
+		{
+			name: "set full valid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.feemarketKeeper.GetAuthority(),
+				Params:    types.NewParams(true, sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 2), 5_000_000, 4, true, nil),
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(s.ctx, tc.request)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.request.Params, s.feemarketKeeper.GetParams(s.ctx))
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// Simulation parameter constants
+const (
+	MinBaseFee               = "min_base_fee"
+	TargetBlockGas           = "target_block_gas"
+	BaseFeeChangeDenominator = "base_fee_change_denominator"
+	BurnBaseFee              = "burn_base_fee"
+)
+
+// GenMinBaseFee randomized MinBaseFee
+func GenMinBaseFee(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(100)+1), 4)
+}
+
+// GenTargetBlockGas randomized TargetBlockGas
+func GenTargetBlockGas(r *rand.Rand) uint64 {
+	return uint64(r.Intn(20_000_000) + 1_000_000)
+}
+
+// GenBaseFeeChangeDenominator randomized BaseFeeChangeDenominator
+func GenBaseFeeChangeDenominator(r *rand.Rand) uint32 {
+	return uint32(r.Intn(16) + 1)
+}
+
+// GenBurnBaseFee randomized BurnBaseFee
+func GenBurnBaseFee(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// RandomizedGenState generates a random GenesisState for feemarket. The fee
+// market is left disabled, as the simulated txs do not pay the base fee.
+func RandomizedGenState(simState *module.SimulationState) {
+	var minBaseFee sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinBaseFee, &minBaseFee, simState.Rand,
+		func(r *rand.Rand) { minBaseFee = GenMinBaseFee(r) },
+	)
+
+	var targetBlockGas uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TargetBlockGas, &targetBlockGas, simState.Rand,
+		func(r *rand.Rand) { targetBlockGas = GenTargetBlockGas(r) },
+	)
+
+	var baseFeeChangeDenominator uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BaseFeeChangeDenominator, &baseFeeChangeDenominator, simState.Rand,
+		func(r *rand.Rand) { baseFeeChangeDenominator = GenBaseFeeChangeDenominator(r) },
+	)
+
+	var burnBaseFee bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BurnBaseFee, &burnBaseFee, simState.Rand,
+		func(r *rand.Rand) { burnBaseFee = GenBurnBaseFee(r) },
+	)
+
+	params := types.NewParams(false, sdk.DefaultBondDenom, minBaseFee, targetBlockGas, baseFeeChangeDenominator, burnBaseFee, nil)
+	feemarketGenesis := types.NewGenesisState(params, minBaseFee)
+
+	bz, err := json.MarshalIndent(&feemarketGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated feemarket parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(feemarketGenesis)
+}
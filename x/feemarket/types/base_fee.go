@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NextBaseFee returns the base fee of the block following a block which used
+// gasUsed gas with the given base fee. As in EIP-1559, the base fee changes
+// proportionally to the difference between the gas used and the target block
+// gas, by at most 1/BaseFeeChangeDenominator of its value for the blocks
+// using less than twice the target, and is bounded below by MinBaseFee.
+func NextBaseFee(params Params, baseFee sdk.Dec, gasUsed uint64) sdk.Dec {
+	baseFee = EffectiveBaseFee(params, baseFee)
+
+	target := sdk.NewDecFromInt(sdk.NewIntFromUint64(params.TargetBlockGas))
+	used := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed))
+	delta := baseFee.Mul(used.Sub(target)).Quo(target).QuoInt64(int64(params.BaseFeeChangeDenominator))
+
+	return EffectiveBaseFee(params, baseFee.Add(delta))
+}
+
+// EffectiveBaseFee returns the base fee bounded below by MinBaseFee.
+func EffectiveBaseFee(params Params, baseFee sdk.Dec) sdk.Dec {
+	if baseFee.IsNil() || baseFee.LT(params.MinBaseFee) {
+		return params.MinBaseFee
+	}
+	return baseFee
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	params := NewParams(true, sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 1), 1000, 8, true, nil)
+
+	tests := []struct {
+		name    string
+		baseFee sdk.Dec
+		gasUsed uint64
+		exp     sdk.Dec
+	}{
+		{"at target", sdk.NewDec(1), 1000, sdk.NewDec(1)},
+		{"full block", sdk.NewDec(1), 2000, sdk.NewDecWithPrec(1125, 3)},
+		{"half full block", sdk.NewDec(1), 1500, sdk.NewDecWithPrec(10625, 4)},
+		{"empty block", sdk.NewDec(1), 0, sdk.NewDecWithPrec(875, 3)},
+		{"empty block at min base fee", sdk.NewDecWithPrec(1, 1), 0, sdk.NewDecWithPrec(1, 1)},
+		{"base fee below min", sdk.NewDecWithPrec(1, 2), 1000, sdk.NewDecWithPrec(1, 1)},
+		{"unset base fee", sdk.Dec{}, 2000, sdk.NewDecWithPrec(1125, 4)},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, NextBaseFee(params, tc.baseFee, tc.gasUsed))
+		})
+	}
+}
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+
+	tests := []struct {
+		name   string
+		params Params
+	}{
+		{"blank denom", NewParams(true, "", DefaultMinBaseFee, DefaultTargetBlockGas, DefaultBaseFeeChangeDenominator, true, nil)},
+		{"invalid denom", NewParams(true, "1stake", DefaultMinBaseFee, DefaultTargetBlockGas, DefaultBaseFeeChangeDenominator, true, nil)},
+		{"nil min base fee", NewParams(true, sdk.DefaultBondDenom, sdk.Dec{}, DefaultTargetBlockGas, DefaultBaseFeeChangeDenominator, true, nil)},
+		{"zero min base fee", NewParams(true, sdk.DefaultBondDenom, sdk.ZeroDec(), DefaultTargetBlockGas, DefaultBaseFeeChangeDenominator, true, nil)},
+		{"zero target block gas", NewParams(true, sdk.DefaultBondDenom, DefaultMinBaseFee, 0, DefaultBaseFeeChangeDenominator, true, nil)},
+		{"zero change denominator", NewParams(true, sdk.DefaultBondDenom, DefaultMinBaseFee, DefaultTargetBlockGas, 0, true, nil)},
+		{"invalid accepted fee denom", NewParams(true, sdk.DefaultBondDenom, DefaultMinBaseFee, DefaultTargetBlockGas, DefaultBaseFeeChangeDenominator, true, []FeeDenomRate{NewFeeDenomRate("1atom", sdk.OneDec())})},
+		{"fee denom accepted twice", NewParams(true, sdk.DefaultBondDenom, DefaultMinBaseFee, DefaultTargetBlockGas, DefaultBaseFeeChangeDenominator, true, []FeeDenomRate{NewFeeDenomRate(sdk.DefaultBondDenom, sdk.OneDec())})},
+		{"duplicate accepted fee denom", NewParams(true, sdk.DefaultBondDenom, DefaultMinBaseFee, DefaultTargetBlockGas, DefaultBaseFeeChangeDenominator, true, []FeeDenomRate{NewFeeDenomRate("atom", sdk.OneDec()), NewFeeDenomRate("atom", sdk.OneDec())})},
+		{"zero conversion rate", NewParams(true, sdk.DefaultBondDenom, DefaultMinBaseFee, DefaultTargetBlockGas, DefaultBaseFeeChangeDenominator, true, []FeeDenomRate{NewFeeDenomRate("atom", sdk.ZeroDec())})},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Error(t, tc.params.Validate())
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package types // noalias
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AccountKeeper defines the contract required for account APIs.
+type AccountKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, moduleName string) types.ModuleAccountI
+}
+
+// BankKeeper defines the contract needed to burn the base fees collected by
+// the fee collector.
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+}
+
+// ConversionRateOracle defines the contract of the oracles providing the
+// conversion rates of the accepted fee denoms to the fee denom. The rates it
+// returns override the ones set in the params.
+type ConversionRateOracle interface {
+	// GetConversionRate returns the amount of fee denom paid by one unit of
+	// denom, or false if the oracle has no rate for denom.
+	GetConversionRate(ctx sdk.Context, denom string) (sdk.Dec, bool)
+}
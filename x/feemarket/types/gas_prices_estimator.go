@@ -0,0 +1,40 @@
+package types
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ client.GasPricesEstimator = GasPricesEstimator{}
+
+// GasPricesEstimator implements the client.GasPricesEstimator interface by
+// querying the base fee of the next block, in any of the accepted fee denoms. It
+// returns no gas prices if the fee market is disabled.
+type GasPricesEstimator struct{}
+
+// EstimateGasPrices returns the base fee of the next block, increased by its
+// maximum change so that the transaction can still be included in the following
+// block if the next one is full.
+func (GasPricesEstimator) EstimateGasPrices(clientCtx client.Context, feeDenom string) (sdk.DecCoins, error) {
+	queryClient := NewQueryClient(clientCtx)
+
+	paramsRes, err := queryClient.Params(context.Background(), &QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	params := paramsRes.Params
+	if !params.Enabled {
+		return nil, nil
+	}
+
+	baseFeeRes, err := queryClient.BaseFee(context.Background(), &QueryBaseFeeRequest{Denom: feeDenom})
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee := baseFeeRes.BaseFee
+	margin := sdk.OneDec().Add(sdk.OneDec().QuoInt64(int64(params.BaseFeeChangeDenominator)))
+	return sdk.NewDecCoins(sdk.NewDecCoinFromDec(baseFee.Denom, baseFee.Amount.Mul(margin))), nil
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state for the feemarket module.
+func NewGenesisState(params Params, baseFee sdk.Dec) *GenesisState {
+	return &GenesisState{
+		Params:  params,
+		BaseFee: baseFee,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state, starting at the
+// minimum base fee.
+func DefaultGenesisState() *GenesisState {
+	params := DefaultParams()
+	return NewGenesisState(params, params.MinBaseFee)
+}
+
+// ValidateGenesis validates the feemarket genesis parameters.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if data.BaseFee.IsNil() || data.BaseFee.IsNegative() {
+		return fmt.Errorf("base fee cannot be negative: %s", data.BaseFee)
+	}
+
+	return nil
+}
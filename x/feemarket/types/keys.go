@@ -0,0 +1,14 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feemarket"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	BaseFeeKey = []byte{0x00}
+	ParamsKey  = []byte{0x01}
+)
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+)
+
+// KVSchema returns the key/value schema of the feemarket store.
+func KVSchema() []schema.Entry {
+	return []schema.Entry{
+		{Name: "base_fee", Prefix: BaseFeeKey, Value: schema.ProtoValue(&sdk.DecProto{})},
+		{Name: "params", Prefix: ParamsKey, Value: schema.ProtoValue(&Params{})},
+	}
+}
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Default parameter values
+const (
+	DefaultTargetBlockGas           uint64 = 10_000_000
+	DefaultBaseFeeChangeDenominator uint32 = 8
+)
+
+// DefaultMinBaseFee is the default lower bound of the base fee per unit of gas.
+var DefaultMinBaseFee = sdk.NewDecWithPrec(25, 4)
+
+// NewParams creates a new Params instance.
+func NewParams(
+	enabled bool, feeDenom string, minBaseFee sdk.Dec, targetBlockGas uint64, baseFeeChangeDenominator uint32, burnBaseFee bool,
+	acceptedFeeDenoms []FeeDenomRate,
+) Params {
+	return Params{
+		Enabled:                  enabled,
+		FeeDenom:                 feeDenom,
+		MinBaseFee:               minBaseFee,
+		TargetBlockGas:           targetBlockGas,
+		BaseFeeChangeDenominator: baseFeeChangeDenominator,
+		BurnBaseFee:              burnBaseFee,
+		AcceptedFeeDenoms:        acceptedFeeDenoms,
+	}
+}
+
+// NewFeeDenomRate creates a new FeeDenomRate instance.
+func NewFeeDenomRate(denom string, rate sdk.Dec) FeeDenomRate {
+	return FeeDenomRate{Denom: denom, Rate: rate}
+}
+
+// DefaultParams returns default x/feemarket module parameters. The fee market
+// is disabled by default, so that the chains enable it once their clients pay
+// the base fee.
+func DefaultParams() Params {
+	return NewParams(false, sdk.DefaultBondDenom, DefaultMinBaseFee, DefaultTargetBlockGas, DefaultBaseFeeChangeDenominator, true, nil)
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if strings.TrimSpace(p.FeeDenom) == "" {
+		return errors.New("fee denom cannot be blank")
+	}
+	if err := sdk.ValidateDenom(p.FeeDenom); err != nil {
+		return err
+	}
+	if p.MinBaseFee.IsNil() || !p.MinBaseFee.IsPositive() {
+		return fmt.Errorf("min base fee must be positive: %s", p.MinBaseFee)
+	}
+	if p.TargetBlockGas == 0 {
+		return errors.New("target block gas must be positive")
+	}
+	if p.BaseFeeChangeDenominator == 0 {
+		return errors.New("base fee change denominator must be positive")
+	}
+
+	seen := map[string]bool{p.FeeDenom: true}
+	for _, fdr := range p.AcceptedFeeDenoms {
+		if err := sdk.ValidateDenom(fdr.Denom); err != nil {
+			return err
+		}
+		if seen[fdr.Denom] {
+			return fmt.Errorf("duplicate accepted fee denom %s", fdr.Denom)
+		}
+		seen[fdr.Denom] = true
+		if fdr.Rate.IsNil() || !fdr.Rate.IsPositive() {
+			return fmt.Errorf("conversion rate of %s must be positive: %s", fdr.Denom, fdr.Rate)
+		}
+	}
+
+	return nil
+}
+
+// ConversionRate returns the conversion rate to the fee denom of the given denom
+// set in the params, which is one for the fee denom itself. It returns false if
+// the fees cannot be paid in the denom.
+func (p Params) ConversionRate(denom string) (sdk.Dec, bool) {
+	if denom == p.FeeDenom {
+		return sdk.OneDec(), true
+	}
+	for _, fdr := range p.AcceptedFeeDenoms {
+		if fdr.Denom == denom {
+			return fdr.Rate, true
+		}
+	}
+
+	return sdk.Dec{}, false
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
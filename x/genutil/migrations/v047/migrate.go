@@ -5,6 +5,9 @@ import (
 	bankv047 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v047"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govv047 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v047"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 // Migrate migrates exported state from v0.46 to a v0.47 genesis state.
@@ -17,5 +20,24 @@ func Migrate(appState types.AppMap, clientCtx client.Context) types.AppMap {
 		newBankState := bankv047.MigrateGenState(oldBankState)
 		appState[banktypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(newBankState)
 	}
+
+	// Migrate x/gov.
+	if appState[govtypes.ModuleName] != nil {
+		// unmarshal relative source genesis application state
+		var oldGovState govv1.GenesisState
+		clientCtx.Codec.MustUnmarshalJSON(appState[govtypes.ModuleName], &oldGovState)
+
+		// delete deprecated x/gov genesis state
+		delete(appState, govtypes.ModuleName)
+
+		// Migrate relative source genesis application state and marshal it into
+		// the respective key.
+		newGovState, err := govv047.MigrateJSON(&oldGovState)
+		if err != nil {
+			panic(err)
+		}
+		appState[govtypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(newGovState)
+	}
+
 	return appState
 }
@@ -34,10 +34,11 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 			),
 		)
 
+		depositParams, _ := keeper.GetProposalParams(ctx, proposal)
 		logger.Info(
 			"proposal did not meet minimum deposit; deleted",
 			"proposal", proposal.Id,
-			"min_deposit", sdk.NewCoins(keeper.GetDepositParams(ctx).MinDeposit...).String(),
+			"min_deposit", sdk.NewCoins(proposal.GetMinDepositFromParams(depositParams)...).String(),
 			"total_deposit", sdk.NewCoins(proposal.TotalDeposit...).String(),
 		)
 
@@ -50,6 +51,37 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 
 		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
 
+		// A failed expedited proposal is converted to a regular proposal: its
+		// voting period is extended to the regular voting period, and its votes
+		// and deposits are kept until it's tallied again as a regular proposal.
+		// A vetoed expedited proposal isn't converted, it's rejected and its
+		// deposits are burnt as for a regular proposal.
+		if proposal.Expedited && !passes && !burnDeposits {
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+
+			proposal.Expedited = false
+			endTime := proposal.VotingStartTime.Add(proposal.GetVotingPeriodFromParams(keeper.GetVotingParams(ctx)))
+			proposal.VotingEndTime = &endTime
+
+			keeper.SetProposal(ctx, proposal)
+			keeper.InsertActiveProposalQueue(ctx, proposal.Id, endTime)
+
+			logger.Info(
+				"expedited proposal converted to regular",
+				"proposal", proposal.Id,
+				"voting_end_time", endTime,
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+
 		if burnDeposits {
 			keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
 		} else {
@@ -102,6 +134,9 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 			proposal.Status = v1.StatusRejected
 			tagValue = types.AttributeValueProposalRejected
 			logMsg = "rejected"
+			if proposal.Optimistic {
+				tagValue = types.AttributeValueOptimisticProposalRejected
+			}
 		}
 
 		proposal.FinalTallyResult = &tallyResults
@@ -38,6 +38,8 @@ func TestTickExpiredDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		"",
+		false,
+		false,
 	)
 	require.NoError(t, err)
 
@@ -91,6 +93,8 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		"",
+		false,
+		false,
 	)
 	require.NoError(t, err)
 
@@ -115,6 +119,8 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		"",
+		false,
+		false,
 	)
 	require.NoError(t, err)
 
@@ -173,6 +179,8 @@ func TestTickPassedDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		"",
+		false,
+		false,
 	)
 	require.NoError(t, err)
 
@@ -225,7 +233,7 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	activeQueue.Close()
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 5))}
-	newProposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, proposalCoins, addrs[0].String(), "")
+	newProposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, proposalCoins, addrs[0].String(), "", false, false)
 	require.NoError(t, err)
 
 	wrapCtx := sdk.WrapSDKContext(ctx)
@@ -293,7 +301,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", addrs[0], false, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
@@ -343,7 +351,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// Create a proposal where the handler will pass for the test proposal
 	// because the value of contextKeyBadProposal is true.
 	ctx = ctx.WithValue(contextKeyBadProposal, true)
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", addrs[0], false, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
@@ -369,6 +377,150 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	gov.EndBlocker(ctx, app.GovKeeper)
 }
 
+func TestExpeditedProposalPassAndConversionToRegular(t *testing.T) {
+	testcases := map[string]struct {
+		// voteOptions are the votes of the two validators on the expedited proposal
+		voteOptions [2]v1.VoteOption
+		// expeditedPasses indicates whether the expedited proposal passes
+		expeditedPasses bool
+		// expeditedVetoed indicates whether the expedited proposal is vetoed
+		expeditedVetoed bool
+		// regularVoteOption, if set, is the new vote of the first validator once
+		// the proposal is converted to a regular proposal
+		regularVoteOption v1.VoteOption
+		// regularPasses indicates whether the converted proposal passes
+		regularPasses bool
+	}{
+		"expedited passes": {
+			voteOptions:     [2]v1.VoteOption{v1.OptionYes, v1.OptionYes},
+			expeditedPasses: true,
+		},
+		"expedited vetoed, rejected and deposits burnt": {
+			voteOptions:     [2]v1.VoteOption{v1.OptionNoWithVeto, v1.OptionYes},
+			expeditedVetoed: true,
+		},
+		"expedited fails, converted to regular which passes": {
+			voteOptions:   [2]v1.VoteOption{v1.OptionYes, v1.OptionNo},
+			regularPasses: true,
+		},
+		"expedited fails, converted to regular which is rejected": {
+			voteOptions:       [2]v1.VoteOption{v1.OptionYes, v1.OptionNo},
+			regularVoteOption: v1.OptionNo,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+
+			SortAddresses(addrs)
+
+			govMsgSvr := keeper.NewMsgServerImpl(app.GovKeeper)
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			// the first validator holds 60% of the voting validators' power, which
+			// is more than the threshold but less than the expedited threshold
+			valAddrs := []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])}
+			createValidators(t, stakingMsgSvr, ctx, valAddrs, []int64{6, 4})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			macc := app.GovKeeper.GetGovernanceAccount(ctx)
+			initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
+
+			depositParams := app.GovKeeper.GetDepositParams(ctx)
+			votingParams := app.GovKeeper.GetVotingParams(ctx)
+
+			// an expedited proposal doesn't enter voting period with the regular
+			// minimum deposit
+			msg, err := v1.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, depositParams.MinDeposit, addrs[2].String(), "", true, false)
+			require.NoError(t, err)
+			res, err := govMsgSvr.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+			require.NoError(t, err)
+			proposal, ok := app.GovKeeper.GetProposal(ctx, res.ProposalId)
+			require.True(t, ok)
+			require.Equal(t, v1.StatusDepositPeriod, proposal.Status)
+
+			msg, err = v1.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, depositParams.ExpeditedMinDeposit, addrs[2].String(), "", true, false)
+			require.NoError(t, err)
+			res, err = govMsgSvr.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+			require.NoError(t, err)
+			proposal, ok = app.GovKeeper.GetProposal(ctx, res.ProposalId)
+			require.True(t, ok)
+			require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+			require.True(t, proposal.Expedited)
+			require.Equal(t, proposal.VotingStartTime.Add(*votingParams.ExpeditedVotingPeriod), *proposal.VotingEndTime)
+
+			for i, option := range tc.voteOptions {
+				require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.Id, addrs[i], v1.NewNonSplitVoteOption(option), ""))
+			}
+
+			// end the expedited voting period
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime.Add(time.Second)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.Id)
+			require.True(t, ok)
+
+			if tc.expeditedPasses {
+				require.Equal(t, v1.StatusPassed, proposal.Status)
+				return
+			}
+
+			if tc.expeditedVetoed {
+				require.Equal(t, v1.StatusRejected, proposal.Status)
+				require.True(t, proposal.Expedited)
+				require.Empty(t, app.GovKeeper.GetDeposits(ctx, proposal.Id))
+
+				// only the deposit of the first proposal, still in its deposit
+				// period, is left in the module account
+				moduleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
+				require.True(t, moduleAccCoins.IsEqual(initialModuleAccCoins.Add(depositParams.MinDeposit...)))
+				return
+			}
+
+			// the proposal is converted to a regular proposal, keeping its votes
+			// and deposits
+			require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+			require.False(t, proposal.Expedited)
+			require.Equal(t, proposal.VotingStartTime.Add(*votingParams.VotingPeriod), *proposal.VotingEndTime)
+			require.Len(t, app.GovKeeper.GetVotes(ctx, proposal.Id), len(tc.voteOptions))
+			require.Len(t, app.GovKeeper.GetDeposits(ctx, proposal.Id), 1)
+
+			if tc.regularVoteOption != v1.OptionEmpty {
+				require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(tc.regularVoteOption), ""))
+			}
+
+			// end the regular voting period
+			newHeader = ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime.Add(time.Second)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.Id)
+			require.True(t, ok)
+			if tc.regularPasses {
+				require.Equal(t, v1.StatusPassed, proposal.Status)
+			} else {
+				require.Equal(t, v1.StatusRejected, proposal.Status)
+			}
+
+			// the deposits of both proposals have been refunded, as the first one
+			// didn't reach the expedited minimum deposit in its deposit period
+			moduleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
+			require.True(t, moduleAccCoins.IsEqual(initialModuleAccCoins))
+		})
+	}
+}
+
 func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sdk.Context, addrs []sdk.ValAddress, powerAmt []int64) {
 	require.True(t, len(addrs) <= len(pubkeys), "Not enough pubkeys specified at top of file.")
 
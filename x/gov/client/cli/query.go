@@ -38,6 +38,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryVoteDelegations(),
+		GetCmdQueryVoteDelegators(),
 	)
 
 	return govQueryCmd
@@ -530,7 +532,7 @@ $ %s query gov params
 			}
 			queryClient := v1.NewQueryClient(clientCtx)
 
-			// Query store for all 3 params
+			// Query store for all 4 params
 			ctx := cmd.Context()
 			votingRes, err := queryClient.Params(
 				ctx,
@@ -556,11 +558,29 @@ $ %s query gov params
 				return err
 			}
 
+			messageBasedRes, err := queryClient.Params(
+				ctx,
+				&v1.QueryParamsRequest{ParamsType: "message_based"},
+			)
+			if err != nil {
+				return err
+			}
+
+			optimisticRes, err := queryClient.Params(
+				ctx,
+				&v1.QueryParamsRequest{ParamsType: "optimistic"},
+			)
+			if err != nil {
+				return err
+			}
+
 			params := v1.NewParams(
 				*votingRes.GetVotingParams(),
 				*tallyRes.GetTallyParams(),
 				*depositRes.GetDepositParams(),
 			)
+			params.MessageBasedParams = messageBasedRes.GetMessageBasedParams()
+			params.OptimisticParams = *optimisticRes.GetOptimisticParams()
 
 			return clientCtx.PrintObjectLegacy(params)
 		},
@@ -576,7 +596,7 @@ func GetCmdQueryParam() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "param [param-type]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Query the parameters (voting|tallying|deposit) of the governance process",
+		Short: "Query the parameters (voting|tallying|deposit|message_based|optimistic) of the governance process",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query the all the parameters for the governance process.
 
@@ -584,8 +604,10 @@ Example:
 $ %s query gov param voting
 $ %s query gov param tallying
 $ %s query gov param deposit
+$ %s query gov param message_based
+$ %s query gov param optimistic
 `,
-				version.AppName, version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -612,8 +634,12 @@ $ %s query gov param deposit
 				out = res.GetTallyParams()
 			case "deposit":
 				out = res.GetDepositParams()
+			case "message_based":
+				return clientCtx.PrintObjectLegacy(res.GetMessageBasedParams())
+			case "optimistic":
+				out = res.GetOptimisticParams()
 			default:
-				return fmt.Errorf("argument must be one of (voting|tallying|deposit), was %s", args[0])
+				return fmt.Errorf("argument must be one of (voting|tallying|deposit|message_based|optimistic), was %s", args[0])
 			}
 
 			return clientCtx.PrintObjectLegacy(out)
@@ -665,3 +691,93 @@ $ %s query gov proposer 1
 
 	return cmd
 }
+
+// GetCmdQueryVoteDelegations implements the command to query the vote delegations of a delegator.
+func GetCmdQueryVoteDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-delegations [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the governance vote delegations of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegates an account named to vote on its behalf.
+
+Example:
+$ %s query gov vote-delegations cosmos1...
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VoteDelegations(
+				cmd.Context(),
+				&v1.QueryVoteDelegationsRequest{Delegator: args[0], Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "vote delegations")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryVoteDelegators implements the command to query the vote delegations made to a delegate.
+func GetCmdQueryVoteDelegators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-delegators [delegate-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the governance vote delegations made to an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the accounts which named an account as their delegate to vote on their behalf.
+
+Example:
+$ %s query gov vote-delegators cosmos1...
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VoteDelegators(
+				cmd.Context(),
+				&v1.QueryVoteDelegatorsRequest{Delegate: args[0], Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "vote delegators")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
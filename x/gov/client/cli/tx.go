@@ -31,6 +31,9 @@ const (
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
 	flagMetadata     = "metadata"
+	flagMsgType      = "msg-type"
+	flagExpedited    = "expedited"
+	flagOptimistic   = "optimistic"
 	// Deprecated: only used for v1beta1 legacy proposals.
 	FlagProposal = "proposal"
 )
@@ -70,6 +73,10 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 		NewCmdVote(),
 		NewCmdWeightedVote(),
 		NewCmdSubmitProposal(),
+		NewCmdDelegateVote(),
+		NewCmdUndelegateVote(),
+		NewCmdRejectVoteDelegation(),
+		NewCmdCancelProposal(),
 
 		// Deprecated
 		cmdSubmitLegacyProp,
@@ -106,8 +113,15 @@ Where proposal.json contains:
   "metadata: "4pIMOgIGx1vZGU=", // base64-encoded metadata
   "deposit": "10stake"
 }
+
+An expedited proposal, which has a shorter voting period and a higher threshold,
+is submitted with the --%s flag. Its deposit must reach the expedited minimum deposit.
+
+An optimistic proposal, which passes unless enough of the bonded stake votes against it,
+is submitted with the --%s flag. Only the addresses authorized in the optimistic params
+can submit optimistic proposals.
 `,
-				version.AppName,
+				version.AppName, flagExpedited, flagOptimistic,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -121,7 +135,17 @@ Where proposal.json contains:
 				return err
 			}
 
-			msg, err := v1.NewMsgSubmitProposal(msgs, deposit, clientCtx.GetFromAddress().String(), metadata)
+			expedited, err := cmd.Flags().GetBool(flagExpedited)
+			if err != nil {
+				return err
+			}
+
+			optimistic, err := cmd.Flags().GetBool(flagOptimistic)
+			if err != nil {
+				return err
+			}
+
+			msg, err := v1.NewMsgSubmitProposal(msgs, deposit, clientCtx.GetFromAddress().String(), metadata, expedited, optimistic)
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
@@ -130,6 +154,8 @@ Where proposal.json contains:
 		},
 	}
 
+	cmd.Flags().Bool(flagExpedited, false, "Submit an expedited proposal")
+	cmd.Flags().Bool(flagOptimistic, false, "Submit an optimistic proposal")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -359,3 +385,178 @@ $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from
 
 	return cmd
 }
+
+// NewCmdDelegateVote implements the command to delegate governance votes.
+func NewCmdDelegateVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-vote [delegate]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Name a delegate voting on your behalf on governance proposals",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Name a delegate whose votes count for your staked voting power, unless
+you vote directly. Without the --%[1]s flag, the delegation applies to all
+proposals. With the --%[1]s flag, it only applies to the proposals made of
+messages of the given type, and takes precedence over the delegation applying
+to all proposals.
+
+Example:
+$ %[2]s tx gov delegate-vote cosmos1... --from mykey
+$ %[2]s tx gov delegate-vote cosmos1... --%[1]s /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade --from mykey
+`,
+				flagMsgType, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delegate, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msgType, err := cmd.Flags().GetString(flagMsgType)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgDelegateVote(clientCtx.GetFromAddress(), delegate, msgType)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagMsgType, "", "Type URL of the proposal messages the delegation applies to")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUndelegateVote implements the command to remove a governance vote delegation.
+func NewCmdUndelegateVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate-vote",
+		Args:  cobra.NoArgs,
+		Short: "Remove a governance vote delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the delegation of your governance votes applying to all proposals, or
+with the --%[1]s flag, to the proposals made of messages of the given type.
+
+Example:
+$ %[2]s tx gov undelegate-vote --from mykey
+$ %[2]s tx gov undelegate-vote --%[1]s /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade --from mykey
+`,
+				flagMsgType, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msgType, err := cmd.Flags().GetString(flagMsgType)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgUndelegateVote(clientCtx.GetFromAddress(), msgType)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagMsgType, "", "Type URL of the proposal messages of the delegation to remove")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdRejectVoteDelegation implements the command to decline a governance
+// vote delegation.
+func NewCmdRejectVoteDelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reject-vote-delegation [delegator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Decline a governance vote delegation made to you",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the delegation of the governance votes of the delegator to you applying
+to all proposals, or with the --%[1]s flag, to the proposals made of messages
+of the given type.
+
+Example:
+$ %[2]s tx gov reject-vote-delegation cosmos1... --from mykey
+$ %[2]s tx gov reject-vote-delegation cosmos1... --%[1]s /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade --from mykey
+`,
+				flagMsgType, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delegator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msgType, err := cmd.Flags().GetString(flagMsgType)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgRejectVoteDelegation(clientCtx.GetFromAddress(), delegator, msgType)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagMsgType, "", "Type URL of the proposal messages of the delegation to decline")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdCancelProposal implements the command to cancel a proposal.
+func NewCmdCancelProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a governance proposal before the end of its voting period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a governance proposal you submitted, before the end of its voting
+period. A share of the proposal deposits is burned or sent to the address set
+in the deposit params, and the rest is refunded to the depositors.
+
+Example:
+$ %s tx gov cancel-proposal 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msg := v1.NewMsgCancelProposal(proposalID, clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
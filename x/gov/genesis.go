@@ -15,6 +15,15 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 	k.SetDepositParams(ctx, *data.DepositParams)
 	k.SetVotingParams(ctx, *data.VotingParams)
 	k.SetTallyParams(ctx, *data.TallyParams)
+	k.SetMessageBasedParams(ctx, data.MessageBasedParams)
+
+	// the optimistic params were added after the other params, and default
+	// ones are used if they're not set
+	optimisticParams := v1.DefaultOptimisticParams()
+	if data.OptimisticParams != nil {
+		optimisticParams = *data.OptimisticParams
+	}
+	k.SetOptimisticParams(ctx, optimisticParams)
 
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
@@ -32,6 +41,10 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		k.SetVote(ctx, *vote)
 	}
 
+	for _, delegation := range data.VoteDelegations {
+		k.SetVoteDelegation(ctx, *delegation)
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case v1.StatusDepositPeriod:
@@ -60,6 +73,7 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *v1.GenesisState {
 	depositParams := k.GetDepositParams(ctx)
 	votingParams := k.GetVotingParams(ctx)
 	tallyParams := k.GetTallyParams(ctx)
+	optimisticParams := k.GetOptimisticParams(ctx)
 	proposals := k.GetProposals(ctx)
 
 	var proposalsDeposits v1.Deposits
@@ -80,5 +94,8 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *v1.GenesisState {
 		DepositParams:      &depositParams,
 		VotingParams:       &votingParams,
 		TallyParams:        &tallyParams,
+		VoteDelegations:    k.GetAllVoteDelegations(ctx),
+		MessageBasedParams: k.GetMessageBasedParams(ctx),
+		OptimisticParams:   &optimisticParams,
 	}
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
@@ -131,7 +132,8 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	depositParams, _ := keeper.GetProposalParams(ctx, proposal)
+	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(proposal.GetMinDepositFromParams(depositParams)) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -162,6 +164,56 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	return activatedVotingPeriod, nil
 }
 
+// ChargeDeposit charges the given share of the deposits on a specific proposal,
+// refunds the rest to the depositors and deletes the deposits. The charged
+// coins are burned if destAddress is empty, sent to the community pool if it's
+// the distribution module account address, and sent to destAddress otherwise.
+func (keeper Keeper) ChargeDeposit(ctx sdk.Context, proposalID uint64, destAddress, cancelRatio string) error {
+	ratio, err := sdk.NewDecFromStr(cancelRatio)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	charges := sdk.NewCoins()
+
+	for _, deposit := range keeper.GetDeposits(ctx, proposalID) {
+		depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
+
+		refund := sdk.NewCoins()
+		for _, coin := range deposit.Amount {
+			charge := sdk.NewDecFromInt(coin.Amount).Mul(ratio).TruncateInt()
+			charges = charges.Add(sdk.NewCoin(coin.Denom, charge))
+			refund = refund.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(charge)))
+		}
+
+		if !refund.IsZero() {
+			if err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, refund); err != nil {
+				return err
+			}
+		}
+
+		store.Delete(types.DepositKey(proposalID, depositor))
+	}
+
+	if charges.IsZero() {
+		return nil
+	}
+
+	switch {
+	case destAddress == "":
+		return keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, charges)
+	case destAddress == keeper.authKeeper.GetModuleAddress(distrtypes.ModuleName).String():
+		return keeper.distrKeeper.FundCommunityPool(ctx, charges, keeper.GetGovernanceAccount(ctx).GetAddress())
+	default:
+		dest, err := sdk.AccAddressFromBech32(destAddress)
+		if err != nil {
+			return err
+		}
+		return keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, dest, charges)
+	}
+}
+
 // RefundAndDeleteDeposits refunds and deletes all the deposits on a specific proposal.
 func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -8,6 +8,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 func TestDeposits(t *testing.T) {
@@ -17,7 +20,7 @@ func TestDeposits(t *testing.T) {
 	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 
@@ -101,7 +104,7 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr1Initial, app.BankKeeper.GetAllBalances(ctx, TestAddrs[1]))
 
 	// Test delete and burn deposits
-	proposal, err = app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err = app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false, false)
 	require.NoError(t, err)
 	proposalID = proposal.Id
 	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
@@ -111,3 +114,92 @@ func TestDeposits(t *testing.T) {
 	require.Len(t, deposits, 0)
 	require.Equal(t, addr0Initial.Sub(fourStake...), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 }
+
+func TestChargeDeposit(t *testing.T) {
+	testCases := []struct {
+		name     string
+		destAddr func(app *simapp.SimApp, ctx sdk.Context) string
+	}{
+		{
+			name: "burn",
+			destAddr: func(app *simapp.SimApp, ctx sdk.Context) string {
+				return ""
+			},
+		},
+		{
+			name: "community pool",
+			destAddr: func(app *simapp.SimApp, ctx sdk.Context) string {
+				return app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName).String()
+			},
+		},
+		{
+			name: "destination address",
+			destAddr: func(app *simapp.SimApp, ctx sdk.Context) string {
+				return addr.String()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+			TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000000))
+			addr0Initial := app.BankKeeper.GetAllBalances(ctx, TestAddrs[0])
+			supplyInitial := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+			communityPoolInitial := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", TestAddrs[0], false, false)
+			require.NoError(t, err)
+			deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))
+			_, err = app.GovKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], deposit)
+			require.NoError(t, err)
+
+			destAddr := tc.destAddr(app, ctx)
+			err = app.GovKeeper.ChargeDeposit(ctx, proposal.Id, destAddr, "0.5")
+			require.NoError(t, err)
+			require.Empty(t, app.GovKeeper.GetDeposits(ctx, proposal.Id))
+
+			// the charge is truncated, so 2 of the 5 deposited tokens are charged
+			charge := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2))
+			require.Equal(t, addr0Initial.Sub(charge...), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
+
+			switch tc.name {
+			case "burn":
+				require.Equal(t, supplyInitial.Sub(charge[0]), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+			case "community pool":
+				require.Equal(t, communityPoolInitial.Add(sdk.NewDecCoinsFromCoins(charge...)...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+			default:
+				require.Equal(t, charge, app.BankKeeper.GetAllBalances(ctx, addr))
+			}
+		})
+	}
+}
+
+func TestDepositMessageBasedParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(100000000))
+
+	// TestProposal contains a MsgSend, whose minimum deposit is twice the
+	// default one
+	minDeposit := app.GovKeeper.GetDepositParams(ctx).MinDeposit
+	sendParams := v1.NewMessageBasedParams(
+		sdk.MsgTypeURL(&banktypes.MsgSend{}), sdk.NewCoins(minDeposit...).Add(minDeposit...),
+		v1.DefaultQuorum, v1.DefaultThreshold, v1.DefaultVetoThreshold,
+	)
+	app.GovKeeper.SetMessageBasedParams(ctx, []*v1.MessageBasedParams{&sendParams})
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", TestAddrs[0], false, false)
+	require.NoError(t, err)
+
+	votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], minDeposit)
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+
+	votingStarted, err = app.GovKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], minDeposit)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+}
@@ -172,6 +172,13 @@ func (q Keeper) Params(c context.Context, req *v1.QueryParamsRequest) (*v1.Query
 		tallyParams := q.GetTallyParams(ctx)
 		return &v1.QueryParamsResponse{TallyParams: &tallyParams}, nil
 
+	case v1.ParamMessageBased:
+		return &v1.QueryParamsResponse{MessageBasedParams: q.GetMessageBasedParams(ctx)}, nil
+
+	case v1.ParamOptimistic:
+		optimisticParams := q.GetOptimisticParams(ctx)
+		return &v1.QueryParamsResponse{OptimisticParams: &optimisticParams}, nil
+
 	default:
 		return nil, status.Errorf(codes.InvalidArgument,
 			"%s is not a valid parameter type", req.ParamsType)
@@ -273,6 +280,78 @@ func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest)
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// VoteDelegations queries the vote delegations of a delegator
+func (q Keeper) VoteDelegations(c context.Context, req *v1.QueryVoteDelegationsRequest) (*v1.QueryVoteDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Delegator == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	delegator, err := sdk.AccAddressFromBech32(req.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	var delegations []*v1.VoteDelegation
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	delegationsStore := prefix.NewStore(store, types.VoteDelegationsKey(delegator))
+
+	pageRes, err := query.Paginate(delegationsStore, req.Pagination, func(key []byte, value []byte) error {
+		var delegation v1.VoteDelegation
+		if err := q.cdc.Unmarshal(value, &delegation); err != nil {
+			return err
+		}
+
+		delegations = append(delegations, &delegation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryVoteDelegationsResponse{VoteDelegations: delegations, Pagination: pageRes}, nil
+}
+
+// VoteDelegators queries the vote delegations made to a delegate
+func (q Keeper) VoteDelegators(c context.Context, req *v1.QueryVoteDelegatorsRequest) (*v1.QueryVoteDelegatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Delegate == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegate address")
+	}
+
+	delegate, err := sdk.AccAddressFromBech32(req.Delegate)
+	if err != nil {
+		return nil, err
+	}
+
+	var delegations []*v1.VoteDelegation
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	delegatorsStore := prefix.NewStore(store, types.VoteDelegatorsKey(delegate))
+
+	pageRes, err := query.Paginate(delegatorsStore, req.Pagination, func(key []byte, _ []byte) error {
+		delegator, msgTypeURL := types.SplitVoteDelegatorKey(key)
+		delegation := v1.NewVoteDelegation(delegator, delegate, msgTypeURL)
+
+		delegations = append(delegations, &delegation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryVoteDelegatorsResponse{VoteDelegations: delegations, Pagination: pageRes}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct {
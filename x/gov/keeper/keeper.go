@@ -21,8 +21,9 @@ type Keeper struct {
 	// The reference to the Paramstore to get and set gov specific params
 	paramSpace types.ParamSubspace
 
-	authKeeper types.AccountKeeper
-	bankKeeper types.BankKeeper
+	authKeeper  types.AccountKeeper
+	bankKeeper  types.BankKeeper
+	distrKeeper types.DistributionKeeper
 
 	// The reference to the DelegationSet and ValidatorSet to get information about validators and delegators
 	sk types.StakingKeeper
@@ -55,7 +56,7 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace types.ParamSubspace,
 	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, sk types.StakingKeeper,
-	router *baseapp.MsgServiceRouter, config types.Config,
+	distrKeeper types.DistributionKeeper, router *baseapp.MsgServiceRouter, config types.Config,
 ) *Keeper {
 	// ensure governance module account is set
 	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -67,15 +68,26 @@ func NewKeeper(
 		config.MaxMetadataLen = types.DefaultConfig().MaxMetadataLen
 	}
 
+	// If MaxVoteDelegations not set by app developer, set to default value.
+	if config.MaxVoteDelegations == 0 {
+		config.MaxVoteDelegations = types.DefaultConfig().MaxVoteDelegations
+	}
+
+	// If MinVoteDelegationStake not set by app developer, set to default value.
+	if config.MinVoteDelegationStake.IsNil() || !config.MinVoteDelegationStake.IsPositive() {
+		config.MinVoteDelegationStake = types.DefaultConfig().MinVoteDelegationStake
+	}
+
 	return &Keeper{
-		storeKey:   key,
-		paramSpace: paramSpace,
-		authKeeper: authKeeper,
-		bankKeeper: bankKeeper,
-		sk:         sk,
-		cdc:        cdc,
-		router:     router,
-		config:     config,
+		storeKey:    key,
+		paramSpace:  paramSpace,
+		authKeeper:  authKeeper,
+		bankKeeper:  bankKeeper,
+		distrKeeper: distrKeeper,
+		sk:          sk,
+		cdc:         cdc,
+		router:      router,
+		config:      config,
 	}
 }
 
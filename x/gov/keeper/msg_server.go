@@ -36,7 +36,12 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, err
 	}
 
-	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata)
+	proposer, err := sdk.AccAddressFromBech32(msg.GetProposer())
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, proposer, msg.Expedited, msg.Optimistic)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +59,6 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 
 	defer telemetry.IncrCounter(1, types.ModuleName, "proposal")
 
-	proposer, _ := sdk.AccAddressFromBech32(msg.GetProposer())
 	votingStarted, err := k.Keeper.AddDeposit(ctx, proposal.Id, proposer, msg.GetInitialDeposit())
 	if err != nil {
 		return nil, err
@@ -206,6 +210,105 @@ func (k msgServer) Deposit(goCtx context.Context, msg *v1.MsgDeposit) (*v1.MsgDe
 	return &v1.MsgDepositResponse{}, nil
 }
 
+func (k msgServer) DelegateVote(goCtx context.Context, msg *v1.MsgDelegateVote) (*v1.MsgDelegateVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	delegatorAddr, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+	delegateAddr, err := sdk.AccAddressFromBech32(msg.Delegate)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.AddVoteDelegation(ctx, delegatorAddr, delegateAddr, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator),
+		),
+	)
+
+	return &v1.MsgDelegateVoteResponse{}, nil
+}
+
+func (k msgServer) UndelegateVote(goCtx context.Context, msg *v1.MsgUndelegateVote) (*v1.MsgUndelegateVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	delegatorAddr, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RemoveVoteDelegation(ctx, delegatorAddr, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator),
+		),
+	)
+
+	return &v1.MsgUndelegateVoteResponse{}, nil
+}
+
+func (k msgServer) RejectVoteDelegation(goCtx context.Context, msg *v1.MsgRejectVoteDelegation) (*v1.MsgRejectVoteDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	delegateAddr, err := sdk.AccAddressFromBech32(msg.Delegate)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddr, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RejectVoteDelegation(ctx, delegateAddr, delegatorAddr, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegate),
+		),
+	)
+
+	return &v1.MsgRejectVoteDelegationResponse{}, nil
+}
+
+func (k msgServer) CancelProposal(goCtx context.Context, msg *v1.MsgCancelProposal) (*v1.MsgCancelProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CancelProposal(ctx, msg.ProposalId, msg.Proposer); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer),
+		),
+	)
+
+	return &v1.MsgCancelProposalResponse{
+		ProposalId:     msg.ProposalId,
+		CanceledTime:   ctx.BlockTime(),
+		CanceledHeight: uint64(ctx.BlockHeight()),
+	}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
@@ -230,6 +333,8 @@ func (k legacyMsgServer) SubmitProposal(goCtx context.Context, msg *v1beta1.MsgS
 		msg.InitialDeposit,
 		msg.Proposer,
 		"",
+		false,
+		false,
 	)
 	if err != nil {
 		return nil, err
@@ -36,6 +36,8 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					strings.Repeat("1", 300),
+					false,
+					false,
 				)
 			},
 			expErr:    true,
@@ -48,6 +50,8 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					"",
+					false,
+					false,
 				)
 			},
 			expErr:    true,
@@ -60,6 +64,8 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					"",
+					false,
+					false,
 				)
 			},
 			expErr:    true,
@@ -72,6 +78,8 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					"",
+					false,
+					false,
 				)
 			},
 			expErr:    true,
@@ -84,6 +92,8 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					"",
+					false,
+					false,
 				)
 			},
 			expErr: false,
@@ -95,6 +105,8 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
+					false,
 				)
 			},
 			expErr: false,
@@ -135,6 +147,8 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 		minDeposit,
 		proposer.String(),
 		"",
+		false,
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -158,6 +172,8 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 					coins,
 					proposer.String(),
 					"",
+					false,
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -199,6 +215,8 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -247,6 +265,8 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 		minDeposit,
 		proposer.String(),
 		"",
+		false,
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -271,6 +291,8 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 					coins,
 					proposer.String(),
 					"",
+					false,
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -312,6 +334,8 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -360,6 +384,8 @@ func (suite *KeeperTestSuite) TestDepositReq() {
 		coins,
 		proposer.String(),
 		"",
+		false,
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -410,6 +436,112 @@ func (suite *KeeperTestSuite) TestDepositReq() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCancelProposalReq() {
+	govAcct := suite.app.GovKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	addrs := suite.addrs
+	proposer := addrs[0]
+
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   proposer.String(),
+		Amount:      coins,
+	}
+
+	msg, err := v1.NewMsgSubmitProposal(
+		[]sdk.Msg{bankMsg},
+		coins,
+		proposer.String(),
+		"",
+		false,
+		false,
+	)
+	suite.Require().NoError(err)
+
+	res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res.ProposalId)
+	pId := res.ProposalId
+
+	// the cases run in order, as the last one cancels the proposal already
+	// canceled by the previous one
+	cases := []struct {
+		name     string
+		preRun   func() uint64
+		expErr   bool
+		proposer sdk.AccAddress
+	}{
+		{
+			name: "wrong proposal id",
+			preRun: func() uint64 {
+				return 0
+			},
+			proposer: proposer,
+			expErr:   true,
+		},
+		{
+			name: "not the proposer",
+			preRun: func() uint64 {
+				return pId
+			},
+			proposer: addrs[1],
+			expErr:   true,
+		},
+		{
+			name: "voting period ended",
+			preRun: func() uint64 {
+				res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+				suite.Require().NoError(err)
+
+				proposal, found := suite.app.GovKeeper.GetProposal(suite.ctx, res.ProposalId)
+				suite.Require().True(found)
+				votingEndTime := suite.ctx.BlockTime()
+				proposal.Status = v1.StatusVotingPeriod
+				proposal.VotingEndTime = &votingEndTime
+				suite.app.GovKeeper.SetProposal(suite.ctx, proposal)
+
+				return res.ProposalId
+			},
+			proposer: proposer,
+			expErr:   true,
+		},
+		{
+			name: "all good",
+			preRun: func() uint64 {
+				return pId
+			},
+			proposer: proposer,
+			expErr:   false,
+		},
+		{
+			name: "already canceled",
+			preRun: func() uint64 {
+				return pId
+			},
+			proposer: proposer,
+			expErr:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			proposalId := tc.preRun()
+			cancelReq := v1.NewMsgCancelProposal(proposalId, tc.proposer)
+			res, err := suite.msgSrvr.CancelProposal(suite.ctx, cancelReq)
+			if tc.expErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(proposalId, res.ProposalId)
+
+				_, found := suite.app.GovKeeper.GetProposal(suite.ctx, proposalId)
+				suite.Require().False(found)
+				suite.Require().Empty(suite.app.GovKeeper.GetDeposits(suite.ctx, proposalId))
+			}
+		})
+	}
+}
+
 // legacy msg server tests
 func (suite *KeeperTestSuite) TestLegacyMsgSubmitProposal() {
 	addrs := suite.addrs
@@ -478,6 +610,8 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 		minDeposit,
 		proposer.String(),
 		"",
+		false,
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -501,6 +635,8 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 					coins,
 					proposer.String(),
 					"",
+					false,
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -532,6 +668,8 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -580,6 +718,8 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 		minDeposit,
 		proposer.String(),
 		"",
+		false,
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -604,6 +744,8 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 					coins,
 					proposer.String(),
 					"",
+					false,
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -635,6 +777,8 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -683,6 +827,8 @@ func (suite *KeeperTestSuite) TestLegacyMsgDeposit() {
 		coins,
 		proposer.String(),
 		"",
+		false,
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -40,3 +40,42 @@ func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams v1.VotingPara
 func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams v1.TallyParams) {
 	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
 }
+
+// GetMessageBasedParams returns the current MessageBasedParams from the global param store
+func (keeper Keeper) GetMessageBasedParams(ctx sdk.Context) []*v1.MessageBasedParams {
+	var messageBasedParams []*v1.MessageBasedParams
+	keeper.paramSpace.Get(ctx, v1.ParamStoreKeyMessageBasedParams, &messageBasedParams)
+	return messageBasedParams
+}
+
+// SetMessageBasedParams sets MessageBasedParams to the global param store
+func (keeper Keeper) SetMessageBasedParams(ctx sdk.Context, messageBasedParams []*v1.MessageBasedParams) {
+	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyMessageBasedParams, &messageBasedParams)
+}
+
+// GetOptimisticParams returns the current OptimisticParams from the global param store
+func (keeper Keeper) GetOptimisticParams(ctx sdk.Context) v1.OptimisticParams {
+	var optimisticParams v1.OptimisticParams
+	keeper.paramSpace.Get(ctx, v1.ParamStoreKeyOptimisticParams, &optimisticParams)
+	return optimisticParams
+}
+
+// SetOptimisticParams sets OptimisticParams to the global param store
+func (keeper Keeper) SetOptimisticParams(ctx sdk.Context, optimisticParams v1.OptimisticParams) {
+	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyOptimisticParams, &optimisticParams)
+}
+
+// GetProposalParams returns the deposit and tally params applying to a
+// proposal, taking the MessageBasedParams of its messages into account. The
+// type URLs of the messages are read from their Any, so that the params of a
+// proposal whose messages can't be unpacked are still known.
+func (keeper Keeper) GetProposalParams(ctx sdk.Context, proposal v1.Proposal) (v1.DepositParams, v1.TallyParams) {
+	msgTypeURLs := make([]string, len(proposal.Messages))
+	for i, msg := range proposal.Messages {
+		msgTypeURLs[i] = msg.TypeUrl
+	}
+
+	return v1.ApplyMessageBasedParams(
+		keeper.GetDepositParams(ctx), keeper.GetTallyParams(ctx), keeper.GetMessageBasedParams(ctx), msgTypeURLs,
+	)
+}
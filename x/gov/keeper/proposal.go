@@ -10,13 +10,18 @@ import (
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
-// SubmitProposal creates a new proposal given an array of messages
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata string) (v1.Proposal, error) {
+// SubmitProposal creates a new proposal given an array of messages. Only the
+// addresses authorized in the OptimisticParams can submit optimistic proposals.
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata string, proposer sdk.AccAddress, expedited, optimistic bool) (v1.Proposal, error) {
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
 
+	if optimistic && !keeper.GetOptimisticParams(ctx).IsAuthorized(proposer.String()) {
+		return v1.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidProposer, "%s is not authorized to submit optimistic proposals", proposer)
+	}
+
 	// Will hold a comma-separated string of all Msg type URLs.
 	msgsStr := ""
 
@@ -68,7 +73,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	submitTime := ctx.BlockHeader().Time
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
-	proposal, err := v1.NewProposal(messages, proposalID, metadata, submitTime, submitTime.Add(*depositPeriod))
+	proposal, err := v1.NewProposal(messages, proposalID, metadata, submitTime, submitTime.Add(*depositPeriod), proposer, expedited, optimistic)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
@@ -91,6 +96,56 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	return proposal, nil
 }
 
+// CancelProposal cancels a proposal on behalf of its proposer before the end
+// of its voting period. A share of the deposits, set by the ProposalCancelRatio
+// param, is charged and the rest is refunded to the depositors.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer string) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	// proposals submitted before proposers were recorded can't be canceled
+	if proposal.Proposer == "" || proposal.Proposer != proposer {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "%s is not the proposer of proposal %d", proposer, proposalID)
+	}
+
+	if proposal.Status != v1.StatusDepositPeriod && proposal.Status != v1.StatusVotingPeriod {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "proposal %d is %s", proposalID, proposal.Status)
+	}
+
+	if proposal.VotingEndTime != nil && !ctx.BlockTime().Before(*proposal.VotingEndTime) {
+		return sdkerrors.Wrapf(types.ErrVotingPeriodEnded, "proposal %d", proposalID)
+	}
+
+	depositParams := keeper.GetDepositParams(ctx)
+	if err := keeper.ChargeDeposit(ctx, proposalID, depositParams.ProposalCancelDest, depositParams.ProposalCancelRatio); err != nil {
+		return err
+	}
+
+	for _, vote := range keeper.GetVotes(ctx, proposalID) {
+		keeper.deleteVote(ctx, proposalID, sdk.MustAccAddressFromBech32(vote.Voter))
+	}
+
+	keeper.DeleteProposal(ctx, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposer, proposer),
+		),
+	)
+
+	keeper.Logger(ctx).Info(
+		"proposal canceled by its proposer",
+		"proposal", proposalID,
+		"proposer", proposer,
+	)
+
+	return nil
+}
+
 // GetProposal gets a proposal from store by ProposalID.
 // Panics if can't unmarshal the proposal.
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (v1.Proposal, bool) {
@@ -237,8 +292,8 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal v1.Proposal) {
 	startTime := ctx.BlockHeader().Time
 	proposal.VotingStartTime = &startTime
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
-	endTime := proposal.VotingStartTime.Add(*votingPeriod)
+	votingPeriod := proposal.GetVotingPeriodFromParams(keeper.GetVotingParams(ctx))
+	endTime := proposal.VotingStartTime.Add(votingPeriod)
 	proposal.VotingEndTime = &endTime
 	proposal.Status = v1.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
@@ -18,7 +18,7 @@ import (
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "")
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "", addr, false, false)
 	suite.Require().NoError(err)
 	proposalID := proposal.Id
 	suite.app.GovKeeper.SetProposal(suite.ctx, proposal)
@@ -30,7 +30,7 @@ func (suite *KeeperTestSuite) TestGetSetProposal() {
 
 func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "")
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "", addr, false, false)
 	suite.Require().NoError(err)
 
 	suite.Require().Nil(proposal.VotingStartTime)
@@ -81,11 +81,28 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	for i, tc := range testCases {
 		prop, err := v1.NewLegacyContent(tc.content, tc.authority)
 		suite.Require().NoError(err)
-		_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata)
+		_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata, addr, false, false)
 		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitOptimisticProposal() {
+	_, _, authorized := testdata.KeyTestPubAddr()
+	suite.app.GovKeeper.SetOptimisticParams(suite.ctx, v1.NewOptimisticParams([]string{authorized.String()}, v1.DefaultOptimisticRejectedThreshold))
+
+	_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal, "", addr, false, true)
+	suite.Require().ErrorIs(err, types.ErrInvalidProposer)
+
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal, "", authorized, false, true)
+	suite.Require().NoError(err)
+	suite.Require().True(proposal.Optimistic)
+
+	// the optimistic params don't restrict regular proposals
+	proposal, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal, "", addr, false, false)
+	suite.Require().NoError(err)
+	suite.Require().False(proposal.Optimistic)
+}
+
 func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 	proposalID := uint64(1)
 	status := []v1.ProposalStatus{v1.StatusDepositPeriod, v1.StatusVotingPeriod}
@@ -94,7 +111,7 @@ func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 
 	for _, s := range status {
 		for i := 0; i < 50; i++ {
-			p, err := v1.NewProposal(TestProposal, proposalID, "", time.Now(), time.Now())
+			p, err := v1.NewProposal(TestProposal, proposalID, "", time.Now(), time.Now(), addr, false, false)
 			suite.Require().NoError(err)
 
 			p.Status = s
@@ -33,16 +33,10 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 		return false
 	})
 
-	keeper.IterateVotes(ctx, proposal.Id, func(vote v1.Vote) bool {
-		// if validator, just record it in the map
-		voter := sdk.MustAccAddressFromBech32(vote.Voter)
-
-		valAddrStr := sdk.ValAddress(voter.Bytes()).String()
-		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Options
-			currValidators[valAddrStr] = val
-		}
-
+	// countDelegations adds the voting power of the voter's delegations to the
+	// results, split according to the vote options, and deducts it from the
+	// delegated-to validators.
+	countDelegations := func(voter sdk.AccAddress, options v1.WeightedVoteOptions) {
 		// iterate over all delegations from voter, deduct from any delegated-to validators
 		keeper.sk.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
 			valAddrStr := delegation.GetValidatorAddr().String()
@@ -56,7 +50,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 				// delegation shares * bonded / total shares
 				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
 
-				for _, option := range vote.Options {
+				for _, option := range options {
 					weight, _ := sdk.NewDecFromStr(option.Weight)
 					subPower := votingPower.Mul(weight)
 					results[option.Option] = results[option.Option].Add(subPower)
@@ -66,10 +60,64 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 
 			return false
 		})
+	}
 
-		keeper.deleteVote(ctx, vote.ProposalId, voter)
-		return false
-	})
+	votes := keeper.GetVotes(ctx, proposal.Id)
+	counted := make(map[string]bool, len(votes))
+	for _, vote := range votes {
+		counted[vote.Voter] = true
+	}
+
+	for _, vote := range votes {
+		// if validator, just record it in the map
+		voter := sdk.MustAccAddressFromBech32(vote.Voter)
+
+		valAddrStr := sdk.ValAddress(voter.Bytes()).String()
+		if val, ok := currValidators[valAddrStr]; ok {
+			val.Vote = vote.Options
+			currValidators[valAddrStr] = val
+		}
+
+		countDelegations(voter, vote.Options)
+	}
+
+	// the vote of a delegate counts for the accounts which delegated their
+	// votes on this proposal to it, unless they voted directly. The delegates
+	// of a proposal whose msgs can't be unpacked are unknown.
+	msgs, msgsErr := proposal.GetMsgs()
+	if msgsErr != nil {
+		keeper.Logger(ctx).Error("failed to unpack proposal msgs", "proposal", proposal.Id, "err", msgsErr)
+	} else {
+		msgTypeURL := v1.ProposalMsgTypeURL(msgs)
+		for _, vote := range votes {
+			delegate := sdk.MustAccAddressFromBech32(vote.Voter)
+
+			keeper.IterateVoteDelegators(ctx, delegate, func(delegator sdk.AccAddress, _ string) bool {
+				if counted[delegator.String()] {
+					return false
+				}
+				if proposalDelegate, found := keeper.getProposalDelegate(ctx, delegator, msgTypeURL); !found || proposalDelegate != vote.Voter {
+					return false
+				}
+
+				counted[delegator.String()] = true
+				countDelegations(delegator, vote.Options)
+				return false
+			})
+		}
+	}
+
+	defer func() {
+		// the votes on a failed expedited proposal are kept, as it's converted
+		// to a regular proposal which is tallied again at the end of its
+		// voting period
+		if proposal.Expedited && !passes {
+			return
+		}
+		for _, vote := range votes {
+			keeper.deleteVote(ctx, vote.ProposalId, sdk.MustAccAddressFromBech32(vote.Voter))
+		}
+	}()
 
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
@@ -88,15 +136,32 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
-	tallyParams := keeper.GetTallyParams(ctx)
+	_, tallyParams := keeper.GetProposalParams(ctx, proposal)
 	tallyResults = v1.NewTallyResultFromMap(results)
 
+	// A proposal whose msgs can't be unpacked can't be executed, so it fails
+	// whatever its votes
+	if msgsErr != nil {
+		return false, false, tallyResults
+	}
+
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
 	// If there is no staked coins, the proposal fails
 	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
 		return false, false, tallyResults
 	}
 
+	// An optimistic proposal passes unless the No and NoWithVeto votes reach
+	// the rejected threshold of the total bonded stake
+	if proposal.Optimistic {
+		rejectedPower := results[v1.OptionNo].Add(results[v1.OptionNoWithVeto])
+		rejectedThreshold, _ := sdk.NewDecFromStr(keeper.GetOptimisticParams(ctx).RejectedThreshold)
+		if rejectedPower.Quo(sdk.NewDecFromInt(keeper.sk.TotalBondedTokens(ctx))).GTE(rejectedThreshold) {
+			return false, false, tallyResults
+		}
+		return true, false, tallyResults
+	}
+
 	// If there is not enough quorum of votes, the proposal fails
 	percentVoting := totalVotingPower.Quo(sdk.NewDecFromInt(keeper.sk.TotalBondedTokens(ctx)))
 	quorum, _ := sdk.NewDecFromStr(tallyParams.Quorum)
@@ -116,7 +181,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 	}
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	threshold, _ := sdk.NewDecFromStr(tallyParams.Threshold)
+	threshold, _ := sdk.NewDecFromStr(proposal.GetThresholdFromParams(tallyParams))
 	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults
 	}
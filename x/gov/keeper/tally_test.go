@@ -6,8 +6,10 @@ import (
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -20,7 +22,7 @@ func TestTallyNoOneVotes(t *testing.T) {
 	createValidators(t, ctx, app, []int64{5, 5, 5})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -35,6 +37,30 @@ func TestTallyNoOneVotes(t *testing.T) {
 	require.True(t, tallyResults.Equals(v1.EmptyTallyResult()))
 }
 
+func TestTallyInvalidMsgs(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, _ := createValidators(t, ctx, app, []int64{5, 5, 5})
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false, false)
+	require.NoError(t, err)
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	for _, voter := range addrs {
+		require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.Id, voter, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	}
+
+	// a proposal with a msg which can't be unpacked fails, whatever its votes
+	proposal.Messages = []*codectypes.Any{{TypeUrl: "/cosmos.gov.v1.Unknown"}}
+	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+	require.False(t, tallyResults.Equals(v1.EmptyTallyResult()))
+}
+
 func TestTallyNoQuorum(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -44,7 +70,7 @@ func TestTallyNoQuorum(t *testing.T) {
 	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -67,7 +93,7 @@ func TestTallyOnlyValidatorsAllYes(t *testing.T) {
 	addrs, _ := createValidators(t, ctx, app, []int64{5, 5, 5})
 	tp := TestProposal
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -93,7 +119,7 @@ func TestTallyOnlyValidators51No(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{5, 6, 0})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -117,7 +143,7 @@ func TestTallyOnlyValidators51Yes(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{5, 6, 0})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -142,7 +168,7 @@ func TestTallyOnlyValidatorsVetoed(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{6, 6, 7})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -168,7 +194,7 @@ func TestTallyOnlyValidatorsAbstainPasses(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{6, 6, 7})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -194,7 +220,7 @@ func TestTallyOnlyValidatorsAbstainFails(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{6, 6, 7})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -221,7 +247,7 @@ func TestTallyOnlyValidatorsNonVoter(t *testing.T) {
 	valAccAddr1, valAccAddr2 := valAccAddrs[0], valAccAddrs[1]
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -255,7 +281,7 @@ func TestTallyDelgatorOverride(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -291,7 +317,7 @@ func TestTallyDelgatorInherit(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -330,7 +356,7 @@ func TestTallyDelgatorMultipleOverride(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -372,7 +398,7 @@ func TestTallyDelgatorMultipleInherit(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -415,7 +441,7 @@ func TestTallyJailedValidator(t *testing.T) {
 	app.StakingKeeper.Jail(ctx, sdk.ConsAddress(consAddr.Bytes()))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -448,7 +474,7 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 	require.NoError(t, err)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -473,3 +499,124 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	require.True(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestTallyMessageBasedParams(t *testing.T) {
+	testCases := []struct {
+		name      string
+		threshold sdk.Dec
+		expPass   bool
+	}{
+		{
+			name:      "message based threshold met",
+			threshold: sdk.NewDecWithPrec(6, 1),
+			expPass:   true,
+		},
+		{
+			name:      "message based threshold not met",
+			threshold: sdk.NewDecWithPrec(7, 1),
+			expPass:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+			addrs, _ := createValidators(t, ctx, app, []int64{5, 5, 5})
+
+			// TestProposal contains a MsgSend, which uses the message based
+			// threshold as it's stricter than the default one
+			sendParams := v1.NewMessageBasedParams(
+				sdk.MsgTypeURL(&banktypes.MsgSend{}), nil,
+				v1.DefaultQuorum, tc.threshold, v1.DefaultVetoThreshold,
+			)
+			app.GovKeeper.SetMessageBasedParams(ctx, []*v1.MessageBasedParams{&sendParams})
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false, false)
+			require.NoError(t, err)
+			proposalID := proposal.Id
+			proposal.Status = v1.StatusVotingPeriod
+			app.GovKeeper.SetProposal(ctx, proposal)
+
+			// 2/3 of the voting power votes Yes
+			require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+			require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+			require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+			passes, burnDeposits, _ := app.GovKeeper.Tally(ctx, proposal)
+
+			require.Equal(t, tc.expPass, passes)
+			require.False(t, burnDeposits)
+		})
+	}
+}
+
+func TestTallyOptimistic(t *testing.T) {
+	testCases := []struct {
+		name              string
+		rejectedThreshold sdk.Dec
+		options           []v1.VoteOption
+		expPass           bool
+	}{
+		{
+			name:              "no votes",
+			rejectedThreshold: v1.DefaultOptimisticRejectedThreshold,
+			expPass:           true,
+		},
+		{
+			name:              "yes votes",
+			rejectedThreshold: v1.DefaultOptimisticRejectedThreshold,
+			options:           []v1.VoteOption{v1.OptionYes, v1.OptionYes},
+			expPass:           true,
+		},
+		{
+			name:              "no votes reach rejected threshold",
+			rejectedThreshold: v1.DefaultOptimisticRejectedThreshold,
+			options:           []v1.VoteOption{v1.OptionYes, v1.OptionYes, v1.OptionNo},
+			expPass:           false,
+		},
+		{
+			name:              "no and veto votes below rejected threshold",
+			rejectedThreshold: sdk.NewDecWithPrec(5, 1),
+			options:           []v1.VoteOption{v1.OptionNoWithVeto},
+			expPass:           true,
+		},
+		{
+			name:              "no and veto votes reach rejected threshold",
+			rejectedThreshold: sdk.NewDecWithPrec(5, 1),
+			options:           []v1.VoteOption{v1.OptionNo, v1.OptionNoWithVeto},
+			expPass:           false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+			addrs, _ := createValidators(t, ctx, app, []int64{5, 5, 5})
+
+			app.GovKeeper.SetOptimisticParams(ctx, v1.NewOptimisticParams([]string{addr.String()}, tc.rejectedThreshold))
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false, true)
+			require.NoError(t, err)
+			proposalID := proposal.Id
+			proposal.Status = v1.StatusVotingPeriod
+			app.GovKeeper.SetProposal(ctx, proposal)
+
+			for i, option := range tc.options {
+				require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[i], v1.NewNonSplitVoteOption(option), ""))
+			}
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+			passes, burnDeposits, _ := app.GovKeeper.Tally(ctx, proposal)
+
+			require.Equal(t, tc.expPass, passes)
+			require.False(t, burnDeposits)
+		})
+	}
+}
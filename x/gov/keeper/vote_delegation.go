@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// AddVoteDelegation names a delegate voting on behalf of the delegator on the
+// proposals made of messages of the given type, or on all proposals if
+// msgTypeURL is empty. It replaces any existing delegation of the delegator
+// for the same msg type. A delegate can't be given more than the configured
+// MaxVoteDelegations, as all its delegations are iterated when its vote is
+// tallied, and only the delegators with at least MinVoteDelegationStake bonded
+// tokens can delegate their votes, so that these can't be taken by empty
+// accounts.
+func (keeper Keeper) AddVoteDelegation(ctx sdk.Context, delegatorAddr, delegateAddr sdk.AccAddress, msgTypeURL string) error {
+	delegation := v1.NewVoteDelegation(delegatorAddr, delegateAddr, msgTypeURL)
+	if err := delegation.Validate(); err != nil {
+		return err
+	}
+	if msgTypeURL != "" && keeper.router != nil && keeper.router.HandlerByTypeURL(msgTypeURL) == nil {
+		return sdkerrors.Wrapf(types.ErrInvalidVoteDelegation, "unknown msg type %s", msgTypeURL)
+	}
+	if bonded := keeper.bondedTokens(ctx, delegatorAddr); bonded.LT(keeper.config.MinVoteDelegationStake) {
+		return sdkerrors.Wrapf(types.ErrInsufficientVoteStake, "delegator %s has %s bonded tokens, minimum is %s", delegatorAddr, bonded, keeper.config.MinVoteDelegationStake)
+	}
+
+	// replacing the delegation of the delegator to the same delegate for the
+	// same msg type doesn't change the number of delegations of the delegate
+	if existing, found := keeper.GetVoteDelegation(ctx, delegatorAddr, msgTypeURL); !found || existing.Delegate != delegation.Delegate {
+		if keeper.countVoteDelegations(ctx, delegateAddr, keeper.config.MaxVoteDelegations) >= keeper.config.MaxVoteDelegations {
+			return sdkerrors.Wrapf(types.ErrTooManyVoteDelegations, "delegate %s already has %d vote delegations", delegateAddr, keeper.config.MaxVoteDelegations)
+		}
+	}
+
+	keeper.SetVoteDelegation(ctx, delegation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelegateVote,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegation.Delegator),
+			sdk.NewAttribute(types.AttributeKeyDelegate, delegation.Delegate),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+		),
+	)
+
+	return nil
+}
+
+// RemoveVoteDelegation removes the vote delegation of the delegator for the given
+// msg type.
+func (keeper Keeper) RemoveVoteDelegation(ctx sdk.Context, delegatorAddr sdk.AccAddress, msgTypeURL string) error {
+	if !keeper.deleteVoteDelegation(ctx, delegatorAddr, msgTypeURL) {
+		return sdkerrors.Wrapf(types.ErrVoteDelegationNotFound, "delegator %s, msg type %q", delegatorAddr, msgTypeURL)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUndelegateVote,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegatorAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+		),
+	)
+
+	return nil
+}
+
+// RejectVoteDelegation removes the vote delegation of the delegator for the
+// given msg type if it is made to the delegate, which lets a delegate decline
+// the delegations it doesn't want to hold.
+func (keeper Keeper) RejectVoteDelegation(ctx sdk.Context, delegateAddr, delegatorAddr sdk.AccAddress, msgTypeURL string) error {
+	delegation, found := keeper.GetVoteDelegation(ctx, delegatorAddr, msgTypeURL)
+	if !found || delegation.Delegate != delegateAddr.String() {
+		return sdkerrors.Wrapf(types.ErrVoteDelegationNotFound, "delegator %s, delegate %s, msg type %q", delegatorAddr, delegateAddr, msgTypeURL)
+	}
+
+	keeper.deleteVoteDelegation(ctx, delegatorAddr, msgTypeURL)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRejectVoteDelegation,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegation.Delegator),
+			sdk.NewAttribute(types.AttributeKeyDelegate, delegation.Delegate),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+		),
+	)
+
+	return nil
+}
+
+// GetVoteDelegation gets the vote delegation of a delegator for a msg type
+func (keeper Keeper) GetVoteDelegation(ctx sdk.Context, delegatorAddr sdk.AccAddress, msgTypeURL string) (delegation v1.VoteDelegation, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.VoteDelegationKey(delegatorAddr, msgTypeURL))
+	if bz == nil {
+		return delegation, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &delegation)
+
+	return delegation, true
+}
+
+// SetVoteDelegation sets a VoteDelegation to the gov store
+func (keeper Keeper) SetVoteDelegation(ctx sdk.Context, delegation v1.VoteDelegation) {
+	delegatorAddr := sdk.MustAccAddressFromBech32(delegation.Delegator)
+	delegateAddr := sdk.MustAccAddressFromBech32(delegation.Delegate)
+
+	keeper.deleteVoteDelegation(ctx, delegatorAddr, delegation.MsgTypeUrl)
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.VoteDelegationKey(delegatorAddr, delegation.MsgTypeUrl), keeper.cdc.MustMarshal(&delegation))
+	store.Set(types.VoteDelegatorKey(delegateAddr, delegatorAddr, delegation.MsgTypeUrl), []byte{})
+}
+
+// GetVoteDelegations returns all the vote delegations of a delegator
+func (keeper Keeper) GetVoteDelegations(ctx sdk.Context, delegatorAddr sdk.AccAddress) (delegations []v1.VoteDelegation) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteDelegationsKey(delegatorAddr))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation v1.VoteDelegation
+		keeper.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations
+}
+
+// GetAllVoteDelegations returns all the vote delegations from the store
+func (keeper Keeper) GetAllVoteDelegations(ctx sdk.Context) (delegations []*v1.VoteDelegation) {
+	keeper.IterateAllVoteDelegations(ctx, func(delegation v1.VoteDelegation) bool {
+		delegations = append(delegations, &delegation)
+		return false
+	})
+	return
+}
+
+// IterateAllVoteDelegations iterates over all the stored vote delegations and performs a callback function
+func (keeper Keeper) IterateAllVoteDelegations(ctx sdk.Context, cb func(delegation v1.VoteDelegation) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteDelegationsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation v1.VoteDelegation
+		keeper.cdc.MustUnmarshal(iterator.Value(), &delegation)
+
+		if cb(delegation) {
+			break
+		}
+	}
+}
+
+// IterateVoteDelegators iterates over the vote delegations made to a delegate
+// and performs a callback function
+func (keeper Keeper) IterateVoteDelegators(ctx sdk.Context, delegateAddr sdk.AccAddress, cb func(delegatorAddr sdk.AccAddress, msgTypeURL string) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	prefix := types.VoteDelegatorsKey(delegateAddr)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		delegatorAddr, msgTypeURL := types.SplitVoteDelegatorKey(iterator.Key()[len(prefix):])
+
+		if cb(delegatorAddr, msgTypeURL) {
+			break
+		}
+	}
+}
+
+// countVoteDelegations returns the number of vote delegations made to a
+// delegate, counting up to max only.
+func (keeper Keeper) countVoteDelegations(ctx sdk.Context, delegateAddr sdk.AccAddress, max uint64) uint64 {
+	var count uint64
+	keeper.IterateVoteDelegators(ctx, delegateAddr, func(_ sdk.AccAddress, _ string) bool {
+		count++
+		return count >= max
+	})
+	return count
+}
+
+// bondedTokens returns the tokens the delegator has delegated to bonded
+// validators, which make its voting power.
+func (keeper Keeper) bondedTokens(ctx sdk.Context, delegatorAddr sdk.AccAddress) math.Int {
+	tokens := sdk.ZeroDec()
+	keeper.sk.IterateDelegations(ctx, delegatorAddr, func(_ int64, delegation stakingtypes.DelegationI) bool {
+		if validator := keeper.sk.Validator(ctx, delegation.GetValidatorAddr()); validator != nil && validator.IsBonded() {
+			tokens = tokens.Add(validator.TokensFromShares(delegation.GetShares()))
+		}
+		return false
+	})
+	return tokens.TruncateInt()
+}
+
+// getProposalDelegate returns the delegate voting on behalf of a delegator on
+// the proposals whose messages all have the given type URL, which is empty for
+// the proposals with no messages or messages of mixed types. A delegation for
+// the msg type takes precedence over the delegation for all proposals.
+func (keeper Keeper) getProposalDelegate(ctx sdk.Context, delegatorAddr sdk.AccAddress, msgTypeURL string) (string, bool) {
+	if msgTypeURL != "" {
+		if delegation, found := keeper.GetVoteDelegation(ctx, delegatorAddr, msgTypeURL); found {
+			return delegation.Delegate, true
+		}
+	}
+
+	delegation, found := keeper.GetVoteDelegation(ctx, delegatorAddr, "")
+	return delegation.Delegate, found
+}
+
+// deleteVoteDelegation deletes the vote delegation of a delegator for a msg
+// type from the store, and returns false if it didn't exist.
+func (keeper Keeper) deleteVoteDelegation(ctx sdk.Context, delegatorAddr sdk.AccAddress, msgTypeURL string) bool {
+	delegation, found := keeper.GetVoteDelegation(ctx, delegatorAddr, msgTypeURL)
+	if !found {
+		return false
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.VoteDelegationKey(delegatorAddr, msgTypeURL))
+	store.Delete(types.VoteDelegatorKey(sdk.MustAccAddressFromBech32(delegation.Delegate), delegatorAddr, msgTypeURL))
+
+	return true
+}
@@ -0,0 +1,345 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var msgSendTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+// bondTokens delegates the given amount of tokens of each address to the
+// genesis validator, which is bonded.
+func bondTokens(t *testing.T, app *simapp.SimApp, ctx sdk.Context, amount int64, addrs ...sdk.AccAddress) {
+	val := app.StakingKeeper.GetAllValidators(ctx)[0]
+	for _, addr := range addrs {
+		_, err := app.StakingKeeper.Delegate(ctx, addr, sdk.NewInt(amount), stakingtypes.Unbonded, val, true)
+		require.NoError(t, err)
+		val, _ = app.StakingKeeper.GetValidator(ctx, val.GetOperator())
+	}
+}
+
+func TestVoteDelegations(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+	bondTokens(t, app, ctx, 1000, addrs[0])
+
+	require.Error(t, app.GovKeeper.AddVoteDelegation(ctx, addrs[0], addrs[0], ""), "self delegation")
+	require.Error(t, app.GovKeeper.AddVoteDelegation(ctx, addrs[0], addrs[1], "/cosmos.unknown.MsgFoo"), "unknown msg type")
+	require.Error(t, app.GovKeeper.RemoveVoteDelegation(ctx, addrs[0], ""), "no delegation")
+
+	require.NoError(t, app.GovKeeper.AddVoteDelegation(ctx, addrs[0], addrs[1], ""))
+	require.NoError(t, app.GovKeeper.AddVoteDelegation(ctx, addrs[0], addrs[1], msgSendTypeURL))
+
+	delegation, found := app.GovKeeper.GetVoteDelegation(ctx, addrs[0], msgSendTypeURL)
+	require.True(t, found)
+	require.Equal(t, v1.NewVoteDelegation(addrs[0], addrs[1], msgSendTypeURL), delegation)
+	require.Len(t, app.GovKeeper.GetVoteDelegations(ctx, addrs[0]), 2)
+
+	// re-delegating for the same msg type replaces the delegate
+	require.NoError(t, app.GovKeeper.AddVoteDelegation(ctx, addrs[0], addrs[2], msgSendTypeURL))
+	delegation, found = app.GovKeeper.GetVoteDelegation(ctx, addrs[0], msgSendTypeURL)
+	require.True(t, found)
+	require.Equal(t, addrs[2].String(), delegation.Delegate)
+	require.Len(t, app.GovKeeper.GetAllVoteDelegations(ctx), 2)
+
+	var delegators []string
+	app.GovKeeper.IterateVoteDelegators(ctx, addrs[1], func(delegator sdk.AccAddress, msgTypeURL string) bool {
+		delegators = append(delegators, delegator.String()+"/"+msgTypeURL)
+		return false
+	})
+	require.Equal(t, []string{addrs[0].String() + "/"}, delegators)
+
+	require.NoError(t, app.GovKeeper.RemoveVoteDelegation(ctx, addrs[0], msgSendTypeURL))
+	_, found = app.GovKeeper.GetVoteDelegation(ctx, addrs[0], msgSendTypeURL)
+	require.False(t, found)
+	require.Len(t, app.GovKeeper.GetVoteDelegations(ctx, addrs[0]), 1)
+
+	delegators = nil
+	app.GovKeeper.IterateVoteDelegators(ctx, addrs[2], func(delegator sdk.AccAddress, msgTypeURL string) bool {
+		delegators = append(delegators, delegator.String())
+		return false
+	})
+	require.Empty(t, delegators)
+}
+
+func TestMaxVoteDelegations(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	govKeeper := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName), app.AccountKeeper, app.BankKeeper,
+		app.StakingKeeper, app.DistrKeeper, app.MsgServiceRouter(), types.Config{MaxVoteDelegations: 2},
+	)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 4, sdk.NewInt(30000000))
+	bondTokens(t, app, ctx, 1000, addrs[0], addrs[1], addrs[2])
+
+	require.NoError(t, govKeeper.AddVoteDelegation(ctx, addrs[0], addrs[3], ""))
+	require.NoError(t, govKeeper.AddVoteDelegation(ctx, addrs[1], addrs[3], ""))
+	err := govKeeper.AddVoteDelegation(ctx, addrs[2], addrs[3], "")
+	require.ErrorIs(t, err, types.ErrTooManyVoteDelegations)
+	err = govKeeper.AddVoteDelegation(ctx, addrs[0], addrs[3], msgSendTypeURL)
+	require.ErrorIs(t, err, types.ErrTooManyVoteDelegations)
+
+	// replacing a delegation to the delegate is allowed at the maximum
+	require.NoError(t, govKeeper.AddVoteDelegation(ctx, addrs[0], addrs[3], ""))
+
+	// removing a delegation makes room for a new one
+	require.NoError(t, govKeeper.RemoveVoteDelegation(ctx, addrs[0], ""))
+	require.NoError(t, govKeeper.AddVoteDelegation(ctx, addrs[2], addrs[3], ""))
+
+	// so does the delegate declining one
+	require.NoError(t, govKeeper.RejectVoteDelegation(ctx, addrs[3], addrs[1], ""))
+	require.NoError(t, govKeeper.AddVoteDelegation(ctx, addrs[0], addrs[3], ""))
+}
+
+func TestMinVoteDelegationStake(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	govKeeper := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName), app.AccountKeeper, app.BankKeeper,
+		app.StakingKeeper, app.DistrKeeper, app.MsgServiceRouter(), types.Config{MinVoteDelegationStake: sdk.NewInt(1000)},
+	)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+
+	// an account with no bonded tokens can't delegate its votes
+	err := govKeeper.AddVoteDelegation(ctx, addrs[0], addrs[2], "")
+	require.ErrorIs(t, err, types.ErrInsufficientVoteStake)
+
+	bondTokens(t, app, ctx, 999, addrs[0])
+	err = govKeeper.AddVoteDelegation(ctx, addrs[0], addrs[2], "")
+	require.ErrorIs(t, err, types.ErrInsufficientVoteStake)
+
+	bondTokens(t, app, ctx, 1, addrs[0])
+	require.NoError(t, govKeeper.AddVoteDelegation(ctx, addrs[0], addrs[2], ""))
+}
+
+func TestRejectVoteDelegation(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+	bondTokens(t, app, ctx, 1000, addrs[0])
+
+	require.NoError(t, app.GovKeeper.AddVoteDelegation(ctx, addrs[0], addrs[1], ""))
+	require.NoError(t, app.GovKeeper.AddVoteDelegation(ctx, addrs[0], addrs[1], msgSendTypeURL))
+
+	// only the delegate of a delegation can decline it
+	err := app.GovKeeper.RejectVoteDelegation(ctx, addrs[2], addrs[0], "")
+	require.ErrorIs(t, err, types.ErrVoteDelegationNotFound)
+	err = app.GovKeeper.RejectVoteDelegation(ctx, addrs[1], addrs[2], "")
+	require.ErrorIs(t, err, types.ErrVoteDelegationNotFound)
+
+	require.NoError(t, app.GovKeeper.RejectVoteDelegation(ctx, addrs[1], addrs[0], ""))
+	_, found := app.GovKeeper.GetVoteDelegation(ctx, addrs[0], "")
+	require.False(t, found)
+	require.Len(t, app.GovKeeper.GetVoteDelegations(ctx, addrs[0]), 1)
+
+	var delegators []string
+	app.GovKeeper.IterateVoteDelegators(ctx, addrs[1], func(delegator sdk.AccAddress, msgTypeURL string) bool {
+		delegators = append(delegators, delegator.String()+"/"+msgTypeURL)
+		return false
+	})
+	require.Equal(t, []string{addrs[0].String() + "/" + msgSendTypeURL}, delegators)
+}
+
+// setupVoteDelegationTally creates three validators of power 5, 6 and 7, and
+// a delegator (addrs[3]) staking 30 with the first validator.
+func setupVoteDelegationTally(t *testing.T) (*simapp.SimApp, sdk.Context, []sdk.AccAddress) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, valAddrs := createValidators(t, ctx, app, []int64{5, 6, 7})
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+	val1, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[3], delTokens, stakingtypes.Unbonded, val1, true)
+	require.NoError(t, err)
+
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+	return app, ctx, addrs
+}
+
+func TestTallyVoteDelegate(t *testing.T) {
+	app, ctx, addrs := setupVoteDelegationTally(t)
+
+	require.NoError(t, app.GovKeeper.AddVoteDelegation(ctx, addrs[3], addrs[4], ""))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false, false)
+	require.NoError(t, err)
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.Id, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.Id, addrs[2], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.Id, addrs[4], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 37).String(), tallyResults.YesCount)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 11).String(), tallyResults.NoCount)
+}
+
+func TestTallyVoteDelegatorOverride(t *testing.T) {
+	app, ctx, addrs := setupVoteDelegationTally(t)
+
+	require.NoError(t, app.GovKeeper.AddVoteDelegation(ctx, addrs[3], addrs[4], ""))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false, false)
+	require.NoError(t, err)
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.Id, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.Id, addrs[2], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.Id, addrs[3], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.Id, addrs[4], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	passes, _, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+
+	require.False(t, passes)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 7).String(), tallyResults.YesCount)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 41).String(), tallyResults.NoCount)
+}
+
+func TestTallyVoteDelegateMsgType(t *testing.T) {
+	app, ctx, addrs := setupVoteDelegationTally(t)
+
+	require.NoError(t, app.GovKeeper.AddVoteDelegation(ctx, addrs[3], addrs[4], ""))
+	require.NoError(t, app.GovKeeper.AddVoteDelegation(ctx, addrs[3], addrs[1], msgSendTypeURL))
+
+	msgs := []sdk.Msg{banktypes.NewMsgSend(govAcct, addr, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000))))}
+	sendProposal, err := app.GovKeeper.SubmitProposal(ctx, msgs, "", addr, false, false)
+	require.NoError(t, err)
+	sendProposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, sendProposal)
+
+	mixedProposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false, false)
+	require.NoError(t, err)
+	mixedProposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, mixedProposal)
+
+	for _, proposal := range []v1.Proposal{sendProposal, mixedProposal} {
+		require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.Id, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+		require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.Id, addrs[2], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+		require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.Id, addrs[4], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	}
+
+	// the delegation for MsgSend takes precedence over the global delegation
+	passes, _, tallyResults := app.GovKeeper.Tally(ctx, sendProposal)
+	require.False(t, passes)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 36).String(), tallyResults.NoCount)
+
+	// the global delegation applies to proposals with messages of mixed types
+	passes, _, tallyResults = app.GovKeeper.Tally(ctx, mixedProposal)
+	require.True(t, passes)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 37).String(), tallyResults.YesCount)
+}
+
+func (suite *KeeperTestSuite) TestDelegateVoteReq() {
+	addrs := suite.addrs
+	bondTokens(suite.T(), suite.app, suite.ctx, 1000, addrs[0])
+
+	cases := map[string]struct {
+		msg       *v1.MsgDelegateVote
+		expErr    bool
+		expErrMsg string
+	}{
+		"self delegation": {
+			msg:       v1.NewMsgDelegateVote(addrs[0], addrs[0], ""),
+			expErr:    true,
+			expErrMsg: "cannot delegate votes to oneself",
+		},
+		"unknown msg type": {
+			msg:       v1.NewMsgDelegateVote(addrs[0], addrs[1], "/cosmos.unknown.MsgFoo"),
+			expErr:    true,
+			expErrMsg: "unknown msg type",
+		},
+		"no bonded stake": {
+			msg:       v1.NewMsgDelegateVote(addrs[1], addrs[0], msgSendTypeURL),
+			expErr:    true,
+			expErrMsg: "insufficient bonded stake",
+		},
+		"all good": {
+			msg:    v1.NewMsgDelegateVote(addrs[0], addrs[1], msgSendTypeURL),
+			expErr: false,
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			_, err := suite.msgSrvr.DelegateVote(suite.ctx, tc.msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	_, err := suite.msgSrvr.UndelegateVote(suite.ctx, v1.NewMsgUndelegateVote(addrs[0], ""))
+	suite.Require().ErrorContains(err, "vote delegation not found")
+
+	_, err = suite.msgSrvr.UndelegateVote(suite.ctx, v1.NewMsgUndelegateVote(addrs[0], msgSendTypeURL))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.DelegateVote(suite.ctx, v1.NewMsgDelegateVote(addrs[0], addrs[1], ""))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.RejectVoteDelegation(suite.ctx, v1.NewMsgRejectVoteDelegation(addrs[0], addrs[1], ""))
+	suite.Require().ErrorContains(err, "vote delegation not found")
+
+	_, err = suite.msgSrvr.RejectVoteDelegation(suite.ctx, v1.NewMsgRejectVoteDelegation(addrs[1], addrs[0], ""))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryVoteDelegations() {
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	_, err := queryClient.VoteDelegations(sdk.WrapSDKContext(ctx), &v1.QueryVoteDelegationsRequest{})
+	suite.Require().Error(err)
+	_, err = queryClient.VoteDelegators(sdk.WrapSDKContext(ctx), &v1.QueryVoteDelegatorsRequest{})
+	suite.Require().Error(err)
+
+	bondTokens(suite.T(), app, ctx, 1000, addrs[0])
+	suite.Require().NoError(app.GovKeeper.AddVoteDelegation(ctx, addrs[0], addrs[1], ""))
+	suite.Require().NoError(app.GovKeeper.AddVoteDelegation(ctx, addrs[0], addrs[1], msgSendTypeURL))
+
+	delegations, err := queryClient.VoteDelegations(sdk.WrapSDKContext(ctx), &v1.QueryVoteDelegationsRequest{Delegator: addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(delegations.VoteDelegations, 2)
+
+	delegators, err := queryClient.VoteDelegators(sdk.WrapSDKContext(ctx), &v1.QueryVoteDelegatorsRequest{Delegate: addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(delegators.VoteDelegations, 2)
+	for _, delegation := range delegators.VoteDelegations {
+		suite.Require().Equal(addrs[0].String(), delegation.Delegator)
+	}
+
+	delegators, err = queryClient.VoteDelegators(sdk.WrapSDKContext(ctx), &v1.QueryVoteDelegatorsRequest{Delegate: addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(delegators.VoteDelegations)
+}
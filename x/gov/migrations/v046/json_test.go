@@ -74,18 +74,24 @@ func TestMigrateJSON(t *testing.T) {
 	// - Proposals use MsgExecLegacyContent
 	expected := `{
 	"deposit_params": {
+		"expedited_min_deposit": [],
 		"max_deposit_period": "172800s",
 		"min_deposit": [
 			{
 				"amount": "10000000",
 				"denom": "stake"
 			}
-		]
+		],
+		"proposal_cancel_dest": "",
+		"proposal_cancel_ratio": ""
 	},
 	"deposits": [],
+	"message_based_params": [],
+	"optimistic_params": null,
 	"proposals": [
 		{
 			"deposit_end_time": "2001-09-09T01:46:40Z",
+			"expedited": false,
 			"final_tally_result": {
 				"abstain_count": "0",
 				"no_count": "0",
@@ -105,6 +111,8 @@ func TestMigrateJSON(t *testing.T) {
 				}
 			],
 			"metadata": "",
+			"optimistic": false,
+			"proposer": "",
 			"status": "PROPOSAL_STATUS_DEPOSIT_PERIOD",
 			"submit_time": "2001-09-09T01:46:40Z",
 			"total_deposit": [
@@ -119,10 +127,12 @@ func TestMigrateJSON(t *testing.T) {
 	],
 	"starting_proposal_id": "1",
 	"tally_params": {
+		"expedited_threshold": "",
 		"quorum": "0.334000000000000000",
 		"threshold": "0.500000000000000000",
 		"veto_threshold": "0.334000000000000000"
 	},
+	"vote_delegations": [],
 	"votes": [
 		{
 			"metadata": "",
@@ -148,6 +158,7 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"voting_params": {
+		"expedited_voting_period": null,
 		"voting_period": "172800s"
 	}
 }`
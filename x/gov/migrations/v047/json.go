@@ -0,0 +1,28 @@
+package v047
+
+import (
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// MigrateJSON accepts exported v0.46 x/gov genesis state and migrates it to
+// v0.47 x/gov genesis state. The migration includes:
+//
+// - Setting the expedited proposal params.
+// - Setting the proposal cancel ratio param.
+// - Setting the default optimistic proposal params.
+func MigrateJSON(oldState *v1.GenesisState) (*v1.GenesisState, error) {
+	depositParams := migrateDepositParams(*oldState.DepositParams)
+	votingParams := migrateVotingParams(*oldState.VotingParams)
+	tallyParams := migrateTallyParams(*oldState.TallyParams)
+
+	newState := *oldState
+	newState.DepositParams = &depositParams
+	newState.VotingParams = &votingParams
+	newState.TallyParams = &tallyParams
+	if newState.OptimisticParams == nil {
+		optimisticParams := v1.DefaultOptimisticParams()
+		newState.OptimisticParams = &optimisticParams
+	}
+
+	return &newState, nil
+}
@@ -0,0 +1,32 @@
+package v047_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047gov "github.com/cosmos/cosmos-sdk/x/gov/migrations/v047"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestMigrateJSON(t *testing.T) {
+	depositPeriod, votingPeriod := time.Hour*24*14, time.Hour*24*3
+	govGenState := v1.DefaultGenesisState()
+	govGenState.DepositParams = &v1.DepositParams{MinDeposit: sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), MaxDepositPeriod: &depositPeriod}
+	govGenState.VotingParams = &v1.VotingParams{VotingPeriod: &votingPeriod}
+	govGenState.TallyParams = &v1.TallyParams{Quorum: "0.4", Threshold: "0.5", VetoThreshold: "0.334"}
+
+	migrated, err := v047gov.MigrateJSON(govGenState)
+	require.NoError(t, err)
+	require.NoError(t, v1.ValidateGenesis(migrated))
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 500)), sdk.Coins(migrated.DepositParams.ExpeditedMinDeposit))
+	require.Equal(t, v1.DefaultProposalCancelRatio.String(), migrated.DepositParams.ProposalCancelRatio)
+	require.Equal(t, v1.DefaultExpeditedPeriod, *migrated.VotingParams.ExpeditedVotingPeriod)
+	require.Equal(t, v1.DefaultExpeditedThreshold.String(), migrated.TallyParams.ExpeditedThreshold)
+
+	// the old genesis state is left untouched
+	require.Nil(t, govGenState.VotingParams.ExpeditedVotingPeriod)
+}
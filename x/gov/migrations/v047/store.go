@@ -0,0 +1,87 @@
+package v047
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47. The
+// migration includes:
+//
+// - Setting the expedited proposal params in the paramstore.
+// - Setting the proposal cancel ratio param in the paramstore.
+// - Setting empty message based params in the paramstore.
+// - Setting the default optimistic proposal params in the paramstore.
+func MigrateStore(ctx sdk.Context, paramSpace types.ParamSubspace) error {
+	var depositParams v1.DepositParams
+	paramSpace.Get(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
+	depositParams = migrateDepositParams(depositParams)
+	paramSpace.Set(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
+
+	var votingParams v1.VotingParams
+	paramSpace.Get(ctx, v1.ParamStoreKeyVotingParams, &votingParams)
+	votingParams = migrateVotingParams(votingParams)
+	paramSpace.Set(ctx, v1.ParamStoreKeyVotingParams, &votingParams)
+
+	var tallyParams v1.TallyParams
+	paramSpace.Get(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
+	tallyParams = migrateTallyParams(tallyParams)
+	paramSpace.Set(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
+
+	messageBasedParams := []*v1.MessageBasedParams{}
+	paramSpace.Set(ctx, v1.ParamStoreKeyMessageBasedParams, &messageBasedParams)
+
+	optimisticParams := v1.DefaultOptimisticParams()
+	paramSpace.Set(ctx, v1.ParamStoreKeyOptimisticParams, &optimisticParams)
+
+	return nil
+}
+
+// migrateDepositParams sets the expedited minimum deposit to 5 times the
+// minimum deposit, and the proposal cancel ratio to the default one.
+func migrateDepositParams(depositParams v1.DepositParams) v1.DepositParams {
+	if len(depositParams.ExpeditedMinDeposit) == 0 {
+		expeditedMinDeposit := sdk.NewCoins()
+		for _, coin := range depositParams.MinDeposit {
+			expeditedMinDeposit = expeditedMinDeposit.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(5)))
+		}
+		depositParams.ExpeditedMinDeposit = expeditedMinDeposit
+	}
+	if depositParams.ProposalCancelRatio == "" {
+		depositParams.ProposalCancelRatio = v1.DefaultProposalCancelRatio.String()
+	}
+
+	return depositParams
+}
+
+// migrateVotingParams sets the expedited voting period to the default one, or
+// half the voting period if it's shorter than the default expedited voting
+// period.
+func migrateVotingParams(votingParams v1.VotingParams) v1.VotingParams {
+	if votingParams.ExpeditedVotingPeriod == nil {
+		expeditedVotingPeriod := v1.DefaultExpeditedPeriod
+		if expeditedVotingPeriod >= *votingParams.VotingPeriod {
+			expeditedVotingPeriod = *votingParams.VotingPeriod / 2
+		}
+		votingParams.ExpeditedVotingPeriod = &expeditedVotingPeriod
+	}
+
+	return votingParams
+}
+
+// migrateTallyParams sets the expedited threshold to the default one, or
+// halfway between the threshold and 1 if the threshold is higher than the
+// default expedited threshold.
+func migrateTallyParams(tallyParams v1.TallyParams) v1.TallyParams {
+	if tallyParams.ExpeditedThreshold == "" {
+		expeditedThreshold := v1.DefaultExpeditedThreshold
+		threshold := sdk.MustNewDecFromStr(tallyParams.Threshold)
+		if expeditedThreshold.LTE(threshold) {
+			expeditedThreshold = threshold.Add(sdk.OneDec()).QuoInt64(2)
+		}
+		tallyParams.ExpeditedThreshold = expeditedThreshold.String()
+	}
+
+	return tallyParams
+}
@@ -0,0 +1,57 @@
+package v047_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047gov "github.com/cosmos/cosmos-sdk/x/gov/migrations/v047"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	govKey := sdk.NewKVStoreKey("gov")
+	tGovKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(govKey, tGovKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, govKey, tGovKey, "gov").WithKeyTable(v1.ParamKeyTable())
+
+	// Set params without the expedited params, with a voting period shorter
+	// than the default expedited voting period.
+	minDeposit := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	depositPeriod, votingPeriod := time.Hour*24*14, time.Hour*12
+	paramSpace.Set(ctx, v1.ParamStoreKeyDepositParams, &v1.DepositParams{MinDeposit: minDeposit, MaxDepositPeriod: &depositPeriod})
+	paramSpace.Set(ctx, v1.ParamStoreKeyVotingParams, &v1.VotingParams{VotingPeriod: &votingPeriod})
+	paramSpace.Set(ctx, v1.ParamStoreKeyTallyParams, &v1.TallyParams{Quorum: "0.4", Threshold: "0.8", VetoThreshold: "0.334"})
+
+	// Run migrations.
+	err := v047gov.MigrateStore(ctx, paramSpace)
+	require.NoError(t, err)
+
+	var depositParams v1.DepositParams
+	paramSpace.Get(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 500)), sdk.Coins(depositParams.ExpeditedMinDeposit))
+	require.Equal(t, v1.DefaultProposalCancelRatio.String(), depositParams.ProposalCancelRatio)
+	require.Empty(t, depositParams.ProposalCancelDest)
+
+	var votingParams v1.VotingParams
+	paramSpace.Get(ctx, v1.ParamStoreKeyVotingParams, &votingParams)
+	require.Equal(t, time.Hour*6, *votingParams.ExpeditedVotingPeriod)
+
+	var tallyParams v1.TallyParams
+	paramSpace.Get(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
+	require.Equal(t, sdk.NewDecWithPrec(9, 1).String(), tallyParams.ExpeditedThreshold)
+
+	var messageBasedParams []*v1.MessageBasedParams
+	paramSpace.Get(ctx, v1.ParamStoreKeyMessageBasedParams, &messageBasedParams)
+	require.Empty(t, messageBasedParams)
+
+	var optimisticParams v1.OptimisticParams
+	paramSpace.Get(ctx, v1.ParamStoreKeyOptimisticParams, &optimisticParams)
+	require.Equal(t, v1.DefaultOptimisticParams(), optimisticParams)
+}
@@ -17,12 +17,20 @@ import (
 
 // Simulation parameter constants
 const (
-	DepositParamsMinDeposit    = "deposit_params_min_deposit"
-	DepositParamsDepositPeriod = "deposit_params_deposit_period"
-	VotingParamsVotingPeriod   = "voting_params_voting_period"
-	TallyParamsQuorum          = "tally_params_quorum"
-	TallyParamsThreshold       = "tally_params_threshold"
-	TallyParamsVeto            = "tally_params_veto"
+	DepositParamsMinDeposit           = "deposit_params_min_deposit"
+	DepositParamsDepositPeriod        = "deposit_params_deposit_period"
+	DepositParamsExpeditedMinDeposit  = "deposit_params_expedited_min_deposit"
+	VotingParamsVotingPeriod          = "voting_params_voting_period"
+	VotingParamsExpeditedVotingPeriod = "voting_params_expedited_voting_period"
+	TallyParamsQuorum                 = "tally_params_quorum"
+	TallyParamsThreshold              = "tally_params_threshold"
+	TallyParamsExpeditedThreshold     = "tally_params_expedited_threshold"
+	TallyParamsVeto                   = "tally_params_veto"
+	DepositParamsProposalCancelRatio  = "deposit_params_proposal_cancel_ratio"
+
+	// expeditedMaxVotingPeriod is the maximum expedited voting period in
+	// seconds, and the minimum regular voting period.
+	expeditedMaxVotingPeriod = 60 * 60 * 24
 )
 
 // GenDepositParamsDepositPeriod randomized DepositParamsDepositPeriod
@@ -35,9 +43,24 @@ func GenDepositParamsMinDeposit(r *rand.Rand) sdk.Coins {
 	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1, 1e3))))
 }
 
+// GenDepositParamsExpeditedMinDeposit randomized DepositParamsExpeditedMinDeposit
+func GenDepositParamsExpeditedMinDeposit(r *rand.Rand) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1e3, 1e4))))
+}
+
+// GenDepositParamsProposalCancelRatio randomized DepositParamsProposalCancelRatio
+func GenDepositParamsProposalCancelRatio(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 1000)), 3)
+}
+
 // GenVotingParamsVotingPeriod randomized VotingParamsVotingPeriod
 func GenVotingParamsVotingPeriod(r *rand.Rand) time.Duration {
-	return time.Duration(simulation.RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
+	return time.Duration(simulation.RandIntBetween(r, expeditedMaxVotingPeriod, 2*60*60*24*2)) * time.Second
+}
+
+// GenVotingParamsExpeditedVotingPeriod randomized VotingParamsExpeditedVotingPeriod
+func GenVotingParamsExpeditedVotingPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, expeditedMaxVotingPeriod)) * time.Second
 }
 
 // GenTallyParamsQuorum randomized TallyParamsQuorum
@@ -50,6 +73,11 @@ func GenTallyParamsThreshold(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 450, 550)), 3)
 }
 
+// GenTallyParamsExpeditedThreshold randomized TallyParamsExpeditedThreshold
+func GenTallyParamsExpeditedThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 550, 700)), 3)
+}
+
 // GenTallyParamsVeto randomized TallyParamsVeto
 func GenTallyParamsVeto(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 250, 334)), 3)
@@ -71,12 +99,24 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { depositPeriod = GenDepositParamsDepositPeriod(r) },
 	)
 
+	var expeditedMinDeposit sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsExpeditedMinDeposit, &expeditedMinDeposit, simState.Rand,
+		func(r *rand.Rand) { expeditedMinDeposit = GenDepositParamsExpeditedMinDeposit(r) },
+	)
+
 	var votingPeriod time.Duration
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, VotingParamsVotingPeriod, &votingPeriod, simState.Rand,
 		func(r *rand.Rand) { votingPeriod = GenVotingParamsVotingPeriod(r) },
 	)
 
+	var expeditedVotingPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VotingParamsExpeditedVotingPeriod, &expeditedVotingPeriod, simState.Rand,
+		func(r *rand.Rand) { expeditedVotingPeriod = GenVotingParamsExpeditedVotingPeriod(r) },
+	)
+
 	var quorum sdk.Dec
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, TallyParamsQuorum, &quorum, simState.Rand,
@@ -89,17 +129,29 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { threshold = GenTallyParamsThreshold(r) },
 	)
 
+	var expeditedThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TallyParamsExpeditedThreshold, &expeditedThreshold, simState.Rand,
+		func(r *rand.Rand) { expeditedThreshold = GenTallyParamsExpeditedThreshold(r) },
+	)
+
 	var veto sdk.Dec
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, TallyParamsVeto, &veto, simState.Rand,
 		func(r *rand.Rand) { veto = GenTallyParamsVeto(r) },
 	)
 
+	var proposalCancelRatio sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsProposalCancelRatio, &proposalCancelRatio, simState.Rand,
+		func(r *rand.Rand) { proposalCancelRatio = GenDepositParamsProposalCancelRatio(r) },
+	)
+
 	govGenesis := v1.NewGenesisState(
 		startingProposalID,
-		v1.NewDepositParams(minDeposit, depositPeriod),
-		v1.NewVotingParams(votingPeriod),
-		v1.NewTallyParams(quorum, threshold, veto),
+		v1.NewDepositParams(minDeposit, depositPeriod, expeditedMinDeposit, proposalCancelRatio, ""),
+		v1.NewVotingParams(votingPeriod, expeditedVotingPeriod),
+		v1.NewTallyParams(quorum, threshold, veto, expeditedThreshold),
 	)
 
 	bz, err := json.MarshalIndent(&govGenesis, "", " ")
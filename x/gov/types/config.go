@@ -1,14 +1,27 @@
 package types
 
+import "cosmossdk.io/math"
+
 // Config is a config struct used for intialising the gov module to avoid using globals.
 type Config struct {
 	// MaxMetadataLen defines the maximum proposal metadata length.
 	MaxMetadataLen uint64
+
+	// MaxVoteDelegations defines the maximum number of vote delegations made to
+	// a single delegate, which bounds the work of tallying its vote.
+	MaxVoteDelegations uint64
+
+	// MinVoteDelegationStake defines the minimum amount of tokens an account
+	// must have bonded to delegate its votes, so that the vote delegations of
+	// a delegate can't be filled with empty accounts.
+	MinVoteDelegationStake math.Int
 }
 
 // DefaultConfig returns the default config for gov.
 func DefaultConfig() Config {
 	return Config{
-		MaxMetadataLen: 255,
+		MaxMetadataLen:         255,
+		MaxVoteDelegations:     1000,
+		MinVoteDelegationStake: math.OneInt(),
 	}
 }
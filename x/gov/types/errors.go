@@ -21,4 +21,10 @@ var (
 	ErrInvalidSigner           = sdkerrors.Register(ModuleName, 13, "expected gov account as only signer for proposal message")
 	ErrInvalidSignalMsg        = sdkerrors.Register(ModuleName, 14, "signal message is invalid")
 	ErrMetadataTooLong         = sdkerrors.Register(ModuleName, 15, "metadata too long")
+	ErrInvalidVoteDelegation   = sdkerrors.Register(ModuleName, 16, "invalid vote delegation")
+	ErrVoteDelegationNotFound  = sdkerrors.Register(ModuleName, 17, "vote delegation not found")
+	ErrInvalidProposer         = sdkerrors.Register(ModuleName, 18, "invalid proposer")
+	ErrVotingPeriodEnded       = sdkerrors.Register(ModuleName, 19, "voting period already ended")
+	ErrTooManyVoteDelegations  = sdkerrors.Register(ModuleName, 20, "too many vote delegations")
+	ErrInsufficientVoteStake   = sdkerrors.Register(ModuleName, 21, "insufficient bonded stake to delegate votes")
 )
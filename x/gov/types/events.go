@@ -2,24 +2,34 @@ package types
 
 // Governance module event types
 const (
-	EventTypeSubmitProposal   = "submit_proposal"
-	EventTypeProposalDeposit  = "proposal_deposit"
-	EventTypeProposalVote     = "proposal_vote"
-	EventTypeInactiveProposal = "inactive_proposal"
-	EventTypeActiveProposal   = "active_proposal"
-	EventTypeSignalProposal   = "signal_proposal"
+	EventTypeSubmitProposal       = "submit_proposal"
+	EventTypeProposalDeposit      = "proposal_deposit"
+	EventTypeProposalVote         = "proposal_vote"
+	EventTypeInactiveProposal     = "inactive_proposal"
+	EventTypeActiveProposal       = "active_proposal"
+	EventTypeSignalProposal       = "signal_proposal"
+	EventTypeDelegateVote         = "delegate_vote"
+	EventTypeUndelegateVote       = "undelegate_vote"
+	EventTypeRejectVoteDelegation = "reject_vote_delegation"
+	EventTypeCancelProposal       = "cancel_proposal"
 
-	AttributeKeyProposalResult     = "proposal_result"
-	AttributeKeyOption             = "option"
-	AttributeKeyProposalID         = "proposal_id"
-	AttributeKeyProposalMessages   = "proposal_messages" // Msg type_urls in the proposal
-	AttributeKeyVotingPeriodStart  = "voting_period_start"
-	AttributeValueCategory         = "governance"
-	AttributeValueProposalDropped  = "proposal_dropped"  // didn't meet min deposit
-	AttributeValueProposalPassed   = "proposal_passed"   // met vote quorum
-	AttributeValueProposalRejected = "proposal_rejected" // didn't meet vote quorum
-	AttributeValueProposalFailed   = "proposal_failed"   // error on proposal handler
-	AttributeKeyProposalType       = "proposal_type"
-	AttributeSignalTitle           = "signal_title"
-	AttributeSignalDescription     = "signal_description"
+	AttributeKeyProposalResult               = "proposal_result"
+	AttributeKeyOption                       = "option"
+	AttributeKeyProposalID                   = "proposal_id"
+	AttributeKeyProposalMessages             = "proposal_messages" // Msg type_urls in the proposal
+	AttributeKeyVotingPeriodStart            = "voting_period_start"
+	AttributeValueCategory                   = "governance"
+	AttributeValueProposalDropped            = "proposal_dropped"             // didn't meet min deposit
+	AttributeValueProposalPassed             = "proposal_passed"              // met vote quorum
+	AttributeValueProposalRejected           = "proposal_rejected"            // didn't meet vote quorum
+	AttributeValueProposalFailed             = "proposal_failed"              // error on proposal handler
+	AttributeValueExpeditedProposalRejected  = "expedited_proposal_rejected"  // failed the expedited tally, converted to a regular proposal
+	AttributeValueOptimisticProposalRejected = "optimistic_proposal_rejected" // No and NoWithVeto votes reached the optimistic rejected threshold
+	AttributeKeyProposalType                 = "proposal_type"
+	AttributeSignalTitle                     = "signal_title"
+	AttributeSignalDescription               = "signal_description"
+	AttributeKeyDelegator                    = "delegator"
+	AttributeKeyDelegate                     = "delegate"
+	AttributeKeyMsgTypeURL                   = "msg_type_url"
+	AttributeKeyProposer                     = "proposer"
 )
@@ -20,7 +20,8 @@ type StakingKeeper interface {
 		sdk.Context, func(index int64, validator stakingtypes.ValidatorI) (stop bool),
 	)
 
-	TotalBondedTokens(sdk.Context) math.Int // total bonded tokens within the validator set
+	Validator(sdk.Context, sdk.ValAddress) stakingtypes.ValidatorI // get a particular validator by operator address
+	TotalBondedTokens(sdk.Context) math.Int                        // total bonded tokens within the validator set
 	IterateDelegations(
 		ctx sdk.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation stakingtypes.DelegationI) (stop bool),
@@ -50,6 +51,11 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
 
+// DistributionKeeper defines the expected distribution keeper (noalias)
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
 // Event Hooks
 // These can be utilized to communicate between a governance keeper and another
 // keepers.
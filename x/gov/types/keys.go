@@ -37,6 +37,10 @@ const (
 // - 0x10<proposalID_Bytes><depositorAddrLen (1 Byte)><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
+//
+// - 0x30<delegatorAddrLen (1 Byte)><delegatorAddr_Bytes><msgTypeURL_Bytes>: VoteDelegation
+//
+// - 0x31<delegateAddrLen (1 Byte)><delegateAddr_Bytes><delegatorAddrLen (1 Byte)><delegatorAddr_Bytes><msgTypeURL_Bytes>: []byte{}
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
@@ -46,6 +50,9 @@ var (
 	DepositsKeyPrefix = []byte{0x10}
 
 	VotesKeyPrefix = []byte{0x20}
+
+	VoteDelegationsKeyPrefix = []byte{0x30}
+	VoteDelegatorsKeyPrefix  = []byte{0x31}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -107,6 +114,27 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
 }
 
+// VoteDelegationsKey gets the first part of the vote delegations key based on the delegator address
+func VoteDelegationsKey(delegatorAddr sdk.AccAddress) []byte {
+	return append(VoteDelegationsKeyPrefix, address.MustLengthPrefix(delegatorAddr.Bytes())...)
+}
+
+// VoteDelegationKey key of a specific vote delegation from the store
+func VoteDelegationKey(delegatorAddr sdk.AccAddress, msgTypeURL string) []byte {
+	return append(VoteDelegationsKey(delegatorAddr), []byte(msgTypeURL)...)
+}
+
+// VoteDelegatorsKey gets the first part of the vote delegators key based on the delegate address
+func VoteDelegatorsKey(delegateAddr sdk.AccAddress) []byte {
+	return append(VoteDelegatorsKeyPrefix, address.MustLengthPrefix(delegateAddr.Bytes())...)
+}
+
+// VoteDelegatorKey key of a vote delegation in the index by delegate
+func VoteDelegatorKey(delegateAddr, delegatorAddr sdk.AccAddress, msgTypeURL string) []byte {
+	key := append(VoteDelegatorsKey(delegateAddr), address.MustLengthPrefix(delegatorAddr.Bytes())...)
+	return append(key, []byte(msgTypeURL)...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -136,6 +164,17 @@ func SplitKeyVote(key []byte) (proposalID uint64, voterAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
 }
 
+// SplitVoteDelegatorKey split the key of a vote delegation in the index by
+// delegate, stripped of its VoteDelegatorsKey prefix, and returns the
+// delegator address and the msg type URL
+func SplitVoteDelegatorKey(key []byte) (delegatorAddr sdk.AccAddress, msgTypeURL string) {
+	kv.AssertKeyAtLeastLength(key, 1)
+	addrLen := int(key[0])
+	kv.AssertKeyAtLeastLength(key, 1+addrLen)
+
+	return sdk.AccAddress(key[1 : 1+addrLen]), string(key[1+addrLen:])
+}
+
 // private functions
 
 func splitKeyWithTime(key []byte) (proposalID uint64, endTime time.Time) {
@@ -19,6 +19,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "cosmos-sdk/v1/MsgVote")
 	legacy.RegisterAminoMsg(cdc, &MsgVoteWeighted{}, "cosmos-sdk/v1/MsgVoteWeighted")
 	legacy.RegisterAminoMsg(cdc, &MsgExecLegacyContent{}, "cosmos-sdk/v1/MsgExecLegacyContent")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateVote{}, "cosmos-sdk/v1/MsgDelegateVote")
+	legacy.RegisterAminoMsg(cdc, &MsgUndelegateVote{}, "cosmos-sdk/v1/MsgUndelegateVote")
+	legacy.RegisterAminoMsg(cdc, &MsgRejectVoteDelegation{}, "cosmos-sdk/v1/MsgRejectVoteDelegation")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelProposal{}, "cosmos-sdk/v1/MsgCancelProposal")
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -28,6 +32,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgVoteWeighted{},
 		&MsgDeposit{},
 		&MsgExecLegacyContent{},
+		&MsgDelegateVote{},
+		&MsgUndelegateVote{},
+		&MsgRejectVoteDelegation{},
+		&MsgCancelProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
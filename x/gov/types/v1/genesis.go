@@ -19,12 +19,15 @@ func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParam
 
 // DefaultGenesisState defines the default governance genesis state
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(
+	genesisState := NewGenesisState(
 		DefaultStartingProposalID,
 		DefaultDepositParams(),
 		DefaultVotingParams(),
 		DefaultTallyParams(),
 	)
+	optimisticParams := DefaultOptimisticParams()
+	genesisState.OptimisticParams = &optimisticParams
+	return genesisState
 }
 
 // Empty returns true if a GenesisState is empty
@@ -53,6 +56,29 @@ func ValidateGenesis(data *GenesisState) error {
 		return fmt.Errorf("invalid deposit params: %w", err)
 	}
 
+	if err := validateMessageBasedParams(data.MessageBasedParams); err != nil {
+		return fmt.Errorf("invalid message based params: %w", err)
+	}
+
+	if data.OptimisticParams != nil {
+		if err := validateOptimisticParams(*data.OptimisticParams); err != nil {
+			return fmt.Errorf("invalid optimistic params: %w", err)
+		}
+	}
+
+	delegations := make(map[string]bool, len(data.VoteDelegations))
+	for _, d := range data.VoteDelegations {
+		if err := d.Validate(); err != nil {
+			return fmt.Errorf("invalid vote delegation: %w", err)
+		}
+
+		key := d.Delegator + "/" + d.MsgTypeUrl
+		if delegations[key] {
+			return fmt.Errorf("duplicate vote delegation of %s for msg type %q", d.Delegator, d.MsgTypeUrl)
+		}
+		delegations[key] = true
+	}
+
 	return nil
 }
 
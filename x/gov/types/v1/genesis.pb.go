@@ -38,6 +38,19 @@ type GenesisState struct {
 	VotingParams *VotingParams `protobuf:"bytes,6,opt,name=voting_params,json=votingParams,proto3" json:"voting_params,omitempty"`
 	// params defines all the paramaters of related to tally.
 	TallyParams *TallyParams `protobuf:"bytes,7,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params,omitempty"`
+	// vote_delegations defines all the vote delegations present at genesis.
+	//
+	// Since: cosmos-sdk 0.47
+	VoteDelegations []*VoteDelegation `protobuf:"bytes,8,rep,name=vote_delegations,json=voteDelegations,proto3" json:"vote_delegations,omitempty"`
+	// message_based_params defines the deposit and tally params applying to the
+	// proposals containing messages of given types.
+	//
+	// Since: cosmos-sdk 0.47
+	MessageBasedParams []*MessageBasedParams `protobuf:"bytes,9,rep,name=message_based_params,json=messageBasedParams,proto3" json:"message_based_params,omitempty"`
+	// optimistic_params defines the params for optimistic proposals.
+	//
+	// Since: cosmos-sdk 0.47
+	OptimisticParams *OptimisticParams `protobuf:"bytes,10,opt,name=optimistic_params,json=optimisticParams,proto3" json:"optimistic_params,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -122,6 +135,27 @@ func (m *GenesisState) GetTallyParams() *TallyParams {
 	return nil
 }
 
+func (m *GenesisState) GetVoteDelegations() []*VoteDelegation {
+	if m != nil {
+		return m.VoteDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMessageBasedParams() []*MessageBasedParams {
+	if m != nil {
+		return m.MessageBasedParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOptimisticParams() *OptimisticParams {
+	if m != nil {
+		return m.OptimisticParams
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
 }
@@ -129,29 +163,34 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcd, 0x4e, 0xc2, 0x40,
-	0x14, 0x85, 0xa9, 0xfc, 0xa8, 0x03, 0xb8, 0x18, 0x8d, 0x34, 0x60, 0x1a, 0xe2, 0x0a, 0x63, 0x6c,
-	0x05, 0xe3, 0xd2, 0xc4, 0xf8, 0x13, 0xe3, 0x8e, 0x54, 0xe3, 0xc2, 0x0d, 0x29, 0x74, 0x52, 0x1b,
-	0x81, 0xdb, 0xf4, 0x5e, 0x27, 0xf2, 0x16, 0x3e, 0x80, 0x0f, 0xe4, 0x92, 0xa5, 0x4b, 0x03, 0x2f,
-	0x62, 0x98, 0x69, 0x05, 0xab, 0xab, 0xc9, 0xcd, 0xf9, 0xce, 0x99, 0x93, 0x7b, 0x59, 0x63, 0x00,
-	0x38, 0x02, 0x74, 0x02, 0x90, 0x8e, 0x6c, 0x3b, 0x81, 0x18, 0x0b, 0x0c, 0xd1, 0x8e, 0x62, 0x20,
-	0xe0, 0x55, 0x2d, 0xda, 0x01, 0x48, 0x5b, 0xb6, 0xeb, 0xb5, 0x0c, 0x0b, 0x52, 0x73, 0xfb, 0xef,
-	0x79, 0x56, 0xb9, 0xd1, 0xce, 0x3b, 0xf2, 0x48, 0xf0, 0x63, 0xb6, 0x83, 0xe4, 0xc5, 0x14, 0x8e,
-	0x83, 0x5e, 0x14, 0x43, 0x04, 0xe8, 0x0d, 0x7b, 0xa1, 0x6f, 0x1a, 0x4d, 0xa3, 0x55, 0x70, 0x79,
-	0xaa, 0x75, 0x13, 0xe9, 0xd6, 0xe7, 0x1d, 0xb6, 0xe1, 0x8b, 0x08, 0x30, 0x24, 0x34, 0xd7, 0x9a,
-	0xf9, 0x56, 0xb9, 0xb3, 0x6b, 0xff, 0xfa, 0xdd, 0xbe, 0xd2, 0xb2, 0xfb, 0xc3, 0xf1, 0x03, 0x56,
-	0x94, 0x40, 0x02, 0xcd, 0xbc, 0x32, 0x6c, 0x67, 0x0c, 0x0f, 0x40, 0xc2, 0xd5, 0x04, 0x3f, 0x65,
-	0x9b, 0x69, 0x0f, 0x34, 0x0b, 0x0a, 0xaf, 0x65, 0xf0, 0xb4, 0x8c, 0xbb, 0x24, 0xf9, 0x25, 0xdb,
-	0x4a, 0x7e, 0xeb, 0x45, 0x5e, 0xec, 0x8d, 0xd0, 0x2c, 0x36, 0x8d, 0x56, 0xb9, 0xb3, 0xf7, 0x7f,
-	0xb7, 0xae, 0x62, 0xdc, 0xaa, 0xbf, 0x3a, 0xf2, 0x73, 0x56, 0x95, 0xa0, 0x57, 0xa1, 0x33, 0x4a,
-	0x2a, 0xa3, 0xf1, 0xb7, 0xee, 0x62, 0x25, 0x3a, 0xa2, 0x22, 0x57, 0x26, 0x7e, 0xc6, 0x2a, 0xe4,
-	0x0d, 0x87, 0x93, 0x34, 0x60, 0x5d, 0x05, 0xd4, 0x33, 0x01, 0xf7, 0x0b, 0x24, 0xf1, 0x97, 0x69,
-	0x39, 0x5c, 0x5c, 0x7f, 0xcc, 0x2c, 0x63, 0x3a, 0xb3, 0x8c, 0xaf, 0x99, 0x65, 0xbc, 0xcd, 0xad,
-	0xdc, 0x74, 0x6e, 0xe5, 0x3e, 0xe7, 0x56, 0xee, 0xf1, 0x30, 0x08, 0xe9, 0xe9, 0xa5, 0x6f, 0x0f,
-	0x60, 0xe4, 0x24, 0xc7, 0xd5, 0xcf, 0x11, 0xfa, 0xcf, 0xce, 0xab, 0xba, 0x34, 0x4d, 0x22, 0x81,
-	0x8e, 0x6c, 0xf7, 0x4b, 0xea, 0xd8, 0x27, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xec, 0x74,
-	0x03, 0x33, 0x02, 0x00, 0x00,
+	// 428 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x8e, 0xd3, 0x30,
+	0x10, 0x87, 0x1b, 0x76, 0xbb, 0xec, 0xba, 0x2d, 0x2c, 0x66, 0xc5, 0x46, 0x5b, 0x08, 0x85, 0x53,
+	0x11, 0x22, 0xa1, 0x45, 0x1c, 0x91, 0x50, 0x29, 0x02, 0x24, 0x10, 0x55, 0x8a, 0x38, 0x70, 0x89,
+	0xdc, 0xc6, 0x0a, 0x16, 0x49, 0x27, 0xca, 0x18, 0x8b, 0x3e, 0x02, 0x37, 0x1e, 0x8b, 0x63, 0x8f,
+	0x1c, 0x51, 0xfb, 0x22, 0xab, 0xd8, 0x49, 0xff, 0xa4, 0x3d, 0x45, 0xe3, 0xf9, 0xe6, 0xf3, 0x2f,
+	0x23, 0x93, 0xf6, 0x14, 0x30, 0x01, 0xf4, 0x22, 0x50, 0x9e, 0xea, 0x79, 0x11, 0x9f, 0x71, 0x14,
+	0xe8, 0xa6, 0x19, 0x48, 0xa0, 0x2d, 0xd3, 0x74, 0x23, 0x50, 0xae, 0xea, 0x5d, 0x5d, 0x56, 0x58,
+	0x50, 0x86, 0x7b, 0xfc, 0xbb, 0x4e, 0x9a, 0xef, 0xcc, 0xe4, 0x58, 0x32, 0xc9, 0xe9, 0x73, 0x72,
+	0x81, 0x92, 0x65, 0x52, 0xcc, 0xa2, 0x20, 0xcd, 0x20, 0x05, 0x64, 0x71, 0x20, 0x42, 0xdb, 0xea,
+	0x58, 0xdd, 0x63, 0x9f, 0x96, 0xbd, 0x51, 0xd1, 0xfa, 0x10, 0xd2, 0x3e, 0x39, 0x0d, 0x79, 0x0a,
+	0x28, 0x24, 0xda, 0x37, 0x3a, 0x47, 0xdd, 0x46, 0xff, 0x9e, 0xbb, 0x73, 0xbb, 0x3b, 0x34, 0x6d,
+	0x7f, 0xcd, 0xd1, 0x27, 0xa4, 0xae, 0x40, 0x72, 0xb4, 0x8f, 0xf4, 0xc0, 0xdd, 0xca, 0xc0, 0x57,
+	0x90, 0xdc, 0x37, 0x04, 0x7d, 0x49, 0xce, 0xca, 0x1c, 0x68, 0x1f, 0x6b, 0xfc, 0xb2, 0x82, 0x97,
+	0x61, 0xfc, 0x0d, 0x49, 0xdf, 0x90, 0x5b, 0xc5, 0x6d, 0x41, 0xca, 0x32, 0x96, 0xa0, 0x5d, 0xef,
+	0x58, 0xdd, 0x46, 0xff, 0xfe, 0xe1, 0x6c, 0x23, 0xcd, 0xf8, 0xad, 0x70, 0xbb, 0xa4, 0xaf, 0x49,
+	0x4b, 0x81, 0x59, 0x85, 0x71, 0x9c, 0x68, 0x47, 0x7b, 0x3f, 0x6e, 0xbe, 0x12, 0xa3, 0x68, 0xaa,
+	0xad, 0x8a, 0xbe, 0x22, 0x4d, 0xc9, 0xe2, 0x78, 0x5e, 0x0a, 0x6e, 0x6a, 0xc1, 0x55, 0x45, 0xf0,
+	0x25, 0x47, 0x8a, 0xf9, 0x86, 0xdc, 0x14, 0xf4, 0x3d, 0x39, 0xcf, 0xb7, 0x10, 0x84, 0x3c, 0xe6,
+	0x11, 0x93, 0x02, 0x66, 0x68, 0x9f, 0xea, 0x1d, 0x3c, 0x38, 0xb0, 0xb2, 0xe1, 0x9a, 0xf2, 0x6f,
+	0xab, 0x9d, 0x1a, 0xe9, 0x98, 0x5c, 0x24, 0x1c, 0x91, 0x45, 0x3c, 0x98, 0x30, 0xe4, 0x61, 0x19,
+	0xe8, 0x4c, 0xdb, 0x1e, 0x55, 0x6c, 0x9f, 0x0c, 0x3a, 0xc8, 0xc9, 0x22, 0x17, 0x4d, 0xf6, 0xce,
+	0xe8, 0x47, 0x72, 0x07, 0x52, 0x29, 0x12, 0x81, 0x52, 0x4c, 0x4b, 0x23, 0xd1, 0xbf, 0xf8, 0xb0,
+	0x62, 0xfc, 0xbc, 0xe6, 0x0a, 0xdf, 0x39, 0x54, 0x4e, 0x06, 0x6f, 0xff, 0x2e, 0x1d, 0x6b, 0xb1,
+	0x74, 0xac, 0xff, 0x4b, 0xc7, 0xfa, 0xb3, 0x72, 0x6a, 0x8b, 0x95, 0x53, 0xfb, 0xb7, 0x72, 0x6a,
+	0xdf, 0x9e, 0x46, 0x42, 0x7e, 0xff, 0x39, 0x71, 0xa7, 0x90, 0x78, 0xc5, 0x4b, 0x36, 0x9f, 0x67,
+	0x18, 0xfe, 0xf0, 0x7e, 0xe9, 0x67, 0x2d, 0xe7, 0x29, 0x47, 0x4f, 0xf5, 0x26, 0x27, 0xfa, 0x65,
+	0xbf, 0xb8, 0x1e, 0x00, 0x27, 0x6f, 0x02, 0x5c, 0x20, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -174,6 +213,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OptimisticParams != nil {
+		{
+			size, err := m.OptimisticParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.MessageBasedParams) > 0 {
+		for iNdEx := len(m.MessageBasedParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageBasedParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.VoteDelegations) > 0 {
+		for iNdEx := len(m.VoteDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.TallyParams != nil {
 		{
 			size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
@@ -310,6 +389,22 @@ func (m *GenesisState) Size() (n int) {
 		l = m.TallyParams.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.VoteDelegations) > 0 {
+		for _, e := range m.VoteDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MessageBasedParams) > 0 {
+		for _, e := range m.MessageBasedParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.OptimisticParams != nil {
+		l = m.OptimisticParams.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -577,6 +672,110 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteDelegations = append(m.VoteDelegations, &VoteDelegation{})
+			if err := m.VoteDelegations[len(m.VoteDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageBasedParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageBasedParams = append(m.MessageBasedParams, &MessageBasedParams{})
+			if err := m.MessageBasedParams[len(m.MessageBasedParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptimisticParams == nil {
+				m.OptimisticParams = &OptimisticParams{}
+			}
+			if err := m.OptimisticParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package v1_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/stretchr/testify/require"
 )
@@ -20,6 +21,25 @@ func TestValidateGenesis(t *testing.T) {
 	votingParams := v1.DefaultVotingParams()
 	tallyParams := v1.DefaultTallyParams()
 
+	lowExpeditedMinDeposit := v1.DefaultDepositParams()
+	lowExpeditedMinDeposit.ExpeditedMinDeposit = lowExpeditedMinDeposit.MinDeposit
+
+	longExpeditedVotingPeriod := v1.DefaultVotingParams()
+	longExpeditedVotingPeriod.ExpeditedVotingPeriod = longExpeditedVotingPeriod.VotingPeriod
+
+	lowExpeditedThreshold := v1.DefaultTallyParams()
+	lowExpeditedThreshold.ExpeditedThreshold = lowExpeditedThreshold.Threshold
+
+	sendParams := v1.NewMessageBasedParams(
+		"/cosmos.bank.v1beta1.MsgSend", depositParams.MinDeposit,
+		v1.DefaultQuorum, v1.DefaultThreshold, v1.DefaultVetoThreshold,
+	)
+	invalidSendParams := sendParams
+	invalidSendParams.Quorum = "2"
+
+	invalidOptimisticAddress := v1.NewOptimisticParams([]string{"invalid"}, v1.DefaultOptimisticRejectedThreshold)
+	invalidOptimisticThreshold := v1.NewOptimisticParams(nil, sdk.ZeroDec())
+
 	testCases := []struct {
 		name         string
 		genesisState *v1.GenesisState
@@ -69,6 +89,90 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErr: true,
 		},
+		{
+			name: "expedited minimum deposit not greater than minimum deposit",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &lowExpeditedMinDeposit,
+				VotingParams:       &votingParams,
+				TallyParams:        &tallyParams,
+			},
+			expErr: true,
+		},
+		{
+			name: "expedited voting period not shorter than voting period",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &longExpeditedVotingPeriod,
+				TallyParams:        &tallyParams,
+			},
+			expErr: true,
+		},
+		{
+			name: "expedited threshold not greater than threshold",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &lowExpeditedThreshold,
+			},
+			expErr: true,
+		},
+		{
+			name: "valid message based params",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &tallyParams,
+				MessageBasedParams: []*v1.MessageBasedParams{&sendParams},
+			},
+		},
+		{
+			name: "invalid message based params",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &tallyParams,
+				MessageBasedParams: []*v1.MessageBasedParams{&invalidSendParams},
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate message based params",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &tallyParams,
+				MessageBasedParams: []*v1.MessageBasedParams{&sendParams, &sendParams},
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid optimistic authorized address",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &tallyParams,
+				OptimisticParams:   &invalidOptimisticAddress,
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid optimistic rejected threshold",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &tallyParams,
+				OptimisticParams:   &invalidOptimisticThreshold,
+			},
+			expErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
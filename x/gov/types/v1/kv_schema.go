@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// KVSchema returns the key/value schema of the gov store.
+func KVSchema() []schema.Entry {
+	proposalID := schema.Field("proposal_id", schema.Uint64Key)
+	endTime := schema.Field("end_time", schema.Fixed(len(sdk.FormatTimeBytes(time.Time{})), schema.TimeKey))
+	return []schema.Entry{
+		{
+			Name:   "proposal",
+			Prefix: types.ProposalsKeyPrefix,
+			Key:    []schema.KeyField{proposalID},
+			Value:  schema.ProtoValue(&Proposal{}),
+		},
+		{
+			Name:   "active_proposal_queue",
+			Prefix: types.ActiveProposalQueuePrefix,
+			Key:    []schema.KeyField{endTime, proposalID},
+			Value:  schema.Uint64Value,
+		},
+		{
+			Name:   "inactive_proposal_queue",
+			Prefix: types.InactiveProposalQueuePrefix,
+			Key:    []schema.KeyField{endTime, proposalID},
+			Value:  schema.Uint64Value,
+		},
+		{Name: "next_proposal_id", Prefix: types.ProposalIDKey, Value: schema.Uint64Value},
+		{
+			Name:   "deposit",
+			Prefix: types.DepositsKeyPrefix,
+			Key:    []schema.KeyField{proposalID, schema.Field("depositor", schema.LengthPrefixed(schema.AccAddressKey))},
+			Value:  schema.ProtoValue(&Deposit{}),
+		},
+		{
+			Name:   "vote",
+			Prefix: types.VotesKeyPrefix,
+			Key:    []schema.KeyField{proposalID, schema.Field("voter", schema.LengthPrefixed(schema.AccAddressKey))},
+			Value:  schema.ProtoValue(&Vote{}),
+		},
+		{
+			Name:   "vote_delegation",
+			Prefix: types.VoteDelegationsKeyPrefix,
+			Key: []schema.KeyField{
+				schema.Field("delegator", schema.LengthPrefixed(schema.AccAddressKey)),
+				schema.Field("msg_type_url", schema.StringKey),
+			},
+			Value: schema.ProtoValue(&VoteDelegation{}),
+		},
+		{
+			Name:   "vote_delegator",
+			Prefix: types.VoteDelegatorsKeyPrefix,
+			Key: []schema.KeyField{
+				schema.Field("delegate", schema.LengthPrefixed(schema.AccAddressKey)),
+				schema.Field("delegator", schema.LengthPrefixed(schema.AccAddressKey)),
+				schema.Field("msg_type_url", schema.StringKey),
+			},
+			Value: schema.BytesValue,
+		},
+	}
+}
@@ -0,0 +1,62 @@
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestKVSchema(t *testing.T) {
+	registry := schema.NewRegistry(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()))
+	registry.Register(types.StoreKey, v1.KVSchema()...)
+
+	delegate := sdk.AccAddress("delegate____________")
+	delegator := sdk.AccAddress("delegator___________")
+	now := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	msgTypeURL := "/cosmos.bank.v1beta1.MsgSend"
+
+	testCases := []struct {
+		name     string
+		key      []byte
+		value    []byte
+		expected string
+	}{
+		{
+			"active proposal queue",
+			types.ActiveProposalQueueKey(7, now),
+			types.GetProposalIDBytes(7),
+			`{"entry":"active_proposal_queue","key":{"end_time":"2022-01-02T03:04:05Z","proposal_id":7},"value":7}`,
+		},
+		{
+			"next proposal id",
+			types.ProposalIDKey,
+			types.GetProposalIDBytes(8),
+			`{"entry":"next_proposal_id","key":{},"value":8}`,
+		},
+		{
+			"vote delegator",
+			types.VoteDelegatorKey(delegate, delegator, msgTypeURL),
+			[]byte{},
+			`{"entry":"vote_delegator","key":{"delegate":"` + delegate.String() + `","delegator":"` + delegator.String() + `","msg_type_url":"` + msgTypeURL + `"},"value":""}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			decoded, err := registry.Decode(types.StoreKey, tc.key, tc.value)
+			require.NoError(t, err)
+			bz, err := json.Marshal(decoded)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, string(bz))
+		})
+	}
+}
@@ -12,17 +12,19 @@ import (
 )
 
 var (
-	_, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}
-	_, _          codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _, _, _, _ sdk.Msg                         = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgDelegateVote{}, &MsgUndelegateVote{}, &MsgRejectVoteDelegation{}, &MsgCancelProposal{}
+	_, _                   codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
 //nolint:interfacer
-func NewMsgSubmitProposal(messages []sdk.Msg, initialDeposit sdk.Coins, proposer string, metadata string) (*MsgSubmitProposal, error) {
+func NewMsgSubmitProposal(messages []sdk.Msg, initialDeposit sdk.Coins, proposer string, metadata string, expedited, optimistic bool) (*MsgSubmitProposal, error) {
 	m := &MsgSubmitProposal{
 		InitialDeposit: initialDeposit,
 		Proposer:       proposer,
 		Metadata:       metadata,
+		Expedited:      expedited,
+		Optimistic:     optimistic,
 	}
 
 	anys, err := sdktx.SetMsgs(messages)
@@ -60,6 +62,10 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, deposit.String())
 	}
 
+	if m.Expedited && m.Optimistic {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal cannot be both expedited and optimistic")
+	}
+
 	// Check that either metadata or Msgs length is non nil.
 	if len(m.Messages) == 0 && len(m.Metadata) == 0 {
 		return sdkerrors.Wrap(types.ErrNoProposalMsgs, "either metadata or Msgs length must be non-nil")
@@ -234,6 +240,142 @@ func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{voter}
 }
 
+// NewMsgDelegateVote creates a message to delegate the votes of an account
+//nolint:interfacer
+func NewMsgDelegateVote(delegator, delegate sdk.AccAddress, msgTypeURL string) *MsgDelegateVote {
+	return &MsgDelegateVote{delegator.String(), delegate.String(), msgTypeURL}
+}
+
+// Route implements Msg
+func (msg MsgDelegateVote) Route() string { return types.RouterKey }
+
+// Type implements Msg
+func (msg MsgDelegateVote) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements Msg
+func (msg MsgDelegateVote) ValidateBasic() error {
+	return VoteDelegation{
+		Delegator:  msg.Delegator,
+		Delegate:   msg.Delegate,
+		MsgTypeUrl: msg.MsgTypeUrl,
+	}.Validate()
+}
+
+// GetSignBytes implements Msg
+func (msg MsgDelegateVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgDelegateVote) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.Delegator)
+	return []sdk.AccAddress{delegator}
+}
+
+// NewMsgUndelegateVote creates a message to remove a vote delegation of an account
+//nolint:interfacer
+func NewMsgUndelegateVote(delegator sdk.AccAddress, msgTypeURL string) *MsgUndelegateVote {
+	return &MsgUndelegateVote{delegator.String(), msgTypeURL}
+}
+
+// Route implements Msg
+func (msg MsgUndelegateVote) Route() string { return types.RouterKey }
+
+// Type implements Msg
+func (msg MsgUndelegateVote) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements Msg
+func (msg MsgUndelegateVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Delegator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgUndelegateVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgUndelegateVote) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.Delegator)
+	return []sdk.AccAddress{delegator}
+}
+
+// NewMsgRejectVoteDelegation creates a message for a delegate to remove a vote
+// delegation made to it
+//nolint:interfacer
+func NewMsgRejectVoteDelegation(delegate, delegator sdk.AccAddress, msgTypeURL string) *MsgRejectVoteDelegation {
+	return &MsgRejectVoteDelegation{delegate.String(), delegator.String(), msgTypeURL}
+}
+
+// Route implements Msg
+func (msg MsgRejectVoteDelegation) Route() string { return types.RouterKey }
+
+// Type implements Msg
+func (msg MsgRejectVoteDelegation) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements Msg
+func (msg MsgRejectVoteDelegation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Delegate); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegate address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Delegator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgRejectVoteDelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgRejectVoteDelegation) GetSigners() []sdk.AccAddress {
+	delegate, _ := sdk.AccAddressFromBech32(msg.Delegate)
+	return []sdk.AccAddress{delegate}
+}
+
+// NewMsgCancelProposal creates a message to cancel a proposal
+//nolint:interfacer
+func NewMsgCancelProposal(proposalID uint64, proposer sdk.AccAddress) *MsgCancelProposal {
+	return &MsgCancelProposal{proposalID, proposer.String()}
+}
+
+// Route implements Msg
+func (msg MsgCancelProposal) Route() string { return types.RouterKey }
+
+// Type implements Msg
+func (msg MsgCancelProposal) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements Msg
+func (msg MsgCancelProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	proposer, _ := sdk.AccAddressFromBech32(msg.Proposer)
+	return []sdk.AccAddress{proposer}
+}
+
 func NewMsgExecLegacyContent(content *codectypes.Any, authority string) *MsgExecLegacyContent {
 	return &MsgExecLegacyContent{
 		Content:   content,
@@ -146,18 +146,23 @@ func TestMsgSubmitProposal_ValidateBasic(t *testing.T) {
 		initialDeposit sdk.Coins
 		messages       []sdk.Msg
 		metadata       string
+		expedited      bool
+		optimistic     bool
 		expErr         bool
 	}{
-		{"invalid addr", "", coinsPos, []sdk.Msg{msg1}, metadata, true},
-		{"empty msgs and metadata", addrs[0].String(), coinsPos, nil, "", true},
-		{"invalid msg", addrs[0].String(), coinsPos, []sdk.Msg{msg1, msg2}, metadata, true},
-		{"valid with no Msg", addrs[0].String(), coinsPos, nil, metadata, false},
-		{"valid with no metadata", addrs[0].String(), coinsPos, []sdk.Msg{msg1}, "", false},
-		{"valid with everything", addrs[0].String(), coinsPos, []sdk.Msg{msg1}, metadata, false},
+		{"invalid addr", "", coinsPos, []sdk.Msg{msg1}, metadata, false, false, true},
+		{"empty msgs and metadata", addrs[0].String(), coinsPos, nil, "", false, false, true},
+		{"invalid msg", addrs[0].String(), coinsPos, []sdk.Msg{msg1, msg2}, metadata, false, false, true},
+		{"expedited and optimistic", addrs[0].String(), coinsPos, []sdk.Msg{msg1}, metadata, true, true, true},
+		{"valid with no Msg", addrs[0].String(), coinsPos, nil, metadata, false, false, false},
+		{"valid with no metadata", addrs[0].String(), coinsPos, []sdk.Msg{msg1}, "", false, false, false},
+		{"valid with everything", addrs[0].String(), coinsPos, []sdk.Msg{msg1}, metadata, false, false, false},
+		{"valid expedited", addrs[0].String(), coinsPos, []sdk.Msg{msg1}, metadata, true, false, false},
+		{"valid optimistic", addrs[0].String(), coinsPos, []sdk.Msg{msg1}, metadata, false, true, false},
 	}
 
 	for _, tc := range tests {
-		msg, err := v1.NewMsgSubmitProposal(tc.messages, tc.initialDeposit, tc.proposer, tc.metadata)
+		msg, err := v1.NewMsgSubmitProposal(tc.messages, tc.initialDeposit, tc.proposer, tc.metadata, tc.expedited, tc.optimistic)
 		require.NoError(t, err)
 		if tc.expErr {
 			require.Error(t, msg.ValidateBasic(), "test: %s", tc.name)
@@ -170,7 +175,7 @@ func TestMsgSubmitProposal_ValidateBasic(t *testing.T) {
 // this tests that Amino JSON MsgSubmitProposal.GetSignBytes() still works with Content as Any using the ModuleCdc
 func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 	proposal := []sdk.Msg{v1.NewMsgVote(addrs[0], 1, v1.OptionYes, "")}
-	msg, err := v1.NewMsgSubmitProposal(proposal, sdk.NewCoins(), sdk.AccAddress{}.String(), "")
+	msg, err := v1.NewMsgSubmitProposal(proposal, sdk.NewCoins(), sdk.AccAddress{}.String(), "", false, false)
 	require.NoError(t, err)
 	var bz []byte
 	require.NotPanics(t, func() {
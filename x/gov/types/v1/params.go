@@ -11,15 +11,20 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
 // Default governance params
 var (
-	DefaultMinDepositTokens = sdk.NewInt(10000000)
-	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
-	DefaultVetoThreshold    = sdk.NewDecWithPrec(334, 3)
+	DefaultMinDepositTokens            = sdk.NewInt(10000000)
+	DefaultMinExpeditedDepositTokens   = DefaultMinDepositTokens.Mul(sdk.NewInt(5))
+	DefaultQuorum                      = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold                   = sdk.NewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold          = sdk.NewDecWithPrec(667, 3)
+	DefaultVetoThreshold               = sdk.NewDecWithPrec(334, 3)
+	DefaultProposalCancelRatio         = sdk.NewDecWithPrec(5, 1)
+	DefaultOptimisticRejectedThreshold = sdk.NewDecWithPrec(1, 1)
 )
 
 // Parameter store key
@@ -27,6 +32,9 @@ var (
 	ParamStoreKeyDepositParams = []byte("depositparams")
 	ParamStoreKeyVotingParams  = []byte("votingparams")
 	ParamStoreKeyTallyParams   = []byte("tallyparams")
+
+	ParamStoreKeyMessageBasedParams = []byte("messagebasedparams")
+	ParamStoreKeyOptimisticParams   = []byte("optimisticparams")
 )
 
 // ParamKeyTable - Key declaration for parameters
@@ -35,14 +43,22 @@ func ParamKeyTable() paramtypes.KeyTable {
 		paramtypes.NewParamSetPair(ParamStoreKeyDepositParams, DepositParams{}, validateDepositParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyVotingParams, VotingParams{}, validateVotingParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyTallyParams, TallyParams{}, validateTallyParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyMessageBasedParams, []*MessageBasedParams{}, validateMessageBasedParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyOptimisticParams, OptimisticParams{}, validateOptimisticParams),
 	)
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(
+	minDeposit sdk.Coins, maxDepositPeriod time.Duration, expeditedMinDeposit sdk.Coins,
+	proposalCancelRatio sdk.Dec, proposalCancelDest string,
+) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: &maxDepositPeriod,
+		MinDeposit:          minDeposit,
+		MaxDepositPeriod:    &maxDepositPeriod,
+		ExpeditedMinDeposit: expeditedMinDeposit,
+		ProposalCancelRatio: proposalCancelRatio.String(),
+		ProposalCancelDest:  proposalCancelDest,
 	}
 }
 
@@ -51,12 +67,17 @@ func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinExpeditedDepositTokens)),
+		DefaultProposalCancelRatio,
+		"",
 	)
 }
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return sdk.Coins(dp.MinDeposit).IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return sdk.Coins(dp.MinDeposit).IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		sdk.Coins(dp.ExpeditedMinDeposit).IsEqual(dp2.ExpeditedMinDeposit) &&
+		dp.ProposalCancelRatio == dp2.ProposalCancelRatio && dp.ProposalCancelDest == dp2.ProposalCancelDest
 }
 
 func validateDepositParams(i interface{}) error {
@@ -71,27 +92,51 @@ func validateDepositParams(i interface{}) error {
 	if v.MaxDepositPeriod == nil || v.MaxDepositPeriod.Seconds() <= 0 {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
+	if !sdk.Coins(v.ExpeditedMinDeposit).IsValid() {
+		return fmt.Errorf("invalid expedited minimum deposit: %s", v.ExpeditedMinDeposit)
+	}
+	if !sdk.Coins(v.ExpeditedMinDeposit).IsAllGT(v.MinDeposit) {
+		return fmt.Errorf("expedited minimum deposit must be greater than minimum deposit: %s", v.ExpeditedMinDeposit)
+	}
+
+	proposalCancelRatio, err := sdk.NewDecFromStr(v.ProposalCancelRatio)
+	if err != nil {
+		return fmt.Errorf("invalid proposal cancel ratio: %w", err)
+	}
+	if proposalCancelRatio.IsNegative() {
+		return fmt.Errorf("proposal cancel ratio cannot be negative: %s", proposalCancelRatio)
+	}
+	if proposalCancelRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("proposal cancel ratio too large: %s", proposalCancelRatio)
+	}
+	if v.ProposalCancelDest != "" {
+		if _, err := sdk.AccAddressFromBech32(v.ProposalCancelDest); err != nil {
+			return fmt.Errorf("invalid proposal cancel destination address: %w", err)
+		}
+	}
 
 	return nil
 }
 
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold, vetoThreshold sdk.Dec) TallyParams {
+func NewTallyParams(quorum, threshold, vetoThreshold, expeditedThreshold sdk.Dec) TallyParams {
 	return TallyParams{
-		Quorum:        quorum.String(),
-		Threshold:     threshold.String(),
-		VetoThreshold: vetoThreshold.String(),
+		Quorum:             quorum.String(),
+		Threshold:          threshold.String(),
+		VetoThreshold:      vetoThreshold.String(),
+		ExpeditedThreshold: expeditedThreshold.String(),
 	}
 }
 
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold)
+	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold, DefaultExpeditedThreshold)
 }
 
 // Equal checks equality of TallyParams
 func (tp TallyParams) Equal(other TallyParams) bool {
-	return tp.Quorum == other.Quorum && tp.Threshold == other.Threshold && tp.VetoThreshold == other.VetoThreshold
+	return tp.Quorum == other.Quorum && tp.Threshold == other.Threshold && tp.VetoThreshold == other.VetoThreshold &&
+		tp.ExpeditedThreshold == other.ExpeditedThreshold
 }
 
 func validateTallyParams(i interface{}) error {
@@ -100,7 +145,76 @@ func validateTallyParams(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	quorum, err := sdk.NewDecFromStr(v.Quorum)
+	if err := validateTally(v.Quorum, v.Threshold, v.VetoThreshold); err != nil {
+		return err
+	}
+
+	threshold := sdk.MustNewDecFromStr(v.Threshold)
+	expeditedThreshold, err := sdk.NewDecFromStr(v.ExpeditedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid expedited threshold string: %w", err)
+	}
+	if expeditedThreshold.LTE(threshold) {
+		return fmt.Errorf("expedited vote threshold must be greater than vote threshold: %s", expeditedThreshold)
+	}
+	if expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", v)
+	}
+
+	return nil
+}
+
+// NewVotingParams creates a new VotingParams object
+func NewVotingParams(votingPeriod, expeditedVotingPeriod time.Duration) VotingParams {
+	return VotingParams{
+		VotingPeriod:          &votingPeriod,
+		ExpeditedVotingPeriod: &expeditedVotingPeriod,
+	}
+}
+
+// DefaultVotingParams default parameters for voting
+func DefaultVotingParams() VotingParams {
+	return NewVotingParams(DefaultPeriod, DefaultExpeditedPeriod)
+}
+
+// Equal checks equality of TallyParams
+func (vp VotingParams) Equal(other VotingParams) bool {
+	return vp.VotingPeriod == other.VotingPeriod && vp.ExpeditedVotingPeriod == other.ExpeditedVotingPeriod
+}
+
+func validateVotingParams(i interface{}) error {
+	v, ok := i.(VotingParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.VotingPeriod == nil {
+		return errors.New("voting period must not be nil")
+	}
+
+	if v.VotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
+	}
+
+	if v.ExpeditedVotingPeriod == nil {
+		return errors.New("expedited voting period must not be nil")
+	}
+
+	if v.ExpeditedVotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", v.ExpeditedVotingPeriod)
+	}
+
+	if *v.ExpeditedVotingPeriod >= *v.VotingPeriod {
+		return fmt.Errorf("expedited voting period %s must be strictly less than the voting period %s", v.ExpeditedVotingPeriod, v.VotingPeriod)
+	}
+
+	return nil
+}
+
+// validateTally validates the quorum, threshold and veto threshold of tally
+// params.
+func validateTally(quorumStr, thresholdStr, vetoThresholdStr string) error {
+	quorum, err := sdk.NewDecFromStr(quorumStr)
 	if err != nil {
 		return fmt.Errorf("invalid quorum string: %w", err)
 	}
@@ -108,10 +222,10 @@ func validateTallyParams(i interface{}) error {
 		return fmt.Errorf("quorom cannot be negative: %s", quorum)
 	}
 	if quorum.GT(sdk.OneDec()) {
-		return fmt.Errorf("quorom too large: %s", v)
+		return fmt.Errorf("quorom too large: %s", quorum)
 	}
 
-	threshold, err := sdk.NewDecFromStr(v.Threshold)
+	threshold, err := sdk.NewDecFromStr(thresholdStr)
 	if err != nil {
 		return fmt.Errorf("invalid threshold string: %w", err)
 	}
@@ -119,10 +233,10 @@ func validateTallyParams(i interface{}) error {
 		return fmt.Errorf("vote threshold must be positive: %s", threshold)
 	}
 	if threshold.GT(sdk.OneDec()) {
-		return fmt.Errorf("vote threshold too large: %s", v)
+		return fmt.Errorf("vote threshold too large: %s", threshold)
 	}
 
-	vetoThreshold, err := sdk.NewDecFromStr(v.VetoThreshold)
+	vetoThreshold, err := sdk.NewDecFromStr(vetoThresholdStr)
 	if err != nil {
 		return fmt.Errorf("invalid vetoThreshold string: %w", err)
 	}
@@ -130,41 +244,164 @@ func validateTallyParams(i interface{}) error {
 		return fmt.Errorf("veto threshold must be positive: %s", vetoThreshold)
 	}
 	if vetoThreshold.GT(sdk.OneDec()) {
-		return fmt.Errorf("veto threshold too large: %s", v)
+		return fmt.Errorf("veto threshold too large: %s", vetoThreshold)
 	}
 
 	return nil
 }
 
-// NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriod time.Duration) VotingParams {
-	return VotingParams{
-		VotingPeriod: &votingPeriod,
+// NewMessageBasedParams creates a new MessageBasedParams object
+func NewMessageBasedParams(msgTypeURL string, minDeposit sdk.Coins, quorum, threshold, vetoThreshold sdk.Dec) MessageBasedParams {
+	return MessageBasedParams{
+		MsgTypeUrl:    msgTypeURL,
+		MinDeposit:    minDeposit,
+		Quorum:        quorum.String(),
+		Threshold:     threshold.String(),
+		VetoThreshold: vetoThreshold.String(),
 	}
 }
 
-// DefaultVotingParams default parameters for voting
-func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod)
+// Validate performs a basic validation of the MessageBasedParams.
+func (p MessageBasedParams) Validate() error {
+	if p.MsgTypeUrl == "" {
+		return errors.New("message type URL cannot be empty")
+	}
+	if !sdk.Coins(p.MinDeposit).IsValid() {
+		return fmt.Errorf("invalid minimum deposit: %s", p.MinDeposit)
+	}
+
+	return validateTally(p.Quorum, p.Threshold, p.VetoThreshold)
 }
 
-// Equal checks equality of TallyParams
-func (vp VotingParams) Equal(other VotingParams) bool {
-	return vp.VotingPeriod == other.VotingPeriod
+func validateMessageBasedParams(i interface{}) error {
+	v, ok := i.([]*MessageBasedParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	msgTypeURLs := make(map[string]bool, len(v))
+	for _, p := range v {
+		if p == nil {
+			return errors.New("message based params cannot be nil")
+		}
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("invalid params for %s: %w", p.MsgTypeUrl, err)
+		}
+		if msgTypeURLs[p.MsgTypeUrl] {
+			return fmt.Errorf("duplicate params for %s", p.MsgTypeUrl)
+		}
+		msgTypeURLs[p.MsgTypeUrl] = true
+	}
+
+	return nil
 }
 
-func validateVotingParams(i interface{}) error {
-	v, ok := i.(VotingParams)
+// ApplyMessageBasedParams returns the deposit and tally params applying to a
+// proposal made of messages of the given type URLs. Each message type is
+// governed by its MessageBasedParams if any, and by the given deposit and tally
+// params otherwise, and the strictest of these params are returned: the
+// largest minimum deposit, quorum and threshold, and the lowest veto
+// threshold. The expedited minimum deposit and threshold are raised to the
+// minimum deposit and threshold if they're lower.
+func ApplyMessageBasedParams(
+	dp DepositParams, tp TallyParams, messageBasedParams []*MessageBasedParams, msgTypeURLs []string,
+) (DepositParams, TallyParams) {
+	byMsgTypeURL := make(map[string]*MessageBasedParams, len(messageBasedParams))
+	for _, p := range messageBasedParams {
+		byMsgTypeURL[p.MsgTypeUrl] = p
+	}
+
+	var (
+		minDeposit                       sdk.Coins
+		quorum, threshold, vetoThreshold sdk.Dec
+		found                            bool
+	)
+	for i, msgTypeURL := range msgTypeURLs {
+		msgMinDeposit, msgQuorum, msgThreshold, msgVetoThreshold := dp.MinDeposit, tp.Quorum, tp.Threshold, tp.VetoThreshold
+		if p, ok := byMsgTypeURL[msgTypeURL]; ok {
+			msgMinDeposit, msgQuorum, msgThreshold, msgVetoThreshold = p.MinDeposit, p.Quorum, p.Threshold, p.VetoThreshold
+			found = true
+		}
+
+		if i == 0 {
+			minDeposit = sdk.NewCoins(msgMinDeposit...)
+			quorum = sdk.MustNewDecFromStr(msgQuorum)
+			threshold = sdk.MustNewDecFromStr(msgThreshold)
+			vetoThreshold = sdk.MustNewDecFromStr(msgVetoThreshold)
+			continue
+		}
+
+		minDeposit = minDeposit.Max(sdk.NewCoins(msgMinDeposit...))
+		quorum = sdk.MaxDec(quorum, sdk.MustNewDecFromStr(msgQuorum))
+		threshold = sdk.MaxDec(threshold, sdk.MustNewDecFromStr(msgThreshold))
+		vetoThreshold = sdk.MinDec(vetoThreshold, sdk.MustNewDecFromStr(msgVetoThreshold))
+	}
+
+	// no message type has its own params
+	if !found {
+		return dp, tp
+	}
+
+	dp.MinDeposit = minDeposit
+	dp.ExpeditedMinDeposit = minDeposit.Max(dp.ExpeditedMinDeposit)
+	tp.Quorum = quorum.String()
+	tp.Threshold = threshold.String()
+	tp.VetoThreshold = vetoThreshold.String()
+	tp.ExpeditedThreshold = sdk.MaxDec(threshold, sdk.MustNewDecFromStr(tp.ExpeditedThreshold)).String()
+
+	return dp, tp
+}
+
+// NewOptimisticParams creates a new OptimisticParams object
+func NewOptimisticParams(authorizedAddresses []string, rejectedThreshold sdk.Dec) OptimisticParams {
+	return OptimisticParams{
+		AuthorizedAddresses: authorizedAddresses,
+		RejectedThreshold:   rejectedThreshold.String(),
+	}
+}
+
+// DefaultOptimisticParams default parameters for optimistic proposals
+func DefaultOptimisticParams() OptimisticParams {
+	return NewOptimisticParams(nil, DefaultOptimisticRejectedThreshold)
+}
+
+// IsAuthorized returns true if the address is allowed to submit optimistic
+// proposals.
+func (op OptimisticParams) IsAuthorized(address string) bool {
+	for _, authorized := range op.AuthorizedAddresses {
+		if authorized == address {
+			return true
+		}
+	}
+	return false
+}
+
+func validateOptimisticParams(i interface{}) error {
+	v, ok := i.(OptimisticParams)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if v.VotingPeriod == nil {
-		return errors.New("voting period must not be nil")
+	addresses := make(map[string]bool, len(v.AuthorizedAddresses))
+	for _, address := range v.AuthorizedAddresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid authorized address %s: %w", address, err)
+		}
+		if addresses[address] {
+			return fmt.Errorf("duplicate authorized address: %s", address)
+		}
+		addresses[address] = true
 	}
 
-	if v.VotingPeriod.Seconds() <= 0 {
-		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
+	rejectedThreshold, err := sdk.NewDecFromStr(v.RejectedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid rejected threshold string: %w", err)
+	}
+	if !rejectedThreshold.IsPositive() {
+		return fmt.Errorf("rejected threshold must be positive: %s", rejectedThreshold)
+	}
+	if rejectedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("rejected threshold too large: %s", rejectedThreshold)
 	}
 
 	return nil
@@ -175,6 +412,9 @@ type Params struct {
 	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
 	TallyParams   TallyParams   `json:"tally_params" yaml:"tally_params"`
 	DepositParams DepositParams `json:"deposit_params" yaml:"deposit_params"`
+
+	MessageBasedParams []*MessageBasedParams `json:"message_based_params,omitempty" yaml:"message_based_params,omitempty"`
+	OptimisticParams   OptimisticParams      `json:"optimistic_params" yaml:"optimistic_params"`
 }
 
 func (gp Params) String() string {
@@ -193,5 +433,7 @@ func NewParams(vp VotingParams, tp TallyParams, dp DepositParams) Params {
 
 // DefaultParams default governance params
 func DefaultParams() Params {
-	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams())
+	params := NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams())
+	params.OptimisticParams = DefaultOptimisticParams()
+	return params
 }
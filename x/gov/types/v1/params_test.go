@@ -0,0 +1,106 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestApplyMessageBasedParams(t *testing.T) {
+	const (
+		sendURL    = "/cosmos.bank.v1beta1.MsgSend"
+		upgradeURL = "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade"
+		otherURL   = "/cosmos.staking.v1beta1.MsgDelegate"
+	)
+
+	dp := v1.DefaultDepositParams()
+	tp := v1.DefaultTallyParams()
+
+	sendParams := v1.NewMessageBasedParams(
+		sendURL, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.NewInt64Coin("atom", 100)),
+		sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(4, 1), sdk.NewDecWithPrec(5, 1),
+	)
+	upgradeParams := v1.NewMessageBasedParams(
+		upgradeURL, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, v1.DefaultMinExpeditedDepositTokens.MulRaw(2))),
+		sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(75, 2), sdk.NewDecWithPrec(2, 1),
+	)
+	messageBasedParams := []*v1.MessageBasedParams{&sendParams, &upgradeParams}
+
+	testCases := []struct {
+		name            string
+		msgTypeURLs     []string
+		expMinDeposit   sdk.Coins
+		expExpedited    sdk.Coins
+		expQuorum       sdk.Dec
+		expThreshold    sdk.Dec
+		expVeto         sdk.Dec
+		expExpThreshold sdk.Dec
+	}{
+		{
+			name:            "no message based params",
+			msgTypeURLs:     []string{otherURL},
+			expMinDeposit:   dp.MinDeposit,
+			expExpedited:    dp.ExpeditedMinDeposit,
+			expQuorum:       v1.DefaultQuorum,
+			expThreshold:    v1.DefaultThreshold,
+			expVeto:         v1.DefaultVetoThreshold,
+			expExpThreshold: v1.DefaultExpeditedThreshold,
+		},
+		{
+			name:            "single message type with laxer params",
+			msgTypeURLs:     []string{sendURL, sendURL},
+			expMinDeposit:   sendParams.MinDeposit,
+			expExpedited:    sdk.NewCoins(dp.ExpeditedMinDeposit...).Max(sendParams.MinDeposit),
+			expQuorum:       sdk.NewDecWithPrec(1, 1),
+			expThreshold:    sdk.NewDecWithPrec(4, 1),
+			expVeto:         sdk.NewDecWithPrec(5, 1),
+			expExpThreshold: v1.DefaultExpeditedThreshold,
+		},
+		{
+			name:            "single message type with stricter params",
+			msgTypeURLs:     []string{upgradeURL},
+			expMinDeposit:   upgradeParams.MinDeposit,
+			expExpedited:    upgradeParams.MinDeposit,
+			expQuorum:       sdk.NewDecWithPrec(5, 1),
+			expThreshold:    sdk.NewDecWithPrec(75, 2),
+			expVeto:         sdk.NewDecWithPrec(2, 1),
+			expExpThreshold: sdk.NewDecWithPrec(75, 2),
+		},
+		{
+			name:            "mixed message types with and without params",
+			msgTypeURLs:     []string{sendURL, otherURL},
+			expMinDeposit:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, v1.DefaultMinDepositTokens), sdk.NewInt64Coin("atom", 100)),
+			expExpedited:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, v1.DefaultMinExpeditedDepositTokens), sdk.NewInt64Coin("atom", 100)),
+			expQuorum:       v1.DefaultQuorum,
+			expThreshold:    v1.DefaultThreshold,
+			expVeto:         v1.DefaultVetoThreshold,
+			expExpThreshold: v1.DefaultExpeditedThreshold,
+		},
+		{
+			name:            "mixed message types with params",
+			msgTypeURLs:     []string{sendURL, upgradeURL},
+			expMinDeposit:   sdk.NewCoins(upgradeParams.MinDeposit...).Max(sendParams.MinDeposit),
+			expExpedited:    sdk.NewCoins(upgradeParams.MinDeposit...).Max(sendParams.MinDeposit),
+			expQuorum:       sdk.NewDecWithPrec(5, 1),
+			expThreshold:    sdk.NewDecWithPrec(75, 2),
+			expVeto:         sdk.NewDecWithPrec(2, 1),
+			expExpThreshold: sdk.NewDecWithPrec(75, 2),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resDp, resTp := v1.ApplyMessageBasedParams(dp, tp, messageBasedParams, tc.msgTypeURLs)
+			require.Equal(t, tc.expMinDeposit, sdk.Coins(resDp.MinDeposit))
+			require.Equal(t, tc.expExpedited, sdk.Coins(resDp.ExpeditedMinDeposit))
+			require.Equal(t, *dp.MaxDepositPeriod, *resDp.MaxDepositPeriod)
+			require.Equal(t, tc.expQuorum.String(), resTp.Quorum)
+			require.Equal(t, tc.expThreshold.String(), resTp.Threshold)
+			require.Equal(t, tc.expVeto.String(), resTp.VetoThreshold)
+			require.Equal(t, tc.expExpThreshold.String(), resTp.ExpeditedThreshold)
+		})
+	}
+}
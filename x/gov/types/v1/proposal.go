@@ -23,7 +23,7 @@ const (
 )
 
 // NewProposal creates a new Proposal instance
-func NewProposal(messages []sdk.Msg, id uint64, metadata string, submitTime, depositEndTime time.Time) (Proposal, error) {
+func NewProposal(messages []sdk.Msg, id uint64, metadata string, submitTime, depositEndTime time.Time, proposer sdk.AccAddress, expedited, optimistic bool) (Proposal, error) {
 	msgs, err := sdktx.SetMsgs(messages)
 	if err != nil {
 		return Proposal{}, err
@@ -39,6 +39,9 @@ func NewProposal(messages []sdk.Msg, id uint64, metadata string, submitTime, dep
 		FinalTallyResult: &tally,
 		SubmitTime:       &submitTime,
 		DepositEndTime:   &depositEndTime,
+		Expedited:        expedited,
+		Proposer:         proposer.String(),
+		Optimistic:       optimistic,
 	}
 
 	return p, nil
@@ -49,6 +52,33 @@ func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
 	return sdktx.GetMsgs(p.Messages, "sdk.MsgProposal")
 }
 
+// GetMinDepositFromParams returns the minimum deposit for the proposal to enter
+// voting period, which is higher for expedited proposals.
+func (p Proposal) GetMinDepositFromParams(params DepositParams) sdk.Coins {
+	if p.Expedited {
+		return params.ExpeditedMinDeposit
+	}
+	return params.MinDeposit
+}
+
+// GetVotingPeriodFromParams returns the length of the voting period of the
+// proposal, which is shorter for expedited proposals.
+func (p Proposal) GetVotingPeriodFromParams(params VotingParams) time.Duration {
+	if p.Expedited {
+		return *params.ExpeditedVotingPeriod
+	}
+	return *params.VotingPeriod
+}
+
+// GetThresholdFromParams returns the minimum proportion of Yes votes for the
+// proposal to pass, which is higher for expedited proposals.
+func (p Proposal) GetThresholdFromParams(params TallyParams) string {
+	if p.Expedited {
+		return params.ExpeditedThreshold
+	}
+	return params.Threshold
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	return sdktx.UnpackInterfaces(unpacker, p.Messages)
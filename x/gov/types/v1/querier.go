@@ -17,9 +17,11 @@ const (
 	QueryVote      = "vote"
 	QueryTally     = "tally"
 
-	ParamDeposit  = "deposit"
-	ParamVoting   = "voting"
-	ParamTallying = "tallying"
+	ParamDeposit      = "deposit"
+	ParamVoting       = "voting"
+	ParamTallying     = "tallying"
+	ParamMessageBased = "message_based"
+	ParamOptimistic   = "optimistic"
 )
 
 // QueryProposalParams Params for queries:
@@ -463,7 +463,7 @@ func (m *QueryVotesResponse) GetPagination() *query.PageResponse {
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 	// params_type defines which parameters to query for, can be one of "voting",
-	// "tallying" or "deposit".
+	// "tallying", "deposit", "message_based" or "optimistic".
 	ParamsType string `protobuf:"bytes,1,opt,name=params_type,json=paramsType,proto3" json:"params_type,omitempty"`
 }
 
@@ -515,6 +515,15 @@ type QueryParamsResponse struct {
 	DepositParams *DepositParams `protobuf:"bytes,2,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params,omitempty"`
 	// tally_params defines the parameters related to tally.
 	TallyParams *TallyParams `protobuf:"bytes,3,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params,omitempty"`
+	// message_based_params defines the deposit and tally parameters applying to
+	// the proposals containing messages of given types.
+	//
+	// Since: cosmos-sdk 0.47
+	MessageBasedParams []*MessageBasedParams `protobuf:"bytes,4,rep,name=message_based_params,json=messageBasedParams,proto3" json:"message_based_params,omitempty"`
+	// optimistic_params defines the parameters related to optimistic proposals.
+	//
+	// Since: cosmos-sdk 0.47
+	OptimisticParams *OptimisticParams `protobuf:"bytes,5,opt,name=optimistic_params,json=optimisticParams,proto3" json:"optimistic_params,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -571,6 +580,20 @@ func (m *QueryParamsResponse) GetTallyParams() *TallyParams {
 	return nil
 }
 
+func (m *QueryParamsResponse) GetMessageBasedParams() []*MessageBasedParams {
+	if m != nil {
+		return m.MessageBasedParams
+	}
+	return nil
+}
+
+func (m *QueryParamsResponse) GetOptimisticParams() *OptimisticParams {
+	if m != nil {
+		return m.OptimisticParams
+	}
+	return nil
+}
+
 // QueryDepositRequest is the request type for the Query/Deposit RPC method.
 type QueryDepositRequest struct {
 	// proposal_id defines the unique id of the proposal.
@@ -873,6 +896,234 @@ func (m *QueryTallyResultResponse) GetTally() *TallyResult {
 	return nil
 }
 
+// QueryVoteDelegationsRequest is the request type for the Query/VoteDelegations RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryVoteDelegationsRequest struct {
+	// delegator defines the address of the delegating account.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVoteDelegationsRequest) Reset()         { *m = QueryVoteDelegationsRequest{} }
+func (m *QueryVoteDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationsRequest) ProtoMessage()    {}
+func (*QueryVoteDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{16}
+}
+func (m *QueryVoteDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationsRequest.Merge(m, src)
+}
+func (m *QueryVoteDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryVoteDelegationsRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *QueryVoteDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVoteDelegationsResponse is the response type for the Query/VoteDelegations RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryVoteDelegationsResponse struct {
+	// vote_delegations defines the vote delegations of the delegator.
+	VoteDelegations []*VoteDelegation `protobuf:"bytes,1,rep,name=vote_delegations,json=voteDelegations,proto3" json:"vote_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVoteDelegationsResponse) Reset()         { *m = QueryVoteDelegationsResponse{} }
+func (m *QueryVoteDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationsResponse) ProtoMessage()    {}
+func (*QueryVoteDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{17}
+}
+func (m *QueryVoteDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationsResponse.Merge(m, src)
+}
+func (m *QueryVoteDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryVoteDelegationsResponse) GetVoteDelegations() []*VoteDelegation {
+	if m != nil {
+		return m.VoteDelegations
+	}
+	return nil
+}
+
+func (m *QueryVoteDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVoteDelegatorsRequest is the request type for the Query/VoteDelegators RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryVoteDelegatorsRequest struct {
+	// delegate defines the address of the delegate.
+	Delegate string `protobuf:"bytes,1,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVoteDelegatorsRequest) Reset()         { *m = QueryVoteDelegatorsRequest{} }
+func (m *QueryVoteDelegatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegatorsRequest) ProtoMessage()    {}
+func (*QueryVoteDelegatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{18}
+}
+func (m *QueryVoteDelegatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteDelegatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteDelegatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegatorsRequest.Merge(m, src)
+}
+func (m *QueryVoteDelegatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteDelegatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteDelegatorsRequest proto.InternalMessageInfo
+
+func (m *QueryVoteDelegatorsRequest) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *QueryVoteDelegatorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVoteDelegatorsResponse is the response type for the Query/VoteDelegators RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryVoteDelegatorsResponse struct {
+	// vote_delegations defines the vote delegations made to the delegate.
+	VoteDelegations []*VoteDelegation `protobuf:"bytes,1,rep,name=vote_delegations,json=voteDelegations,proto3" json:"vote_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVoteDelegatorsResponse) Reset()         { *m = QueryVoteDelegatorsResponse{} }
+func (m *QueryVoteDelegatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegatorsResponse) ProtoMessage()    {}
+func (*QueryVoteDelegatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{19}
+}
+func (m *QueryVoteDelegatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteDelegatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteDelegatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegatorsResponse.Merge(m, src)
+}
+func (m *QueryVoteDelegatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteDelegatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteDelegatorsResponse proto.InternalMessageInfo
+
+func (m *QueryVoteDelegatorsResponse) GetVoteDelegations() []*VoteDelegation {
+	if m != nil {
+		return m.VoteDelegations
+	}
+	return nil
+}
+
+func (m *QueryVoteDelegatorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryProposalRequest)(nil), "cosmos.gov.v1.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.gov.v1.QueryProposalResponse")
@@ -890,72 +1141,89 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryVoteDelegationsRequest)(nil), "cosmos.gov.v1.QueryVoteDelegationsRequest")
+	proto.RegisterType((*QueryVoteDelegationsResponse)(nil), "cosmos.gov.v1.QueryVoteDelegationsResponse")
+	proto.RegisterType((*QueryVoteDelegatorsRequest)(nil), "cosmos.gov.v1.QueryVoteDelegatorsRequest")
+	proto.RegisterType((*QueryVoteDelegatorsResponse)(nil), "cosmos.gov.v1.QueryVoteDelegatorsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 945 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5d, 0x6f, 0xdc, 0x44,
-	0x14, 0xcd, 0x6c, 0x92, 0x76, 0xf7, 0xa6, 0x09, 0x70, 0x5b, 0x9a, 0xc5, 0x94, 0x6d, 0x70, 0x68,
-	0x12, 0x28, 0xf5, 0xb0, 0xe9, 0x97, 0x04, 0xad, 0x04, 0x2d, 0x04, 0x90, 0x78, 0x08, 0xdb, 0x8a,
-	0x07, 0x5e, 0x22, 0x27, 0x6b, 0x19, 0x8b, 0x8d, 0xc7, 0xdd, 0x99, 0x5d, 0x11, 0xd2, 0x08, 0xa9,
-	0x12, 0x82, 0x27, 0x40, 0xa2, 0x12, 0xfc, 0x10, 0x7e, 0x04, 0x8f, 0x15, 0xbc, 0x20, 0x78, 0x41,
-	0x09, 0x3f, 0x04, 0x79, 0xe6, 0xda, 0x6b, 0x3b, 0xde, 0xcd, 0xa6, 0x8a, 0xfa, 0x14, 0x8d, 0x7d,
-	0xee, 0xb9, 0xe7, 0x7e, 0xf8, 0x4c, 0x16, 0x5e, 0xda, 0x12, 0x72, 0x5b, 0x48, 0xee, 0x8b, 0x3e,
-	0xef, 0x37, 0xf9, 0x83, 0x9e, 0xd7, 0xdd, 0x71, 0xa2, 0xae, 0x50, 0x02, 0x67, 0xcd, 0x2b, 0xc7,
-	0x17, 0x7d, 0xa7, 0xdf, 0xb4, 0xde, 0x20, 0xe4, 0xa6, 0x2b, 0x3d, 0x83, 0xe3, 0xfd, 0xe6, 0xa6,
-	0xa7, 0xdc, 0x26, 0x8f, 0x5c, 0x3f, 0x08, 0x5d, 0x15, 0x88, 0xd0, 0x84, 0x5a, 0x17, 0x7c, 0x21,
-	0xfc, 0x8e, 0xc7, 0xdd, 0x28, 0xe0, 0x6e, 0x18, 0x0a, 0xa5, 0x5f, 0x4a, 0x7a, 0x3b, 0x9f, 0xcf,
-	0x19, 0xf3, 0x9b, 0x17, 0x24, 0x66, 0x43, 0x9f, 0x38, 0xa5, 0xd7, 0x07, 0xfb, 0x26, 0x9c, 0xfb,
-	0x34, 0xce, 0xb9, 0xde, 0x15, 0x91, 0x90, 0x6e, 0xa7, 0xe5, 0x3d, 0xe8, 0x79, 0x52, 0xe1, 0x45,
-	0x98, 0x89, 0xe8, 0xd1, 0x46, 0xd0, 0xae, 0xb3, 0x05, 0xb6, 0x32, 0xd5, 0x82, 0xe4, 0xd1, 0xc7,
-	0x6d, 0xfb, 0x13, 0x78, 0xb1, 0x10, 0x28, 0x23, 0x11, 0x4a, 0x0f, 0xaf, 0x42, 0x35, 0x81, 0xe9,
-	0xb0, 0x99, 0xd5, 0x79, 0x27, 0x57, 0xb1, 0x93, 0x86, 0xa4, 0x40, 0xfb, 0xc7, 0x4a, 0x81, 0x4e,
-	0x26, 0x42, 0xd6, 0xe0, 0xb9, 0x54, 0x88, 0x54, 0xae, 0xea, 0x49, 0xcd, 0x3a, 0xb7, 0xfa, 0xca,
-	0x10, 0xd6, 0x7b, 0x1a, 0xd4, 0x9a, 0x8b, 0x72, 0x67, 0x74, 0x60, 0xba, 0x2f, 0x94, 0xd7, 0xad,
-	0x57, 0x16, 0xd8, 0x4a, 0xed, 0x4e, 0xfd, 0x8f, 0xdf, 0xae, 0x9c, 0x23, 0x82, 0xf7, 0xda, 0xed,
-	0xae, 0x27, 0xe5, 0x3d, 0xd5, 0x0d, 0x42, 0xbf, 0x65, 0x60, 0x78, 0x03, 0x6a, 0x6d, 0x2f, 0x12,
-	0x32, 0x50, 0xa2, 0x5b, 0x9f, 0x3c, 0x22, 0x66, 0x00, 0xc5, 0x35, 0x80, 0xc1, 0xd8, 0xea, 0x53,
-	0xba, 0x01, 0x4b, 0x89, 0xd4, 0x78, 0xc6, 0x8e, 0xd9, 0x05, 0x9a, 0xb1, 0xb3, 0xee, 0xfa, 0x1e,
-	0xd5, 0xda, 0xca, 0x44, 0xda, 0xbf, 0x32, 0x38, 0x5f, 0xec, 0x08, 0x75, 0xf8, 0x3a, 0xd4, 0x92,
-	0xe2, 0xe2, 0x66, 0x4c, 0x8e, 0x6a, 0xf1, 0x00, 0x89, 0x1f, 0xe6, 0x94, 0x55, 0xb4, 0xb2, 0xe5,
-	0x23, 0x95, 0x99, 0x9c, 0x39, 0x69, 0x5b, 0xf0, 0xbc, 0x56, 0xf6, 0x99, 0x50, 0xde, 0xb8, 0xfb,
-	0x72, 0xdc, 0xfe, 0xdb, 0xb7, 0xe0, 0x85, 0x4c, 0x12, 0xaa, 0x7c, 0x19, 0xa6, 0xe2, 0xb7, 0xb4,
-	0x57, 0x67, 0x0b, 0x45, 0x6b, 0xa8, 0x06, 0xd8, 0x0f, 0x33, 0xd1, 0x72, 0x6c, 0x8d, 0x6b, 0x25,
-	0x1d, 0x7a, 0x9a, 0xd9, 0x7d, 0xcf, 0x00, 0xb3, 0xe9, 0x49, 0xfd, 0xeb, 0xa6, 0x05, 0xc9, 0xcc,
-	0x4a, 0xe5, 0x1b, 0xc4, 0xc9, 0xcd, 0xea, 0x3a, 0x29, 0x59, 0x77, 0xbb, 0xee, 0x76, 0xae, 0x13,
-	0xfa, 0xc1, 0x86, 0xda, 0x89, 0x4c, 0x3b, 0x6b, 0x71, 0x58, 0xfc, 0xe8, 0xfe, 0x4e, 0xe4, 0xd9,
-	0xff, 0x30, 0x38, 0x9b, 0x8b, 0xa3, 0x12, 0xde, 0x85, 0xd9, 0xbe, 0x50, 0x41, 0xe8, 0x6f, 0x18,
-	0x30, 0x4d, 0xe2, 0xe5, 0xc3, 0xa5, 0x04, 0xa1, 0x4f, 0xb1, 0x67, 0xfa, 0x99, 0x13, 0xde, 0x85,
-	0x39, 0xfa, 0x58, 0x12, 0x0a, 0x53, 0xdd, 0x85, 0x02, 0xc5, 0xfb, 0x06, 0x44, 0x1c, 0xb3, 0xed,
-	0xec, 0x11, 0x6f, 0xc3, 0x19, 0xe5, 0x76, 0x3a, 0x3b, 0x09, 0xc5, 0xa4, 0xa6, 0xb0, 0x0a, 0x14,
-	0xf7, 0x63, 0x08, 0x11, 0xcc, 0xa8, 0xc1, 0xc1, 0x0e, 0xa9, 0x38, 0xca, 0x31, 0xf6, 0x7e, 0xe4,
-	0x3c, 0xa1, 0x32, 0xb6, 0x27, 0xd8, 0x1f, 0x91, 0xc9, 0xa6, 0xf9, 0xa8, 0x9b, 0x6f, 0xc1, 0x69,
-	0x02, 0x51, 0x1f, 0xcf, 0x97, 0x37, 0xa1, 0x95, 0xc0, 0xec, 0x6f, 0xf2, 0x4c, 0xcf, 0x7e, 0xb5,
-	0x1f, 0x33, 0x32, 0xea, 0x81, 0x02, 0x2a, 0x66, 0x15, 0xaa, 0xa4, 0x32, 0x59, 0xf0, 0x61, 0xd5,
-	0xa4, 0xb8, 0x93, 0x5b, 0xf3, 0xb7, 0x61, 0x5e, 0xab, 0xd2, 0x23, 0x6f, 0x79, 0xb2, 0xd7, 0x51,
-	0xc7, 0xb8, 0xc9, 0xea, 0x87, 0x63, 0xd3, 0x09, 0x4d, 0xeb, 0xc5, 0xa1, 0xf9, 0x94, 0x6e, 0x18,
-	0x85, 0x18, 0xe0, 0xea, 0xdf, 0x55, 0x98, 0xd6, 0x74, 0xf8, 0x2d, 0x83, 0x6a, 0xe2, 0xc3, 0xb8,
-	0x58, 0x88, 0x2c, 0xbb, 0x74, 0xad, 0xd7, 0x46, 0x83, 0x8c, 0x26, 0xdb, 0x79, 0xf4, 0xe7, 0x7f,
-	0x3f, 0x57, 0x56, 0x70, 0x89, 0xe7, 0xef, 0xfb, 0xd4, 0xe9, 0xf9, 0x6e, 0xa6, 0xe0, 0x3d, 0xfc,
-	0x1a, 0x6a, 0xe9, 0x1d, 0x82, 0x23, 0x53, 0x24, 0xeb, 0x64, 0x5d, 0x3a, 0x02, 0x45, 0x4a, 0x16,
-	0xb4, 0x12, 0x0b, 0xeb, 0xc3, 0x94, 0xe0, 0x77, 0x0c, 0xa6, 0x62, 0x5f, 0xc3, 0x8b, 0x65, 0x8c,
-	0x99, 0x0b, 0xc4, 0x5a, 0x18, 0x0e, 0xa0, 0x6c, 0xb7, 0x74, 0xb6, 0x1b, 0x78, 0x6d, 0xbc, 0xba,
-	0xb9, 0x76, 0x52, 0xbe, 0xab, 0xaf, 0x93, 0x3d, 0x7c, 0xc4, 0x60, 0x5a, 0xdb, 0x31, 0x0e, 0xcd,
-	0x94, 0x96, 0xff, 0xea, 0x08, 0x04, 0x89, 0xb9, 0xa6, 0xc5, 0x38, 0xf8, 0xe6, 0x71, 0xc4, 0xe0,
-	0x43, 0x38, 0x45, 0x0e, 0x56, 0x9a, 0x22, 0x67, 0xd2, 0x96, 0x3d, 0x0a, 0x42, 0x32, 0x2e, 0x6b,
-	0x19, 0x97, 0x70, 0xb1, 0x28, 0x43, 0xc3, 0xf8, 0x6e, 0xc6, 0xe5, 0xf7, 0xf0, 0x17, 0x06, 0xa7,
-	0xe9, 0x1b, 0xc4, 0x52, 0xf2, 0xbc, 0x1f, 0x5a, 0x8b, 0x23, 0x31, 0xa4, 0xe0, 0xae, 0x56, 0x70,
-	0x1b, 0xdf, 0x19, 0xb3, 0x11, 0xc9, 0xb7, 0xcf, 0x77, 0x53, 0x7f, 0xdc, 0xc3, 0x1f, 0x18, 0x54,
-	0x13, 0x43, 0xc1, 0x51, 0x69, 0xe5, 0xc8, 0x4f, 0xa5, 0xe8, 0x49, 0xf6, 0x4d, 0x2d, 0xae, 0x89,
-	0xfc, 0x98, 0xe2, 0xf0, 0x31, 0x83, 0x99, 0xcc, 0xc7, 0x8d, 0x4b, 0x65, 0xe9, 0x0e, 0x9b, 0x8d,
-	0xb5, 0x7c, 0x24, 0xee, 0x29, 0xf7, 0x47, 0x9b, 0xcb, 0x9d, 0x0f, 0x7e, 0xdf, 0x6f, 0xb0, 0x27,
-	0xfb, 0x0d, 0xf6, 0xef, 0x7e, 0x83, 0xfd, 0x74, 0xd0, 0x98, 0x78, 0x72, 0xd0, 0x98, 0xf8, 0xeb,
-	0xa0, 0x31, 0xf1, 0xf9, 0x65, 0x3f, 0x50, 0x5f, 0xf4, 0x36, 0x9d, 0x2d, 0xb1, 0x9d, 0x30, 0x9a,
-	0x3f, 0x57, 0x64, 0xfb, 0x4b, 0xfe, 0x95, 0xa6, 0x8f, 0xb7, 0x40, 0xc6, 0x3f, 0x2e, 0x4e, 0xe9,
-	0xff, 0xfd, 0xaf, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xce, 0x57, 0x85, 0xcf, 0xa5, 0x0c, 0x00,
-	0x00,
+	// 1162 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x5b, 0x6f, 0xdc, 0x44,
+	0x14, 0xee, 0x6c, 0x92, 0x36, 0x39, 0x69, 0x2e, 0x3d, 0x4d, 0x9b, 0xc5, 0x4d, 0x37, 0xa9, 0x43,
+	0x73, 0x25, 0x36, 0x9b, 0xa4, 0xad, 0x04, 0xad, 0x04, 0x69, 0x09, 0x45, 0x2a, 0x22, 0x6c, 0x2a,
+	0x1e, 0x78, 0x59, 0x39, 0x59, 0xcb, 0x58, 0xec, 0xee, 0xb8, 0x1e, 0x67, 0x45, 0x48, 0x23, 0xa4,
+	0x4a, 0x08, 0x9e, 0x00, 0x89, 0x4a, 0x40, 0x25, 0xc4, 0x5f, 0x00, 0x89, 0x1f, 0xc1, 0x63, 0x05,
+	0x2f, 0x3c, 0xa2, 0x84, 0xdf, 0x81, 0x90, 0xc7, 0xc7, 0x5e, 0xdb, 0xf1, 0x5e, 0x52, 0xad, 0x10,
+	0x4f, 0xab, 0x19, 0x7f, 0xe7, 0x3b, 0xdf, 0xb9, 0xcc, 0x9c, 0xd1, 0xc2, 0x4b, 0xbb, 0x5c, 0xd4,
+	0xb8, 0xd0, 0x2d, 0xde, 0xd0, 0x1b, 0x45, 0xfd, 0xd1, 0x9e, 0xe9, 0xee, 0x6b, 0x8e, 0xcb, 0x3d,
+	0x8e, 0x23, 0xc1, 0x27, 0xcd, 0xe2, 0x0d, 0xad, 0x51, 0x54, 0x96, 0x08, 0xb9, 0x63, 0x08, 0x33,
+	0xc0, 0xe9, 0x8d, 0xe2, 0x8e, 0xe9, 0x19, 0x45, 0xdd, 0x31, 0x2c, 0xbb, 0x6e, 0x78, 0x36, 0xaf,
+	0x07, 0xa6, 0xca, 0x94, 0xc5, 0xb9, 0x55, 0x35, 0x75, 0xc3, 0xb1, 0x75, 0xa3, 0x5e, 0xe7, 0x9e,
+	0xfc, 0x28, 0xe8, 0xeb, 0x64, 0xd2, 0xa7, 0xcf, 0x1f, 0x7c, 0x20, 0x31, 0x65, 0xb9, 0xd2, 0xc9,
+	0xbd, 0x5c, 0xa8, 0xb7, 0x60, 0xe2, 0x7d, 0xdf, 0xe7, 0x96, 0xcb, 0x1d, 0x2e, 0x8c, 0x6a, 0xc9,
+	0x7c, 0xb4, 0x67, 0x0a, 0x0f, 0xa7, 0x61, 0xd8, 0xa1, 0xad, 0xb2, 0x5d, 0xc9, 0xb3, 0x19, 0xb6,
+	0xd0, 0x5f, 0x82, 0x70, 0xeb, 0x9d, 0x8a, 0xfa, 0x00, 0x2e, 0xa5, 0x0c, 0x85, 0xc3, 0xeb, 0xc2,
+	0xc4, 0x35, 0x18, 0x0c, 0x61, 0xd2, 0x6c, 0x78, 0x75, 0x52, 0x4b, 0x44, 0xac, 0x45, 0x26, 0x11,
+	0x50, 0xfd, 0x3a, 0x97, 0xa2, 0x13, 0xa1, 0x90, 0x4d, 0x18, 0x8b, 0x84, 0x08, 0xcf, 0xf0, 0xf6,
+	0x84, 0x64, 0x1d, 0x5d, 0xbd, 0xda, 0x82, 0x75, 0x5b, 0x82, 0x4a, 0xa3, 0x4e, 0x62, 0x8d, 0x1a,
+	0x0c, 0x34, 0xb8, 0x67, 0xba, 0xf9, 0xdc, 0x0c, 0x5b, 0x18, 0xda, 0xc8, 0xff, 0xfe, 0xeb, 0xca,
+	0x04, 0x11, 0xbc, 0x59, 0xa9, 0xb8, 0xa6, 0x10, 0xdb, 0x9e, 0x6b, 0xd7, 0xad, 0x52, 0x00, 0xc3,
+	0x9b, 0x30, 0x54, 0x31, 0x1d, 0x2e, 0x6c, 0x8f, 0xbb, 0xf9, 0xbe, 0x0e, 0x36, 0x4d, 0x28, 0x6e,
+	0x02, 0x34, 0xcb, 0x96, 0xef, 0x97, 0x09, 0x98, 0x0b, 0xa5, 0xfa, 0x35, 0xd6, 0x82, 0x5e, 0xa0,
+	0x1a, 0x6b, 0x5b, 0x86, 0x65, 0x52, 0xac, 0xa5, 0x98, 0xa5, 0xfa, 0x3d, 0x83, 0xcb, 0xe9, 0x8c,
+	0x50, 0x86, 0x6f, 0xc0, 0x50, 0x18, 0x9c, 0x9f, 0x8c, 0xbe, 0x76, 0x29, 0x6e, 0x22, 0xf1, 0xed,
+	0x84, 0xb2, 0x9c, 0x54, 0x36, 0xdf, 0x51, 0x59, 0xe0, 0x33, 0x21, 0x6d, 0x17, 0xc6, 0xa5, 0xb2,
+	0x0f, 0xb8, 0x67, 0x76, 0xdb, 0x2f, 0xa7, 0xcd, 0xbf, 0x7a, 0x1b, 0x2e, 0xc4, 0x9c, 0x50, 0xe4,
+	0xf3, 0xd0, 0xef, 0x7f, 0xa5, 0xbe, 0xba, 0x98, 0x0a, 0x5a, 0x42, 0x25, 0x40, 0x7d, 0x1c, 0xb3,
+	0x16, 0x5d, 0x6b, 0xdc, 0xcc, 0xc8, 0xd0, 0x8b, 0xd4, 0xee, 0x4b, 0x06, 0x18, 0x77, 0x4f, 0xea,
+	0x17, 0x83, 0x14, 0x84, 0x35, 0xcb, 0x94, 0x1f, 0x20, 0x7a, 0x57, 0xab, 0x1b, 0xa4, 0x64, 0xcb,
+	0x70, 0x8d, 0x5a, 0x22, 0x13, 0x72, 0xa3, 0xec, 0xed, 0x3b, 0x41, 0x3a, 0x87, 0x4a, 0x10, 0x6c,
+	0x3d, 0xdc, 0x77, 0x4c, 0xf5, 0x9f, 0x1c, 0x5c, 0x4c, 0xd8, 0x51, 0x08, 0x6f, 0xc0, 0x48, 0x83,
+	0x7b, 0x76, 0xdd, 0x2a, 0x07, 0x60, 0xaa, 0xc4, 0x95, 0x93, 0xa1, 0xd8, 0x75, 0x8b, 0x6c, 0xcf,
+	0x37, 0x62, 0x2b, 0xbc, 0x0b, 0xa3, 0x74, 0x58, 0x42, 0x8a, 0x20, 0xba, 0xa9, 0x14, 0xc5, 0xbd,
+	0x00, 0x44, 0x1c, 0x23, 0x95, 0xf8, 0x12, 0xef, 0xc0, 0x79, 0xcf, 0xa8, 0x56, 0xf7, 0x43, 0x8a,
+	0x3e, 0x49, 0xa1, 0xa4, 0x28, 0x1e, 0xfa, 0x10, 0x22, 0x18, 0xf6, 0x9a, 0x0b, 0xdc, 0x86, 0x89,
+	0x9a, 0x29, 0x84, 0x61, 0x99, 0x65, 0x3f, 0x97, 0x95, 0x90, 0xa6, 0x5f, 0xd6, 0xe5, 0x5a, 0x8a,
+	0xe6, 0xdd, 0x00, 0xba, 0xe1, 0x23, 0x89, 0x0d, 0x6b, 0x27, 0xf6, 0xf0, 0x01, 0x5c, 0xe0, 0x8e,
+	0x67, 0xd7, 0x6c, 0xe1, 0xd9, 0xbb, 0x21, 0xe3, 0x80, 0x14, 0x36, 0x9d, 0x62, 0x7c, 0x2f, 0xc2,
+	0x11, 0xdf, 0x38, 0x4f, 0xed, 0xa8, 0x75, 0xca, 0x3f, 0xa5, 0xa1, 0xeb, 0x16, 0x4e, 0x5c, 0x5b,
+	0xb9, 0xae, 0xaf, 0x2d, 0xf5, 0x3e, 0x4c, 0x24, 0xfd, 0x51, 0xc1, 0x5f, 0x85, 0x73, 0x04, 0xa2,
+	0x52, 0x5f, 0xce, 0xae, 0x53, 0x29, 0x84, 0xa9, 0x9f, 0x25, 0x99, 0xfe, 0xfb, 0xd3, 0xf7, 0x94,
+	0xc1, 0xa5, 0x94, 0x02, 0x0a, 0x66, 0x15, 0x06, 0x49, 0x65, 0x78, 0x06, 0x5b, 0x45, 0x13, 0xe1,
+	0x7a, 0x77, 0x12, 0x5f, 0x83, 0x49, 0xa9, 0x4a, 0x76, 0x65, 0xc9, 0x14, 0x7b, 0x55, 0xef, 0x14,
+	0xc3, 0x36, 0x7f, 0xd2, 0x36, 0xaa, 0xd0, 0x80, 0xec, 0xed, 0x3c, 0x6b, 0x7d, 0x08, 0xc8, 0x24,
+	0x00, 0xaa, 0x3f, 0x32, 0xb8, 0x12, 0x5d, 0x4f, 0xf7, 0xcc, 0xaa, 0x69, 0x49, 0x85, 0x51, 0xa5,
+	0x64, 0x0f, 0xc9, 0x5d, 0xee, 0xe6, 0x59, 0xe7, 0x1e, 0x22, 0x68, 0xcf, 0x0a, 0xf8, 0x0b, 0x83,
+	0xa9, 0x6c, 0x7d, 0x14, 0xf2, 0x7d, 0x18, 0xf7, 0xaf, 0xc9, 0x72, 0xa5, 0xf9, 0x8d, 0xea, 0x79,
+	0x35, 0xe3, 0x4e, 0x6d, 0x32, 0x94, 0xc6, 0x1a, 0x49, 0xc6, 0xde, 0x55, 0xf7, 0x19, 0x03, 0x25,
+	0xad, 0x99, 0xbb, 0x51, 0x4a, 0xd7, 0xfd, 0xce, 0x93, 0x9b, 0x66, 0xc7, 0x8c, 0x46, 0xc8, 0x9e,
+	0x25, 0xf4, 0xe7, 0x8c, 0x82, 0x4b, 0x71, 0xff, 0xdb, 0x7c, 0xae, 0xfe, 0x30, 0x0c, 0x03, 0x52,
+	0x32, 0x7e, 0xce, 0x60, 0x30, 0x7c, 0xce, 0xe0, 0x6c, 0x4a, 0x4f, 0xd6, 0xdb, 0x55, 0x79, 0xb9,
+	0x3d, 0x28, 0xf0, 0xa6, 0x6a, 0x4f, 0xfe, 0xf8, 0xfb, 0xdb, 0xdc, 0x02, 0xce, 0xe9, 0xc9, 0x67,
+	0x73, 0xf4, 0x60, 0xd2, 0x0f, 0x62, 0x87, 0xf2, 0x10, 0x3f, 0x85, 0xa1, 0x90, 0x43, 0x60, 0x5b,
+	0x17, 0x61, 0xd5, 0x95, 0xeb, 0x1d, 0x50, 0xa4, 0x64, 0x46, 0x2a, 0x51, 0x30, 0xdf, 0x4a, 0x09,
+	0x7e, 0xc1, 0xa0, 0xdf, 0x4f, 0x3d, 0x4e, 0x67, 0x31, 0xc6, 0xde, 0x61, 0xca, 0x4c, 0x6b, 0x00,
+	0x79, 0xbb, 0x2d, 0xbd, 0xdd, 0xc4, 0xf5, 0xee, 0xe2, 0xd6, 0xfd, 0x12, 0x0b, 0xfd, 0xc0, 0xff,
+	0x71, 0x0f, 0xf1, 0x09, 0x83, 0x01, 0x9f, 0x4e, 0x60, 0x4b, 0x4f, 0x51, 0xf8, 0xd7, 0xda, 0x20,
+	0x48, 0xcc, 0xba, 0x14, 0xa3, 0xe1, 0x2b, 0xa7, 0x11, 0x83, 0x8f, 0xe1, 0x2c, 0x0d, 0xdd, 0x4c,
+	0x17, 0x89, 0xb7, 0x8e, 0xa2, 0xb6, 0x83, 0x90, 0x8c, 0x65, 0x29, 0xe3, 0x3a, 0xce, 0xa6, 0x65,
+	0x48, 0x98, 0x7e, 0x10, 0x7b, 0x2c, 0x1d, 0xe2, 0x77, 0x0c, 0xce, 0xd1, 0x9c, 0xc0, 0x4c, 0xf2,
+	0xe4, 0xcc, 0x56, 0x66, 0xdb, 0x62, 0x48, 0xc1, 0x5d, 0xa9, 0xe0, 0x0e, 0xbe, 0xde, 0x65, 0x22,
+	0xc2, 0xf9, 0xa4, 0x1f, 0x44, 0x33, 0xfc, 0x10, 0xbf, 0x62, 0x30, 0x48, 0xc4, 0x02, 0xdb, 0xb9,
+	0x15, 0x6d, 0x8f, 0x4a, 0x7a, 0x6e, 0xaa, 0xb7, 0xa4, 0xb8, 0x22, 0xea, 0xa7, 0x14, 0x87, 0x4f,
+	0x19, 0x0c, 0xc7, 0x06, 0x10, 0xce, 0x65, 0xb9, 0x3b, 0x39, 0x10, 0x95, 0xf9, 0x8e, 0xb8, 0x17,
+	0xec, 0x1f, 0x39, 0x00, 0xf1, 0x27, 0x06, 0x63, 0xa9, 0xd9, 0x82, 0x4b, 0xad, 0x9a, 0xf5, 0xe4,
+	0x80, 0x54, 0x96, 0xbb, 0xc2, 0x92, 0xc4, 0x35, 0x29, 0x71, 0x05, 0x97, 0x53, 0x12, 0xd3, 0x37,
+	0xae, 0x7e, 0x40, 0x0b, 0xbf, 0x92, 0xcf, 0x18, 0x8c, 0x26, 0x2f, 0x6b, 0x5c, 0xec, 0xe0, 0xb4,
+	0x39, 0x6d, 0x94, 0xa5, 0x6e, 0xa0, 0x24, 0xaf, 0x28, 0xe5, 0x2d, 0xe3, 0x62, 0x1b, 0x79, 0xdc,
+	0x6d, 0xaa, 0x33, 0x0f, 0x37, 0xde, 0xfa, 0xed, 0xa8, 0xc0, 0x9e, 0x1f, 0x15, 0xd8, 0x5f, 0x47,
+	0x05, 0xf6, 0xcd, 0x71, 0xe1, 0xcc, 0xf3, 0xe3, 0xc2, 0x99, 0x3f, 0x8f, 0x0b, 0x67, 0x3e, 0x5c,
+	0xb6, 0x6c, 0xef, 0xa3, 0xbd, 0x1d, 0x6d, 0x97, 0xd7, 0x42, 0xba, 0xe0, 0x67, 0x45, 0x54, 0x3e,
+	0xd6, 0x3f, 0x91, 0xdc, 0xfe, 0x21, 0x12, 0xfe, 0x5f, 0x1c, 0x67, 0xe5, 0x3f, 0x10, 0x6b, 0xff,
+	0x0e, 0x00, 0xa5, 0x1b, 0x38, 0x5a, 0x2b, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -986,6 +1254,14 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// VoteDelegations queries the vote delegations of a delegator.
+	//
+	// Since: cosmos-sdk 0.47
+	VoteDelegations(ctx context.Context, in *QueryVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsResponse, error)
+	// VoteDelegators queries the vote delegations made to a delegate.
+	//
+	// Since: cosmos-sdk 0.47
+	VoteDelegators(ctx context.Context, in *QueryVoteDelegatorsRequest, opts ...grpc.CallOption) (*QueryVoteDelegatorsResponse, error)
 }
 
 type queryClient struct {
@@ -1068,6 +1344,24 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) VoteDelegations(ctx context.Context, in *QueryVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsResponse, error) {
+	out := new(QueryVoteDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/VoteDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VoteDelegators(ctx context.Context, in *QueryVoteDelegatorsRequest, opts ...grpc.CallOption) (*QueryVoteDelegatorsResponse, error) {
+	out := new(QueryVoteDelegatorsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/VoteDelegators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Proposal queries proposal details based on ProposalID.
@@ -1086,6 +1380,14 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// VoteDelegations queries the vote delegations of a delegator.
+	//
+	// Since: cosmos-sdk 0.47
+	VoteDelegations(context.Context, *QueryVoteDelegationsRequest) (*QueryVoteDelegationsResponse, error)
+	// VoteDelegators queries the vote delegations made to a delegate.
+	//
+	// Since: cosmos-sdk 0.47
+	VoteDelegators(context.Context, *QueryVoteDelegatorsRequest) (*QueryVoteDelegatorsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1116,6 +1418,12 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) VoteDelegations(ctx context.Context, req *QueryVoteDelegationsRequest) (*QueryVoteDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegations not implemented")
+}
+func (*UnimplementedQueryServer) VoteDelegators(ctx context.Context, req *QueryVoteDelegatorsRequest) (*QueryVoteDelegatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegators not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1265,6 +1573,42 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VoteDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/VoteDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteDelegations(ctx, req.(*QueryVoteDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VoteDelegators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteDelegators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/VoteDelegators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteDelegators(ctx, req.(*QueryVoteDelegatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1301,6 +1645,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "VoteDelegations",
+			Handler:    _Query_VoteDelegations_Handler,
+		},
+		{
+			MethodName: "VoteDelegators",
+			Handler:    _Query_VoteDelegators_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -1681,6 +2033,32 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OptimisticParams != nil {
+		{
+			size, err := m.OptimisticParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MessageBasedParams) > 0 {
+		for iNdEx := len(m.MessageBasedParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageBasedParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.TallyParams != nil {
 		{
 			size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
@@ -1942,44 +2320,226 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryVoteDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
-	}
-	return n
+
+func (m *QueryVoteDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryVoteDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Proposal != nil {
-		l = m.Proposal.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryProposalsRequest) Size() (n int) {
-	if m == nil {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VoteDelegations) > 0 {
+		for iNdEx := len(m.VoteDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteDelegatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteDelegatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteDelegatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteDelegatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteDelegatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteDelegatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VoteDelegations) > 0 {
+		for iNdEx := len(m.VoteDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != nil {
+		l = m.Proposal.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsRequest) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -2116,6 +2676,16 @@ func (m *QueryParamsResponse) Size() (n int) {
 		l = m.TallyParams.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.MessageBasedParams) > 0 {
+		for _, e := range m.MessageBasedParams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.OptimisticParams != nil {
+		l = m.OptimisticParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2208,6 +2778,78 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryVoteDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VoteDelegations) > 0 {
+		for _, e := range m.VoteDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteDelegatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteDelegatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VoteDelegations) > 0 {
+		for _, e := range m.VoteDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3289,16 +3931,86 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageBasedParams", wireType)
 			}
-			if (iNdEx + skippy) > l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageBasedParams = append(m.MessageBasedParams, &MessageBasedParams{})
+			if err := m.MessageBasedParams[len(m.MessageBasedParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptimisticParams == nil {
+				m.OptimisticParams = &OptimisticParams{}
+			}
+			if err := m.OptimisticParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -3877,6 +4589,482 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryVoteDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteDelegations = append(m.VoteDelegations, &VoteDelegation{})
+			if err := m.VoteDelegations[len(m.VoteDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteDelegatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteDelegatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteDelegatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteDelegatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteDelegatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteDelegatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteDelegations = append(m.VoteDelegations, &VoteDelegation{})
+			if err := m.VoteDelegations[len(m.VoteDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,53 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewVoteDelegation creates a new VoteDelegation instance
+//
+//nolint:interfacer
+func NewVoteDelegation(delegator, delegate sdk.AccAddress, msgTypeURL string) VoteDelegation {
+	return VoteDelegation{
+		Delegator:  delegator.String(),
+		Delegate:   delegate.String(),
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// Validate performs a stateless validation of the vote delegation.
+func (d VoteDelegation) Validate() error {
+	delegator, err := sdk.AccAddressFromBech32(d.Delegator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	delegate, err := sdk.AccAddressFromBech32(d.Delegate)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegate address: %s", err)
+	}
+	if delegator.Equals(delegate) {
+		return sdkerrors.Wrap(types.ErrInvalidVoteDelegation, "cannot delegate votes to oneself")
+	}
+
+	return nil
+}
+
+// ProposalMsgTypeURL returns the type URL shared by all the messages of a
+// proposal, which selects the vote delegations applying to it. It returns an
+// empty string if the proposal has no messages or messages of different types.
+func ProposalMsgTypeURL(msgs []sdk.Msg) string {
+	if len(msgs) == 0 {
+		return ""
+	}
+
+	typeURL := sdk.MsgTypeURL(msgs[0])
+	for _, msg := range msgs[1:] {
+		if sdk.MsgTypeURL(msg) != typeURL {
+			return ""
+		}
+	}
+
+	return typeURL
+}
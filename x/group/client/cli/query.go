@@ -23,6 +23,7 @@ func QueryCmd(name string) *cobra.Command {
 		QueryGroupInfoCmd(),
 		QueryGroupPolicyInfoCmd(),
 		QueryGroupMembersCmd(),
+		QueryFlattenedGroupMembersCmd(),
 		QueryGroupsByAdminCmd(),
 		QueryGroupPoliciesByGroupCmd(),
 		QueryGroupPoliciesByAdminCmd(),
@@ -173,6 +174,48 @@ func QueryGroupMembersCmd() *cobra.Command {
 	return cmd
 }
 
+// QueryFlattenedGroupMembersCmd creates a CLI command for Query/FlattenedGroupMembers.
+func QueryFlattenedGroupMembersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "flattened-group-members [id]",
+		Short: "Query for the members of a group and of its sub-groups by group id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FlattenedGroupMembers(cmd.Context(), &group.QueryFlattenedGroupMembersRequest{
+				GroupId:    groupID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "flattened-group-members")
+
+	return cmd
+}
+
 // QueryGroupsByAdminCmd creates a CLI command for Query/GroupsByAdmin.
 func QueryGroupsByAdminCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1,6 +1,8 @@
 package group
 
 import (
+	"github.com/gogo/protobuf/proto"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -15,8 +17,9 @@ import (
 // These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*DecisionPolicy)(nil), nil)
-	cdc.RegisterConcrete(&ThresholdDecisionPolicy{}, "cosmos-sdk/ThresholdDecisionPolicy", nil)
-	cdc.RegisterConcrete(&PercentageDecisionPolicy{}, "cosmos-sdk/PercentageDecisionPolicy", nil)
+	for _, p := range registeredDecisionPolicies() {
+		cdc.RegisterConcrete(p.policy, p.aminoName, nil)
+	}
 
 	legacy.RegisterAminoMsg(cdc, &MsgCreateGroup{}, "cosmos-sdk/MsgCreateGroup")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupMembers{}, "cosmos-sdk/MsgUpdateGroupMembers")
@@ -54,12 +57,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 
-	registry.RegisterInterface(
-		"cosmos.group.v1.DecisionPolicy",
-		(*DecisionPolicy)(nil),
-		&ThresholdDecisionPolicy{},
-		&PercentageDecisionPolicy{},
-	)
+	policies := registeredDecisionPolicies()
+	impls := make([]proto.Message, len(policies))
+	for i, p := range policies {
+		impls[i] = p.policy
+	}
+	registry.RegisterInterface("cosmos.group.v1.DecisionPolicy", (*DecisionPolicy)(nil), impls...)
 }
 
 var (
@@ -9,6 +9,10 @@ type Config struct {
 	MaxExecutionPeriod time.Duration
 	// MaxMetadataLen defines the max length of the metadata bytes field for various entities within the group module. Defaults to 255 if not explicitly set.
 	MaxMetadataLen uint64
+	// MaxSubGroupDepth defines the max depth of nested sub-groups taken into
+	// account when tallying proposals and resolving group membership. Defaults
+	// to 3 if not explicitly set.
+	MaxSubGroupDepth uint64
 }
 
 // DefaultConfig returns the default config for group.
@@ -16,5 +20,6 @@ func DefaultConfig() Config {
 	return Config{
 		MaxExecutionPeriod: 2 * time.Hour * 24 * 7, // Two weeks.
 		MaxMetadataLen:     255,
+		MaxSubGroupDepth:   3,
 	}
 }
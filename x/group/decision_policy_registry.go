@@ -0,0 +1,80 @@
+package group
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+)
+
+// registeredDecisionPolicy is a DecisionPolicy implementation along with the
+// name it is registered under in the legacy amino codec.
+type registeredDecisionPolicy struct {
+	policy    DecisionPolicy
+	aminoName string
+}
+
+var (
+	decisionPoliciesMu sync.RWMutex
+	// decisionPolicies holds all the DecisionPolicy implementations that can
+	// be used by group policies, in registration order.
+	decisionPolicies = []registeredDecisionPolicy{
+		{&ThresholdDecisionPolicy{}, "cosmos-sdk/ThresholdDecisionPolicy"},
+		{&PercentageDecisionPolicy{}, "cosmos-sdk/PercentageDecisionPolicy"},
+	}
+)
+
+// RegisterDecisionPolicy registers a DecisionPolicy implementation defined
+// outside of the group module, so that it can be used by group policies.
+//
+// The policy is registered under aminoName on the group and authz legacy
+// amino codecs straight away, and is added to the codecs configured by
+// RegisterLegacyAminoCodec and RegisterInterfaces. It must therefore be called
+// before the app's codecs are built, typically from an init function of the
+// package defining the policy.
+//
+// It panics if the policy's type or aminoName is already registered.
+func RegisterDecisionPolicy(policy DecisionPolicy, aminoName string) {
+	decisionPoliciesMu.Lock()
+	defer decisionPoliciesMu.Unlock()
+
+	typeURL := "/" + proto.MessageName(policy)
+	for _, p := range decisionPolicies {
+		if p.aminoName == aminoName {
+			panic(fmt.Errorf("decision policy amino name %s is already registered", aminoName))
+		}
+		if "/"+proto.MessageName(p.policy) == typeURL {
+			panic(fmt.Errorf("decision policy %s is already registered", typeURL))
+		}
+	}
+
+	decisionPolicies = append(decisionPolicies, registeredDecisionPolicy{policy, aminoName})
+
+	amino.RegisterConcrete(policy, aminoName, nil)
+	authzcodec.Amino.RegisterConcrete(policy, aminoName, nil)
+}
+
+// DecisionPolicies returns the type URLs of all the registered DecisionPolicy
+// implementations, including the ones shipped with the group module.
+func DecisionPolicies() []string {
+	decisionPoliciesMu.RLock()
+	defer decisionPoliciesMu.RUnlock()
+
+	typeURLs := make([]string, len(decisionPolicies))
+	for i, p := range decisionPolicies {
+		typeURLs[i] = "/" + proto.MessageName(p.policy)
+	}
+
+	return typeURLs
+}
+
+// registeredDecisionPolicies returns a copy of the registered DecisionPolicy
+// implementations.
+func registeredDecisionPolicies() []registeredDecisionPolicy {
+	decisionPoliciesMu.RLock()
+	defer decisionPoliciesMu.RUnlock()
+
+	return append([]registeredDecisionPolicy(nil), decisionPolicies...)
+}
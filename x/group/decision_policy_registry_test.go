@@ -0,0 +1,24 @@
+package group_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+func TestRegisterDecisionPolicy(t *testing.T) {
+	require.Equal(t, []string{
+		"/cosmos.group.v1.ThresholdDecisionPolicy",
+		"/cosmos.group.v1.PercentageDecisionPolicy",
+	}, group.DecisionPolicies()[:2])
+
+	require.PanicsWithError(t, "decision policy amino name cosmos-sdk/ThresholdDecisionPolicy is already registered", func() {
+		group.RegisterDecisionPolicy(&group.PercentageDecisionPolicy{}, "cosmos-sdk/ThresholdDecisionPolicy")
+	})
+	require.PanicsWithError(t, "decision policy /cosmos.group.v1.PercentageDecisionPolicy is already registered", func() {
+		group.RegisterDecisionPolicy(&group.PercentageDecisionPolicy{}, "cosmos-sdk/OtherDecisionPolicy")
+	})
+	require.Len(t, group.DecisionPolicies(), 2)
+}
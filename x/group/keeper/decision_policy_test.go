@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
+)
+
+func init() {
+	group.RegisterDecisionPolicy(&treasuryDecisionPolicy{}, "cosmos-sdk/group/testTreasuryDecisionPolicy")
+}
+
+// treasuryDecisionPolicy is a decision policy defined outside of the group
+// module. It behaves like a threshold decision policy, except that proposals
+// sending funds must be approved by all the group members.
+type treasuryDecisionPolicy struct {
+	group.ThresholdDecisionPolicy
+}
+
+var _ group.DecisionPolicy = &treasuryDecisionPolicy{}
+
+func (*treasuryDecisionPolicy) XXX_MessageName() string {
+	return "cosmos.group.v1.testTreasuryDecisionPolicy"
+}
+
+func (p treasuryDecisionPolicy) Allow(tallyResult group.TallyResult, totalPower string, sinceSubmission time.Duration, msgs []sdk.Msg, votes []group.WeightedVote) (group.DecisionPolicyResult, error) {
+	spendsFunds := false
+	for _, msg := range msgs {
+		if _, ok := msg.(*banktypes.MsgSend); ok {
+			spendsFunds = true
+		}
+	}
+	if !spendsFunds {
+		return p.ThresholdDecisionPolicy.Allow(tallyResult, totalPower, sinceSubmission, msgs, votes)
+	}
+
+	yesWeight := math.NewDecFromInt64(0)
+	for _, v := range votes {
+		if v.Vote.Option != group.VOTE_OPTION_YES {
+			continue
+		}
+		weight, err := math.NewNonNegativeDecFromString(v.Weight)
+		if err != nil {
+			return group.DecisionPolicyResult{}, err
+		}
+		if yesWeight, err = yesWeight.Add(weight); err != nil {
+			return group.DecisionPolicyResult{}, err
+		}
+	}
+
+	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
+	if err != nil {
+		return group.DecisionPolicyResult{}, err
+	}
+	if yesWeight.Cmp(totalPowerDec) >= 0 {
+		return group.DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	return group.DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+func (s *TestSuite) TestCustomDecisionPolicy() {
+	addrs := s.addrs
+	members := []group.MemberRequest{
+		{Address: addrs[1].String(), Weight: "2"},
+		{Address: addrs[2].String(), Weight: "1"},
+	}
+	policy := &treasuryDecisionPolicy{
+		ThresholdDecisionPolicy: group.ThresholdDecisionPolicy{
+			Threshold: "2",
+			Windows:   &group.DecisionPolicyWindows{VotingPeriod: time.Second},
+		},
+	}
+	policyAddr, _ := s.createGroupAndGroupPolicy(addrs[0], members, policy)
+	s.Require().NoError(testutil.FundAccount(s.bankKeeper, s.sdkCtx, sdk.MustAccAddressFromBech32(policyAddr), sdk.Coins{sdk.NewInt64Coin("test", 10000)}))
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: policyAddr,
+		ToAddress:   addrs[3].String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+
+	specs := map[string]struct {
+		msgs      []sdk.Msg
+		voters    []sdk.AccAddress
+		expStatus group.ProposalStatus
+	}{
+		"threshold is enough without spending funds": {
+			voters:    []sdk.AccAddress{addrs[1]},
+			expStatus: group.PROPOSAL_STATUS_ACCEPTED,
+		},
+		"spending funds requires all members": {
+			msgs:      []sdk.Msg{msgSend},
+			voters:    []sdk.AccAddress{addrs[1]},
+			expStatus: group.PROPOSAL_STATUS_SUBMITTED,
+		},
+		"spending funds approved by all members": {
+			msgs:      []sdk.Msg{msgSend},
+			voters:    []sdk.AccAddress{addrs[1], addrs[2]},
+			expStatus: group.PROPOSAL_STATUS_ACCEPTED,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			ctx, _ := s.sdkCtx.CacheContext()
+			goCtx := sdk.WrapSDKContext(ctx)
+
+			proposalReq := &group.MsgSubmitProposal{
+				GroupPolicyAddress: policyAddr,
+				Proposers:          []string{addrs[1].String()},
+			}
+			s.Require().NoError(proposalReq.SetMsgs(spec.msgs))
+			proposalRes, err := s.groupKeeper.SubmitProposal(goCtx, proposalReq)
+			s.Require().NoError(err)
+
+			for _, voter := range spec.voters {
+				_, err := s.groupKeeper.Vote(goCtx, &group.MsgVote{
+					ProposalId: proposalRes.ProposalId,
+					Voter:      voter.String(),
+					Option:     group.VOTE_OPTION_YES,
+				})
+				s.Require().NoError(err)
+			}
+
+			_, err = s.groupKeeper.Exec(goCtx, &group.MsgExec{Executor: addrs[1].String(), ProposalId: proposalRes.ProposalId})
+			s.Require().NoError(err)
+
+			res, err := s.groupKeeper.Proposal(goCtx, &group.QueryProposalRequest{ProposalId: proposalRes.ProposalId})
+			if spec.expStatus == group.PROPOSAL_STATUS_ACCEPTED {
+				// successfully executed proposals are pruned.
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(spec.expStatus, res.Proposal.Status)
+		})
+	}
+}
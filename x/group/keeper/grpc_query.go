@@ -346,3 +346,38 @@ func (k Keeper) TallyResult(goCtx context.Context, request *group.QueryTallyResu
 		Tally: tallyResult,
 	}, nil
 }
+
+// FlattenedGroupMembers queries the members of a group along with the members
+// of its sub-groups. The pagination key of a member is its position in the
+// flattened membership.
+func (k Keeper) FlattenedGroupMembers(goCtx context.Context, request *group.QueryFlattenedGroupMembersRequest) (*group.QueryFlattenedGroupMembersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.getGroupInfo(ctx, request.GroupId); err != nil {
+		return nil, sdkerrors.Wrap(err, "group")
+	}
+
+	var start uint64
+	if key := request.Pagination.GetKey(); key != nil {
+		if len(key) != 8 {
+			return nil, sdkerrors.Wrap(errors.ErrInvalid, "pagination key")
+		}
+		start = sdk.BigEndianToUint64(key)
+	}
+
+	flattened, err := k.flattenGroupMembers(ctx, request.GroupId, 0, map[uint64]bool{request.GroupId: true})
+	if err != nil {
+		return nil, err
+	}
+
+	var members []*group.FlattenedGroupMember
+	pageRes, err := orm.Paginate(flattenedMembersIterator(flattened, start), request.Pagination, &members)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryFlattenedGroupMembersResponse{
+		Members:    members,
+		Pagination: pageRes,
+	}, nil
+}
@@ -208,6 +208,9 @@ func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router *baseapp.Ms
 	if config.MaxExecutionPeriod == 0 {
 		config.MaxExecutionPeriod = group.DefaultConfig().MaxExecutionPeriod
 	}
+	if config.MaxSubGroupDepth == 0 {
+		config.MaxSubGroupDepth = group.DefaultConfig().MaxSubGroupDepth
+	}
 	k.config = config
 
 	return k
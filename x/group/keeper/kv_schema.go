@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// sequenceStorageKey is the key of a sequence under its prefix, see orm.Sequence.
+const sequenceStorageKey = 0x1
+
+// KVSchema returns the key/value schema of the group store. The rows of the tables are
+// stored under their table prefix followed by a 0 byte, and the entries of the indexes
+// under the index prefix, the indexed value and the row ID.
+func KVSchema() []schema.Entry {
+	var (
+		groupID     = schema.Field("group_id", schema.Uint64Key)
+		proposalID  = schema.Field("proposal_id", schema.Uint64Key)
+		groupPolicy = schema.Field("group_policy", schema.LengthPrefixed(schema.AccAddressKey))
+		memberRowID = schema.Field("row_id", rowID(groupID, schema.Field("member", schema.AccAddressKey)))
+		voteRowID   = schema.Field("row_id", rowID(proposalID, schema.Field("voter", schema.AccAddressKey)))
+	)
+	return []schema.Entry{
+		{Name: "group", Prefix: []byte{GroupTablePrefix, 0}, Key: []schema.KeyField{groupID}, Value: schema.ProtoValue(&group.GroupInfo{})},
+		{Name: "group_sequence", Prefix: []byte{GroupTableSeqPrefix, sequenceStorageKey}, Value: schema.Uint64Value},
+		{
+			Name:   "group_by_admin",
+			Prefix: []byte{GroupByAdminIndexPrefix},
+			Key:    []schema.KeyField{schema.Field("admin", schema.LengthPrefixed(schema.AccAddressKey)), groupID},
+			Value:  schema.BytesValue,
+		},
+		{
+			Name:   "group_member",
+			Prefix: []byte{GroupMemberTablePrefix, 0},
+			Key:    []schema.KeyField{groupID, schema.Field("member", schema.AccAddressKey)},
+			Value:  schema.ProtoValue(&group.GroupMember{}),
+		},
+		{
+			Name:   "group_member_by_group",
+			Prefix: []byte{GroupMemberByGroupIndexPrefix},
+			Key:    []schema.KeyField{groupID, memberRowID},
+			Value:  schema.BytesValue,
+		},
+		{
+			Name:   "group_member_by_member",
+			Prefix: []byte{GroupMemberByMemberIndexPrefix},
+			Key:    []schema.KeyField{schema.Field("member", schema.LengthPrefixed(schema.AccAddressKey)), memberRowID},
+			Value:  schema.BytesValue,
+		},
+		{
+			Name:   "group_policy",
+			Prefix: []byte{GroupPolicyTablePrefix, 0},
+			Key:    []schema.KeyField{groupPolicy},
+			Value:  schema.ProtoValue(&group.GroupPolicyInfo{}),
+		},
+		{Name: "group_policy_sequence", Prefix: []byte{GroupPolicyTableSeqPrefix, sequenceStorageKey}, Value: schema.Uint64Value},
+		{
+			Name:   "group_policy_by_group",
+			Prefix: []byte{GroupPolicyByGroupIndexPrefix},
+			Key:    []schema.KeyField{groupID, groupPolicy},
+			Value:  schema.BytesValue,
+		},
+		{
+			Name:   "group_policy_by_admin",
+			Prefix: []byte{GroupPolicyByAdminIndexPrefix},
+			Key:    []schema.KeyField{schema.Field("admin", schema.LengthPrefixed(schema.AccAddressKey)), groupPolicy},
+			Value:  schema.BytesValue,
+		},
+		{Name: "proposal", Prefix: []byte{ProposalTablePrefix, 0}, Key: []schema.KeyField{proposalID}, Value: schema.ProtoValue(&group.Proposal{})},
+		{Name: "proposal_sequence", Prefix: []byte{ProposalTableSeqPrefix, sequenceStorageKey}, Value: schema.Uint64Value},
+		{
+			Name:   "proposal_by_group_policy",
+			Prefix: []byte{ProposalByGroupPolicyIndexPrefix},
+			Key:    []schema.KeyField{groupPolicy, proposalID},
+			Value:  schema.BytesValue,
+		},
+		{
+			Name:   "proposal_by_voting_period_end",
+			Prefix: []byte{ProposalsByVotingPeriodEndPrefix},
+			Key:    []schema.KeyField{schema.Field("voting_period_end", schema.LengthPrefixed(schema.TimeKey)), proposalID},
+			Value:  schema.BytesValue,
+		},
+		{
+			Name:   "vote",
+			Prefix: []byte{VoteTablePrefix, 0},
+			Key:    []schema.KeyField{proposalID, schema.Field("voter", schema.AccAddressKey)},
+			Value:  schema.ProtoValue(&group.Vote{}),
+		},
+		{
+			Name:   "vote_by_proposal",
+			Prefix: []byte{VoteByProposalIndexPrefix},
+			Key:    []schema.KeyField{proposalID, voteRowID},
+			Value:  schema.BytesValue,
+		},
+		{
+			Name:   "vote_by_voter",
+			Prefix: []byte{VoteByVoterIndexPrefix},
+			Key:    []schema.KeyField{schema.Field("voter", schema.LengthPrefixed(schema.AccAddressKey)), voteRowID},
+			Value:  schema.BytesValue,
+		},
+	}
+}
+
+// rowID decodes the rest of the key as the row ID of a table with a composite primary
+// key, into an object of the given fields.
+func rowID(fields ...schema.KeyField) schema.KeyCodec {
+	return func(key []byte) (interface{}, []byte, error) {
+		decoded := schema.DecodedKey{}
+		for _, field := range fields {
+			value, rest, err := field.Codec(key)
+			if err != nil {
+				return nil, nil, err
+			}
+			decoded = append(decoded, schema.DecodedField{Name: field.Name, Value: value})
+			key = rest
+		}
+		return decoded, key, nil
+	}
+}
@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+)
+
+func (s *TestSuite) TestKVSchema() {
+	req := &group.MsgSubmitProposal{
+		GroupPolicyAddress: s.groupPolicyAddr.String(),
+		Proposers:          []string{s.addrs[4].String()},
+	}
+	proposalRes, err := s.groupKeeper.SubmitProposal(s.ctx, req)
+	s.Require().NoError(err)
+	_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{
+		ProposalId: proposalRes.ProposalId,
+		Voter:      s.addrs[1].String(),
+		Option:     group.VOTE_OPTION_YES,
+	})
+	s.Require().NoError(err)
+
+	registry := schema.NewRegistry(codec.NewProtoCodec(s.interfaceRegistry))
+	registry.Register(group.StoreKey, keeper.KVSchema()...)
+
+	// every entry written by the keeper is decoded
+	entries := make(map[string]bool)
+	store := s.sdkCtx.KVStore(s.app.UnsafeFindStoreKey(group.StoreKey))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		decoded, err := registry.Decode(group.StoreKey, iter.Key(), iter.Value())
+		s.Require().NoError(err, "key %X", iter.Key())
+		entries[decoded.Entry] = true
+	}
+	for _, entry := range keeper.KVSchema() {
+		s.Require().True(entries[entry.Name], entry.Name)
+	}
+}
@@ -634,10 +634,13 @@ func (k Keeper) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgVoteR
 
 	// Count and store votes.
 	voterAddr := req.Voter
-	voter := group.GroupMember{GroupId: electorate.Id, Member: &group.Member{Address: voterAddr}}
-	if err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&voter), &voter); err != nil {
+	isMember, err := k.isEffectiveMember(ctx, electorate.Id, voterAddr, 0)
+	if err != nil {
 		return nil, sdkerrors.Wrapf(err, "voter address: %s", voterAddr)
 	}
+	if !isMember {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "voter address: %s", voterAddr)
+	}
 	newVote := group.Vote{
 		ProposalId: id,
 		Voter:      voterAddr,
@@ -680,13 +683,18 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, electorate
 		return err
 	}
 
-	tallyResult, err := k.Tally(ctx, *p, policyInfo.GroupId)
+	tallyResult, votes, err := k.tally(ctx, *p, policyInfo.GroupId)
+	if err != nil {
+		return err
+	}
+
+	msgs, err := p.GetMsgs()
 	if err != nil {
 		return err
 	}
 
 	sinceSubmission := ctx.BlockTime().Sub(p.SubmitTime) // duration passed since proposal submission.
-	result, err := policy.Allow(tallyResult, electorate.TotalWeight, sinceSubmission)
+	result, err := policy.Allow(tallyResult, electorate.TotalWeight, sinceSubmission, msgs, votes)
 	// If the result was final (i.e. enough votes to pass) or if the voting
 	// period ended, then we consider the proposal as final.
 	isFinal := result.Final || ctx.BlockTime().After(p.VotingPeriodEnd)
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
+)
+
+// subGroupID returns the ID of the group a group policy account belongs to,
+// or false if the given address is not a group policy account.
+func (k Keeper) subGroupID(ctx sdk.Context, address string) (uint64, bool, error) {
+	policyInfo, err := k.getGroupPolicyInfo(ctx, address)
+	switch {
+	case sdkerrors.ErrNotFound.Is(err):
+		return 0, false, nil
+	case err != nil:
+		return 0, false, err
+	}
+
+	return policyInfo.GroupId, true, nil
+}
+
+// isEffectiveMember returns whether an address is a member of a group, either
+// directly or through the sub-groups nested at most MaxSubGroupDepth levels
+// below the group, where depth is the nesting level of the given group.
+func (k Keeper) isEffectiveMember(ctx sdk.Context, groupID uint64, address string, depth uint64) (bool, error) {
+	store := ctx.KVStore(k.key)
+	if k.groupMemberTable.Has(store, orm.PrimaryKey(&group.GroupMember{GroupId: groupID, Member: &group.Member{Address: address}})) {
+		return true, nil
+	}
+	if depth >= k.config.MaxSubGroupDepth {
+		return false, nil
+	}
+
+	members, err := k.loadGroupMembers(ctx, groupID)
+	if err != nil {
+		return false, err
+	}
+	for _, member := range members {
+		subGroupID, isSubGroup, err := k.subGroupID(ctx, member.Member.Address)
+		if err != nil {
+			return false, err
+		}
+		if !isSubGroup {
+			continue
+		}
+
+		isMember, err := k.isEffectiveMember(ctx, subGroupID, address, depth+1)
+		if err != nil || isMember {
+			return isMember, err
+		}
+	}
+
+	return false, nil
+}
+
+// flattenGroupMembers returns the members of a group along with the members
+// of its sub-groups nested at most MaxSubGroupDepth levels below the group,
+// in depth-first order, where depth is the nesting level of the given group.
+// The groups in visited are already flattened: a sub-group reached through
+// several paths, or through a cycle, is still listed as a member but its
+// members are only listed the first time.
+func (k Keeper) flattenGroupMembers(ctx sdk.Context, groupID uint64, depth uint64, visited map[uint64]bool) ([]*group.FlattenedGroupMember, error) {
+	members, err := k.loadGroupMembers(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	var flattened []*group.FlattenedGroupMember
+	for _, member := range members {
+		subGroupID, isSubGroup, err := k.subGroupID(ctx, member.Member.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		flattened = append(flattened, &group.FlattenedGroupMember{
+			GroupId:    groupID,
+			Member:     member.Member,
+			Depth:      depth,
+			SubGroupId: subGroupID,
+		})
+
+		if isSubGroup && depth < k.config.MaxSubGroupDepth && !visited[subGroupID] {
+			visited[subGroupID] = true
+			subMembers, err := k.flattenGroupMembers(ctx, subGroupID, depth+1, visited)
+			if err != nil {
+				return nil, err
+			}
+			flattened = append(flattened, subMembers...)
+		}
+	}
+
+	return flattened, nil
+}
+
+// flattenedMembersIterator returns an iterator over the flattened members of
+// a group starting at the given position, which is used as the row ID of each
+// member so that the members can be paginated by key.
+func flattenedMembersIterator(members []*group.FlattenedGroupMember, start uint64) orm.Iterator {
+	pos := start
+	return orm.IteratorFunc(func(dest codec.ProtoMarshaler) (orm.RowID, error) {
+		if dest == nil {
+			return nil, sdkerrors.Wrap(errors.ErrORMInvalidArgument, "destination object must not be nil")
+		}
+		if pos >= uint64(len(members)) {
+			return nil, errors.ErrORMIteratorDone
+		}
+
+		bz, err := members[pos].Marshal()
+		if err != nil {
+			return nil, err
+		}
+		rowID := orm.RowID(sdk.Uint64ToBigEndian(pos))
+		pos++
+
+		return rowID, dest.Unmarshal(bz)
+	})
+}
+
+// loadGroupMembers returns all the direct members of a group.
+func (k Keeper) loadGroupMembers(ctx sdk.Context, groupID uint64) ([]*group.GroupMember, error) {
+	it, err := k.groupMemberByGroupIndex.Get(ctx.KVStore(k.key), groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []*group.GroupMember
+	if _, err := orm.ReadAll(it, &members); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
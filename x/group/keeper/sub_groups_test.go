@@ -0,0 +1,250 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+func (s *TestSuite) TestSubGroups() {
+	addrs := s.addrs
+	policy := group.NewThresholdDecisionPolicy("2", time.Second, 0)
+
+	committeeAddr, committeeID := s.createGroupAndGroupPolicy(addrs[0], []group.MemberRequest{
+		{Address: addrs[2].String(), Weight: "1"},
+		{Address: addrs[3].String(), Weight: "1"},
+		{Address: addrs[5].String(), Weight: "1"},
+	}, policy)
+	councilAddr, councilID := s.createGroupAndGroupPolicy(addrs[0], []group.MemberRequest{
+		{Address: addrs[1].String(), Weight: "1"},
+		{Address: committeeAddr, Weight: "2"},
+	}, policy)
+
+	specs := map[string]struct {
+		votes    map[string]group.VoteOption
+		expErr   bool
+		expTally group.TallyResult
+	}{
+		"committee vote is the majority of its members": {
+			votes: map[string]group.VoteOption{
+				addrs[1].String(): group.VOTE_OPTION_NO,
+				addrs[2].String(): group.VOTE_OPTION_YES,
+				addrs[3].String(): group.VOTE_OPTION_YES,
+				addrs[5].String(): group.VOTE_OPTION_NO,
+			},
+			expTally: group.TallyResult{YesCount: "2", NoCount: "1", AbstainCount: "0", NoWithVetoCount: "0"},
+		},
+		"committee tie is not counted": {
+			votes: map[string]group.VoteOption{
+				addrs[1].String(): group.VOTE_OPTION_YES,
+				addrs[2].String(): group.VOTE_OPTION_YES,
+				addrs[3].String(): group.VOTE_OPTION_ABSTAIN,
+			},
+			expTally: group.TallyResult{YesCount: "1", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+		},
+		"direct vote of the committee takes precedence": {
+			votes: map[string]group.VoteOption{
+				committeeAddr:     group.VOTE_OPTION_NO_WITH_VETO,
+				addrs[2].String(): group.VOTE_OPTION_YES,
+			},
+			expTally: group.TallyResult{YesCount: "0", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "2"},
+		},
+		"non member cannot vote": {
+			votes: map[string]group.VoteOption{
+				addrs[4].String(): group.VOTE_OPTION_YES,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			ctx, _ := s.sdkCtx.CacheContext()
+			goCtx := sdk.WrapSDKContext(ctx)
+
+			proposalReq := &group.MsgSubmitProposal{
+				GroupPolicyAddress: councilAddr,
+				Proposers:          []string{addrs[1].String()},
+			}
+			proposalRes, err := s.groupKeeper.SubmitProposal(goCtx, proposalReq)
+			s.Require().NoError(err)
+
+			for voter, option := range spec.votes {
+				_, err = s.groupKeeper.Vote(goCtx, &group.MsgVote{
+					ProposalId: proposalRes.ProposalId,
+					Voter:      voter,
+					Option:     option,
+				})
+				if spec.expErr {
+					s.Require().Error(err)
+					return
+				}
+				s.Require().NoError(err)
+			}
+
+			res, err := s.groupKeeper.TallyResult(goCtx, &group.QueryTallyResultRequest{ProposalId: proposalRes.ProposalId})
+			s.Require().NoError(err)
+			s.Require().Equal(spec.expTally, res.Tally)
+		})
+	}
+
+	res, err := s.groupKeeper.FlattenedGroupMembers(s.ctx, &group.QueryFlattenedGroupMembersRequest{GroupId: councilID})
+	s.Require().NoError(err)
+	s.Require().Len(res.Members, 5)
+	for _, m := range res.Members {
+		switch m.Member.Address {
+		case addrs[1].String():
+			s.Require().Equal(group.FlattenedGroupMember{GroupId: councilID, Member: m.Member, Depth: 0}, *m)
+		case committeeAddr:
+			s.Require().Equal(group.FlattenedGroupMember{GroupId: councilID, Member: m.Member, Depth: 0, SubGroupId: committeeID}, *m)
+		default:
+			s.Require().Equal(group.FlattenedGroupMember{GroupId: committeeID, Member: m.Member, Depth: 1}, *m)
+		}
+	}
+}
+
+func (s *TestSuite) TestSubGroupsMaxDepth() {
+	addrs := s.addrs
+	policyAddr, groupID := s.createGroupAndGroupPolicy(addrs[0], []group.MemberRequest{
+		{Address: addrs[1].String(), Weight: "1"},
+	}, group.NewThresholdDecisionPolicy("1", time.Second, 0))
+
+	// The group becomes a sub-group of itself.
+	_, err := s.groupKeeper.UpdateGroupMembers(s.ctx, &group.MsgUpdateGroupMembers{
+		Admin:         addrs[0].String(),
+		GroupId:       groupID,
+		MemberUpdates: []group.MemberRequest{{Address: policyAddr, Weight: "1"}},
+	})
+	s.Require().NoError(err)
+
+	res, err := s.groupKeeper.FlattenedGroupMembers(s.ctx, &group.QueryFlattenedGroupMembersRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	// The group is listed as its own member, but its members are only listed
+	// once.
+	s.Require().Len(res.Members, 2)
+	for _, m := range res.Members {
+		s.Require().Equal(uint64(0), m.Depth)
+	}
+
+	proposalRes, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyAddr,
+		Proposers:          []string{addrs[1].String()},
+	})
+	s.Require().NoError(err)
+	_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{
+		ProposalId: proposalRes.ProposalId,
+		Voter:      addrs[1].String(),
+		Option:     group.VOTE_OPTION_YES,
+	})
+	s.Require().NoError(err)
+
+	tallyRes, err := s.groupKeeper.TallyResult(s.ctx, &group.QueryTallyResultRequest{ProposalId: proposalRes.ProposalId})
+	s.Require().NoError(err)
+	// The vote is only counted in the outermost group, where addrs[1] is
+	// already a member.
+	s.Require().Equal("1", tallyRes.Tally.YesCount)
+}
+
+func (s *TestSuite) TestSubGroupsOverlap() {
+	addrs := s.addrs
+	policy := group.NewThresholdDecisionPolicy("2", time.Second, 0)
+
+	// addrs[1] is both a direct member of the council and a member of the
+	// committee.
+	committeeAddr, _ := s.createGroupAndGroupPolicy(addrs[0], []group.MemberRequest{
+		{Address: addrs[1].String(), Weight: "1"},
+		{Address: addrs[2].String(), Weight: "1"},
+	}, policy)
+	councilAddr, _ := s.createGroupAndGroupPolicy(addrs[0], []group.MemberRequest{
+		{Address: addrs[1].String(), Weight: "1"},
+		{Address: committeeAddr, Weight: "2"},
+	}, policy)
+
+	proposalRes, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: councilAddr,
+		Proposers:          []string{addrs[1].String()},
+	})
+	s.Require().NoError(err)
+	for voter, option := range map[string]group.VoteOption{
+		addrs[1].String(): group.VOTE_OPTION_YES,
+		addrs[2].String(): group.VOTE_OPTION_NO,
+	} {
+		_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{
+			ProposalId: proposalRes.ProposalId,
+			Voter:      voter,
+			Option:     option,
+		})
+		s.Require().NoError(err)
+	}
+
+	// The vote of addrs[1] is counted in the council only, so the committee
+	// votes no instead of being tied.
+	tallyRes, err := s.groupKeeper.TallyResult(s.ctx, &group.QueryTallyResultRequest{ProposalId: proposalRes.ProposalId})
+	s.Require().NoError(err)
+	s.Require().Equal(group.TallyResult{YesCount: "1", NoCount: "2", AbstainCount: "0", NoWithVetoCount: "0"}, tallyRes.Tally)
+}
+
+func (s *TestSuite) TestFlattenedGroupMembersDiamond() {
+	addrs := s.addrs
+	policy := group.NewThresholdDecisionPolicy("1", time.Second, 0)
+
+	// The council is made of two committees sharing the same board.
+	boardAddr, boardID := s.createGroupAndGroupPolicy(addrs[0], []group.MemberRequest{
+		{Address: addrs[4].String(), Weight: "1"},
+	}, policy)
+	committee1Addr, _ := s.createGroupAndGroupPolicy(addrs[0], []group.MemberRequest{
+		{Address: boardAddr, Weight: "1"},
+		{Address: addrs[2].String(), Weight: "1"},
+	}, policy)
+	committee2Addr, _ := s.createGroupAndGroupPolicy(addrs[0], []group.MemberRequest{
+		{Address: boardAddr, Weight: "1"},
+		{Address: addrs[3].String(), Weight: "1"},
+	}, policy)
+	_, councilID := s.createGroupAndGroupPolicy(addrs[0], []group.MemberRequest{
+		{Address: committee1Addr, Weight: "1"},
+		{Address: committee2Addr, Weight: "1"},
+	}, policy)
+
+	res, err := s.groupKeeper.FlattenedGroupMembers(s.ctx, &group.QueryFlattenedGroupMembersRequest{GroupId: councilID})
+	s.Require().NoError(err)
+	// The board is listed as a member of both committees, but its members are
+	// only listed once.
+	s.Require().Len(res.Members, 7)
+	var boardMembers, boardListings int
+	for _, m := range res.Members {
+		if m.GroupId == boardID {
+			boardMembers++
+		}
+		if m.Member.Address == boardAddr {
+			boardListings++
+		}
+	}
+	s.Require().Equal(1, boardMembers)
+	s.Require().Equal(2, boardListings)
+	s.Require().Equal(uint64(7), res.Pagination.Total)
+
+	// The members are paginated by key in the same order.
+	var paginated []*group.FlattenedGroupMember
+	pageReq := &query.PageRequest{Limit: 3}
+	for {
+		page, err := s.groupKeeper.FlattenedGroupMembers(s.ctx, &group.QueryFlattenedGroupMembersRequest{GroupId: councilID, Pagination: pageReq})
+		s.Require().NoError(err)
+		s.Require().LessOrEqual(len(page.Members), 3)
+		paginated = append(paginated, page.Members...)
+		if page.Pagination.NextKey == nil {
+			break
+		}
+		pageReq = &query.PageRequest{Key: page.Pagination.NextKey, Limit: 3}
+	}
+	s.Require().Equal(res.Members, paginated)
+
+	// and by offset
+	page, err := s.groupKeeper.FlattenedGroupMembers(s.ctx, &group.QueryFlattenedGroupMembersRequest{GroupId: councilID, Pagination: &query.PageRequest{Offset: 5, Limit: 3}})
+	s.Require().NoError(err)
+	s.Require().Equal(res.Members[5:], page.Members)
+
+	_, err = s.groupKeeper.FlattenedGroupMembers(s.ctx, &group.QueryFlattenedGroupMembersRequest{GroupId: councilID, Pagination: &query.PageRequest{Key: []byte{1}}})
+	s.Require().Error(err)
+}
@@ -4,60 +4,151 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/group"
-	"github.com/cosmos/cosmos-sdk/x/group/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
 	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
 )
 
-// Tally is a function that tallies a proposal by iterating through its votes,
-// and returns the tally result without modifying the proposal or any state.
+// Tally is a function that tallies a proposal by iterating through the
+// members of its group, and returns the tally result without modifying the
+// proposal or any state.
 func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.TallyResult, error) {
+	tallyResult, _, err := k.tally(ctx, p, groupID)
+	return tallyResult, err
+}
+
+// tally tallies a proposal like Tally does, and additionally returns the
+// counted votes weighted by their voter's current weight in the group. No
+// votes are returned for a proposal which was already tallied, as its votes
+// have been pruned.
+func (k Keeper) tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.TallyResult, []group.WeightedVote, error) {
 	// If proposal has already been tallied and updated, then its status is
 	// accepted/rejected, in which case we just return the previously stored result.
 	//
 	// In all other cases (including withdrawn, aborted...) we do the tally
 	// again.
 	if p.Status == group.PROPOSAL_STATUS_ACCEPTED || p.Status == group.PROPOSAL_STATUS_REJECTED {
-		return p.FinalTallyResult, nil
+		return p.FinalTallyResult, nil, nil
 	}
 
-	it, err := k.voteByProposalIndex.Get(ctx.KVStore(k.key), p.Id)
+	return k.tallyGroup(ctx, p.Id, groupID, 0, nil)
+}
+
+// tallyGroup tallies the votes cast on a proposal by the members of a group
+// nested depth levels deep below the proposal's group. Members who left the
+// group after voting are not counted, nor are the members of the enclosing
+// groups, given in outerMembers, whose votes are already counted there.
+func (k Keeper) tallyGroup(ctx sdk.Context, proposalID, groupID, depth uint64, outerMembers map[string]bool) (group.TallyResult, []group.WeightedVote, error) {
+	members, err := k.loadGroupMembers(ctx, groupID)
 	if err != nil {
-		return group.TallyResult{}, err
+		return group.TallyResult{}, nil, err
+	}
+
+	// the members of this group are not counted again in its sub-groups
+	subOuterMembers := make(map[string]bool, len(outerMembers)+len(members))
+	for addr := range outerMembers {
+		subOuterMembers[addr] = true
+	}
+	for _, member := range members {
+		subOuterMembers[member.Member.Address] = true
 	}
-	defer it.Close()
 
 	tallyResult := group.DefaultTallyResult()
+	var votes []group.WeightedVote
 
-	for {
-		var vote group.Vote
-		_, err = it.LoadNext(&vote)
-		if errors.ErrORMIteratorDone.Is(err) {
-			break
+	for _, member := range members {
+		if outerMembers[member.Member.Address] {
+			continue
 		}
+
+		vote, found, err := k.memberVote(ctx, proposalID, member.Member.Address, depth, subOuterMembers)
 		if err != nil {
-			return group.TallyResult{}, err
+			return group.TallyResult{}, nil, err
 		}
-
-		var member group.GroupMember
-		err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.GroupMember{
-			GroupId: groupID,
-			Member:  &group.Member{Address: vote.Voter},
-		}), &member)
-
-		switch {
-		case sdkerrors.ErrNotFound.Is(err):
-			// If the member left the group after voting, then we simply skip the
-			// vote.
+		if !found {
 			continue
-		case err != nil:
-			// For any other errors, we stop and return the error.
-			return group.TallyResult{}, err
 		}
 
 		if err := tallyResult.Add(vote, member.Member.Weight); err != nil {
-			return group.TallyResult{}, sdkerrors.Wrap(err, "add new vote")
+			return group.TallyResult{}, nil, sdkerrors.Wrap(err, "add new vote")
 		}
+		votes = append(votes, group.WeightedVote{Vote: vote, Weight: member.Member.Weight})
+	}
+
+	return tallyResult, votes, nil
+}
+
+// memberVote returns the vote of a group member on a proposal. When the
+// member is a sub-group, i.e. the account of a group policy, which didn't
+// vote itself, its vote is worked out from the tally of the sub-group's
+// members: it is the option holding the most weight in that tally, if any.
+// The decision policy of the sub-group's policy account is not applied: its
+// threshold or percentage, and its voting and execution windows, don't affect
+// the sub-group's vote. Sub-groups nested more than MaxSubGroupDepth levels
+// deep are not tallied, and the sub-group's members in outerMembers are left
+// out of its tally.
+func (k Keeper) memberVote(ctx sdk.Context, proposalID uint64, address string, depth uint64, outerMembers map[string]bool) (group.Vote, bool, error) {
+	var vote group.Vote
+	err := k.voteTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.Vote{ProposalId: proposalID, Voter: address}), &vote)
+	switch {
+	case err == nil:
+		return vote, true, nil
+	case !sdkerrors.ErrNotFound.Is(err):
+		return group.Vote{}, false, err
+	case depth >= k.config.MaxSubGroupDepth:
+		return group.Vote{}, false, nil
+	}
+
+	subGroupID, isSubGroup, err := k.subGroupID(ctx, address)
+	if err != nil || !isSubGroup {
+		return group.Vote{}, false, err
+	}
+
+	subTally, _, err := k.tallyGroup(ctx, proposalID, subGroupID, depth+1, outerMembers)
+	if err != nil {
+		return group.Vote{}, false, sdkerrors.Wrapf(err, "sub-group %d", subGroupID)
+	}
+	option, found, err := majorityOption(subTally)
+	if err != nil || !found {
+		return group.Vote{}, false, err
+	}
+
+	return group.Vote{ProposalId: proposalID, Voter: address, Option: option}, true, nil
+}
+
+// majorityOption returns the vote option holding strictly the most weight in
+// a tally result. It returns false if no votes were cast or if several options
+// hold the most weight.
+func majorityOption(tallyResult group.TallyResult) (group.VoteOption, bool, error) {
+	counts := []struct {
+		option group.VoteOption
+		count  string
+	}{
+		{group.VOTE_OPTION_YES, tallyResult.YesCount},
+		{group.VOTE_OPTION_NO, tallyResult.NoCount},
+		{group.VOTE_OPTION_ABSTAIN, tallyResult.AbstainCount},
+		{group.VOTE_OPTION_NO_WITH_VETO, tallyResult.NoWithVetoCount},
+	}
+
+	option := group.VOTE_OPTION_UNSPECIFIED
+	max := math.NewDecFromInt64(0)
+	tie := false
+	for _, c := range counts {
+		count, err := math.NewNonNegativeDecFromString(c.count)
+		if err != nil {
+			return group.VOTE_OPTION_UNSPECIFIED, false, err
+		}
+
+		switch cmp := count.Cmp(max); {
+		case cmp > 0:
+			option, max, tie = c.option, count, false
+		case cmp == 0:
+			tie = true
+		}
+	}
+
+	if option == group.VOTE_OPTION_UNSPECIFIED || tie {
+		return group.VOTE_OPTION_UNSPECIFIED, false, nil
 	}
 
-	return tallyResult, nil
+	return option, true, nil
 }
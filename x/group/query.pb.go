@@ -1380,6 +1380,117 @@ func (m *QueryTallyResultResponse) GetTally() TallyResult {
 	return TallyResult{}
 }
 
+// QueryFlattenedGroupMembersRequest is the Query/FlattenedGroupMembers request type.
+type QueryFlattenedGroupMembersRequest struct {
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFlattenedGroupMembersRequest) Reset()         { *m = QueryFlattenedGroupMembersRequest{} }
+func (m *QueryFlattenedGroupMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFlattenedGroupMembersRequest) ProtoMessage()    {}
+func (*QueryFlattenedGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{26}
+}
+func (m *QueryFlattenedGroupMembersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFlattenedGroupMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFlattenedGroupMembersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFlattenedGroupMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFlattenedGroupMembersRequest.Merge(m, src)
+}
+func (m *QueryFlattenedGroupMembersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFlattenedGroupMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFlattenedGroupMembersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFlattenedGroupMembersRequest proto.InternalMessageInfo
+
+func (m *QueryFlattenedGroupMembersRequest) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *QueryFlattenedGroupMembersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFlattenedGroupMembersResponse is the Query/FlattenedGroupMembers response type.
+type QueryFlattenedGroupMembersResponse struct {
+	// members are the direct and sub-group members of the group with given
+	// group_id, in depth-first order.
+	Members []*FlattenedGroupMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFlattenedGroupMembersResponse) Reset()         { *m = QueryFlattenedGroupMembersResponse{} }
+func (m *QueryFlattenedGroupMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFlattenedGroupMembersResponse) ProtoMessage()    {}
+func (*QueryFlattenedGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{27}
+}
+func (m *QueryFlattenedGroupMembersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFlattenedGroupMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFlattenedGroupMembersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFlattenedGroupMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFlattenedGroupMembersResponse.Merge(m, src)
+}
+func (m *QueryFlattenedGroupMembersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFlattenedGroupMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFlattenedGroupMembersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFlattenedGroupMembersResponse proto.InternalMessageInfo
+
+func (m *QueryFlattenedGroupMembersResponse) GetMembers() []*FlattenedGroupMember {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *QueryFlattenedGroupMembersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGroupInfoRequest)(nil), "cosmos.group.v1.QueryGroupInfoRequest")
 	proto.RegisterType((*QueryGroupInfoResponse)(nil), "cosmos.group.v1.QueryGroupInfoResponse")
@@ -1407,91 +1518,96 @@ func init() {
 	proto.RegisterType((*QueryGroupsByMemberResponse)(nil), "cosmos.group.v1.QueryGroupsByMemberResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.group.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.group.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryFlattenedGroupMembersRequest)(nil), "cosmos.group.v1.QueryFlattenedGroupMembersRequest")
+	proto.RegisterType((*QueryFlattenedGroupMembersResponse)(nil), "cosmos.group.v1.QueryFlattenedGroupMembersResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/query.proto", fileDescriptor_0fcf9f1d74302290) }
 
 var fileDescriptor_0fcf9f1d74302290 = []byte{
-	// 1249 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0xc7, 0x33, 0x25, 0x69, 0x92, 0x97, 0xb6, 0x11, 0x43, 0x5a, 0x12, 0x37, 0xda, 0x04, 0x03,
-	0xf9, 0x1d, 0x3b, 0xbb, 0x49, 0xd3, 0x8a, 0x9f, 0xea, 0x4a, 0x10, 0x72, 0x28, 0x4a, 0x97, 0x88,
-	0x03, 0x97, 0xc8, 0x9b, 0x75, 0x8c, 0xc5, 0xae, 0x67, 0xbb, 0x76, 0x22, 0x56, 0xd1, 0x5e, 0x90,
-	0xca, 0x01, 0x71, 0x00, 0x8a, 0x50, 0x89, 0x38, 0xf4, 0x80, 0x04, 0x7f, 0x00, 0x08, 0x89, 0x5b,
-	0x6f, 0x3d, 0x56, 0x70, 0xe1, 0x84, 0x50, 0xc2, 0x1f, 0x82, 0x3c, 0xf3, 0xbc, 0xeb, 0xdf, 0xeb,
-	0x15, 0x2b, 0xc8, 0xa9, 0x5d, 0xfb, 0xbd, 0x79, 0x9f, 0xf9, 0xbe, 0xe7, 0xf1, 0xd7, 0x81, 0xeb,
-	0xfb, 0xcc, 0xae, 0x31, 0x5b, 0x35, 0x1a, 0xec, 0xb0, 0xae, 0x1e, 0xe5, 0xd5, 0x7b, 0x87, 0x7a,
-	0xa3, 0xa9, 0xd4, 0x1b, 0xcc, 0x61, 0x74, 0x5c, 0xdc, 0x54, 0xf8, 0x4d, 0xe5, 0x28, 0x2f, 0x4d,
-	0x18, 0xcc, 0x60, 0xfc, 0x9e, 0xea, 0xfe, 0x4f, 0x84, 0x49, 0xd3, 0x06, 0x63, 0x46, 0x55, 0x57,
-	0xb5, 0xba, 0xa9, 0x6a, 0x96, 0xc5, 0x1c, 0xcd, 0x31, 0x99, 0x65, 0xe3, 0xdd, 0x48, 0x05, 0xa7,
-	0x59, 0xd7, 0xbd, 0x9b, 0x4b, 0x78, 0xb3, 0xac, 0xd9, 0xba, 0x28, 0xad, 0x1e, 0xe5, 0xcb, 0xba,
-	0xa3, 0xe5, 0xd5, 0xba, 0x66, 0x98, 0x16, 0x5f, 0x09, 0x63, 0xa7, 0x44, 0xec, 0x9e, 0xa8, 0x8f,
-	0x68, 0xfc, 0x87, 0x5c, 0x80, 0xab, 0x77, 0xdd, 0xe4, 0x2d, 0xb7, 0xc6, 0xb6, 0x75, 0xc0, 0x4a,
-	0xfa, 0xbd, 0x43, 0xdd, 0x76, 0xe8, 0x14, 0x8c, 0xf0, 0xba, 0x7b, 0x66, 0x65, 0x92, 0xcc, 0x92,
-	0x85, 0xc1, 0xd2, 0x30, 0xff, 0xbd, 0x5d, 0x91, 0xdf, 0x81, 0x6b, 0xe1, 0x1c, 0xbb, 0xce, 0x2c,
-	0x5b, 0xa7, 0x0a, 0x0c, 0x9a, 0xd6, 0x01, 0xe3, 0x09, 0x63, 0x05, 0x49, 0x09, 0xa9, 0xa0, 0x74,
-	0x32, 0x78, 0x9c, 0x7c, 0x17, 0xae, 0x77, 0x56, 0xda, 0x61, 0x55, 0x73, 0xbf, 0xe9, 0x67, 0x28,
-	0xc0, 0xb0, 0x56, 0xa9, 0x34, 0x74, 0xdb, 0xe6, 0x2b, 0x8e, 0x16, 0x27, 0x7f, 0xfb, 0x69, 0x75,
-	0x02, 0x17, 0xbd, 0x2d, 0xee, 0xbc, 0xe7, 0x34, 0x4c, 0xcb, 0x28, 0x79, 0x81, 0xf2, 0x2e, 0x4c,
-	0xc7, 0x2f, 0x89, 0x88, 0x1b, 0x01, 0xc4, 0xd9, 0x78, 0x44, 0x5f, 0x9e, 0x00, 0x6d, 0xc1, 0x64,
-	0x67, 0xd5, 0x3b, 0x7a, 0xad, 0xac, 0x37, 0xec, 0xee, 0x4a, 0xd1, 0xb7, 0x01, 0x3a, 0xcd, 0x98,
-	0xbc, 0xc0, 0x4b, 0xce, 0x79, 0x25, 0xdd, 0xce, 0x29, 0x62, 0x68, 0xb0, 0x73, 0xca, 0x8e, 0x66,
-	0xe8, 0xb8, 0x6c, 0xc9, 0x97, 0x29, 0x7f, 0x47, 0x60, 0x2a, 0xa6, 0x3e, 0x6e, 0x69, 0x13, 0x86,
-	0x6b, 0xe2, 0xd2, 0x24, 0x99, 0x7d, 0x66, 0x61, 0xac, 0x30, 0x1d, 0xbf, 0x2b, 0x91, 0x57, 0xf2,
-	0x82, 0xe9, 0x56, 0x0c, 0xdd, 0x7c, 0x57, 0x3a, 0x51, 0x34, 0x80, 0xf7, 0x20, 0x80, 0x67, 0x17,
-	0x9b, 0xb7, 0x2b, 0x35, 0xd3, 0xf2, 0xf4, 0x51, 0x60, 0x48, 0x73, 0x7f, 0x77, 0xed, 0xa1, 0x08,
-	0xeb, 0x9b, 0x68, 0xdf, 0x12, 0x90, 0xe2, 0xa8, 0x50, 0xb5, 0x02, 0x5c, 0xe4, 0xf2, 0x78, 0xa2,
-	0xa5, 0x4d, 0x2b, 0x46, 0xf6, 0x4f, 0xb1, 0xfb, 0x04, 0x66, 0x43, 0x63, 0x6a, 0xea, 0x76, 0x51,
-	0xfc, 0xfc, 0x0f, 0x07, 0xeb, 0x67, 0x02, 0x2f, 0xa4, 0x70, 0xa0, 0x54, 0x5b, 0x70, 0x45, 0x80,
-	0xd4, 0x31, 0x00, 0x25, 0xeb, 0xfe, 0xf4, 0x5c, 0x36, 0xfc, 0xeb, 0xf6, 0x4f, 0xbf, 0x93, 0x04,
-	0xfd, 0xce, 0xc5, 0xe0, 0x25, 0x89, 0x1a, 0x9c, 0xbf, 0xf3, 0x27, 0xea, 0x4d, 0x98, 0xe0, 0xd8,
-	0x3b, 0x0d, 0x56, 0x67, 0xb6, 0x56, 0xf5, 0x74, 0x9c, 0x81, 0xb1, 0x3a, 0x5e, 0xea, 0x8c, 0x22,
-	0x78, 0x97, 0xb6, 0x2b, 0xf2, 0xbb, 0xf8, 0x12, 0xe9, 0x24, 0xe2, 0x1e, 0x6f, 0xc0, 0x88, 0x17,
-	0x86, 0x07, 0xee, 0x54, 0x64, 0x77, 0xed, 0xa4, 0x76, 0xa8, 0xfc, 0x88, 0x80, 0x1c, 0x58, 0xd0,
-	0x9b, 0x48, 0x21, 0xc2, 0xbf, 0x78, 0x3d, 0xf4, 0xad, 0xc7, 0x3f, 0x10, 0x78, 0x31, 0x15, 0x11,
-	0x15, 0xb8, 0x09, 0xa3, 0xde, 0xb6, 0xbc, 0x06, 0xa7, 0x48, 0xd0, 0x89, 0xed, 0x5f, 0x57, 0x1b,
-	0x30, 0xc3, 0x41, 0xdf, 0x67, 0x8e, 0x5e, 0x6c, 0xe3, 0xba, 0xbf, 0x1a, 0x59, 0x1b, 0xec, 0x3e,
-	0x49, 0x47, 0x6e, 0x02, 0xe7, 0x48, 0x7d, 0x92, 0x78, 0x98, 0x7c, 0x07, 0x9f, 0xce, 0xd8, 0x9a,
-	0xa8, 0xcc, 0x22, 0x0c, 0xba, 0xc1, 0x38, 0x17, 0x57, 0x23, 0xa2, 0xb8, 0xd1, 0x25, 0x1e, 0x22,
-	0x7f, 0x4a, 0xd0, 0x27, 0xb8, 0xd7, 0xec, 0x62, 0xcf, 0x03, 0xda, 0xb7, 0xae, 0x7f, 0x4d, 0xd0,
-	0x5d, 0x44, 0x40, 0x70, 0x53, 0xcb, 0x42, 0x28, 0xaf, 0xd5, 0x09, 0xbb, 0x12, 0x31, 0xfd, 0x6b,
-	0xf1, 0x57, 0x04, 0xed, 0x09, 0x62, 0x05, 0x9a, 0xdb, 0xee, 0x1d, 0xc9, 0xd4, 0xbb, 0xbe, 0x69,
-	0xf5, 0xa5, 0x67, 0x0a, 0x82, 0x50, 0xff, 0xab, 0x50, 0x0f, 0xc3, 0x96, 0x00, 0x2d, 0xd1, 0x39,
-	0x38, 0x50, 0x4e, 0x88, 0xdf, 0x0b, 0xfb, 0xd0, 0xce, 0x83, 0x5d, 0x79, 0x05, 0x9e, 0xe7, 0x6c,
-	0xbb, 0x5a, 0xb5, 0xea, 0x9e, 0x6d, 0x87, 0x55, 0x27, 0xf3, 0xcb, 0x61, 0x17, 0x67, 0x33, 0x90,
-	0x8b, 0x9b, 0xba, 0x05, 0x43, 0x8e, 0x7b, 0x19, 0x0f, 0x81, 0xa8, 0x6f, 0xf5, 0x25, 0x15, 0x07,
-	0x9f, 0xfc, 0x39, 0x33, 0x50, 0x12, 0x09, 0x85, 0xfb, 0xcf, 0xc2, 0x10, 0x5f, 0x96, 0x7e, 0x4e,
-	0x60, 0xb4, 0xbd, 0x75, 0x3a, 0x17, 0x59, 0x22, 0xf6, 0xf3, 0x46, 0x9a, 0xef, 0x1a, 0x27, 0x10,
-	0x65, 0xe5, 0x93, 0xdf, 0xff, 0x7e, 0x70, 0x61, 0x81, 0xce, 0xa9, 0xe1, 0xaf, 0x31, 0xf4, 0x66,
-	0xd6, 0x01, 0x53, 0x8f, 0x3d, 0x9f, 0xd6, 0xa2, 0xdf, 0x13, 0x18, 0x0f, 0xbd, 0xb0, 0xe9, 0x4a,
-	0x4a, 0xb1, 0xc8, 0x57, 0x8f, 0xb4, 0x9a, 0x31, 0x1a, 0x01, 0x37, 0x38, 0xa0, 0x42, 0x57, 0x12,
-	0x00, 0xb9, 0xbd, 0x68, 0x22, 0x27, 0x4e, 0x6d, 0x8b, 0x3e, 0x24, 0x70, 0xc9, 0xff, 0x31, 0x41,
-	0x17, 0x53, 0xaa, 0x06, 0x3f, 0x78, 0xa4, 0xa5, 0x2c, 0xa1, 0x48, 0x97, 0xe7, 0x74, 0xcb, 0x74,
-	0x31, 0x81, 0x0e, 0xbf, 0x45, 0xfc, 0x0a, 0x9e, 0x10, 0xb8, 0x1c, 0xb0, 0xec, 0x34, 0xad, 0x60,
-	0xc8, 0xf4, 0x49, 0xcb, 0x99, 0x62, 0x91, 0x6e, 0x8d, 0xd3, 0x2d, 0xd1, 0x85, 0x78, 0x3a, 0x7b,
-	0xaf, 0xdc, 0xdc, 0xe3, 0xde, 0xd0, 0x55, 0xae, 0x66, 0x5a, 0x2d, 0xfa, 0x2b, 0x81, 0x89, 0x38,
-	0xaf, 0x4c, 0xf3, 0xdd, 0xba, 0x16, 0xf1, 0xf7, 0x52, 0xa1, 0x97, 0x14, 0x24, 0x7e, 0x95, 0x13,
-	0xdf, 0xa0, 0xeb, 0x69, 0xdd, 0x36, 0x75, 0x4e, 0x2e, 0x6e, 0xf9, 0x94, 0xfd, 0x25, 0x0a, 0x2f,
-	0x04, 0xce, 0x06, 0x1f, 0xd0, 0xb9, 0xd0, 0x4b, 0x0a, 0xc2, 0xdf, 0xe2, 0xf0, 0x05, 0xba, 0x96,
-	0x01, 0x3e, 0x28, 0xfb, 0x67, 0x04, 0x46, 0xbc, 0x97, 0x2d, 0x7d, 0x39, 0xbe, 0x74, 0xc8, 0x15,
-	0x48, 0x73, 0xdd, 0xc2, 0x90, 0x4a, 0xe5, 0x54, 0x8b, 0x74, 0x3e, 0x42, 0xe5, 0x9d, 0x62, 0xea,
-	0xb1, 0xef, 0x88, 0x6b, 0xd1, 0xc7, 0x04, 0xae, 0xc5, 0xdb, 0x3e, 0xba, 0x9e, 0x5e, 0x33, 0xd6,
-	0xc7, 0x4a, 0x1b, 0xbd, 0x25, 0x21, 0xf6, 0x6b, 0x1c, 0x7b, 0x93, 0x6e, 0x24, 0x62, 0x77, 0x86,
-	0x00, 0x0f, 0x01, 0xdf, 0xf3, 0xff, 0x98, 0xc0, 0x73, 0x31, 0xee, 0x8c, 0xae, 0xc5, 0xb3, 0x24,
-	0x9b, 0x47, 0x29, 0xdf, 0x43, 0x06, 0xa2, 0xbf, 0xc5, 0xd1, 0xdf, 0xa4, 0xaf, 0x47, 0xd0, 0xdd,
-	0xf7, 0xbd, 0x4b, 0xdd, 0xd6, 0x9b, 0x7b, 0x92, 0xa0, 0xfe, 0xea, 0x31, 0xbf, 0xd8, 0xa2, 0x3f,
-	0x12, 0x18, 0x0f, 0x19, 0xb1, 0xa4, 0xa3, 0x36, 0xde, 0x38, 0x26, 0x1d, 0xb5, 0x09, 0xee, 0x2e,
-	0x65, 0x7e, 0xb9, 0x4f, 0xf1, 0x83, 0x87, 0x46, 0xe6, 0x1b, 0x02, 0x97, 0xfc, 0x3e, 0x28, 0xe9,
-	0xb8, 0x8d, 0x31, 0x70, 0x49, 0xc7, 0x6d, 0x9c, 0xad, 0x4a, 0x99, 0xe5, 0x36, 0x21, 0x2a, 0x8a,
-	0x1a, 0x3e, 0x22, 0x70, 0x25, 0xe8, 0x38, 0x68, 0x97, 0x13, 0x34, 0x60, 0x99, 0xa4, 0x95, 0x6c,
-	0xc1, 0x88, 0xb7, 0xce, 0xf1, 0x56, 0xe9, 0x72, 0xca, 0x79, 0x2b, 0xde, 0x08, 0xbe, 0x51, 0x3d,
-	0x21, 0x30, 0xe6, 0xf3, 0x01, 0x74, 0x21, 0xbe, 0x64, 0xd4, 0x9b, 0x48, 0x8b, 0x19, 0x22, 0x91,
-	0x6c, 0x93, 0x93, 0xad, 0x51, 0x25, 0xf9, 0x69, 0x0a, 0x4d, 0x21, 0xf7, 0x21, 0xc5, 0x37, 0x9e,
-	0x9c, 0xe6, 0xc8, 0xd3, 0xd3, 0x1c, 0xf9, 0xeb, 0x34, 0x47, 0xbe, 0x38, 0xcb, 0x0d, 0x3c, 0x3d,
-	0xcb, 0x0d, 0xfc, 0x71, 0x96, 0x1b, 0xf8, 0xe0, 0x25, 0xc3, 0x74, 0x3e, 0x3c, 0x2c, 0x2b, 0xfb,
-	0xac, 0xe6, 0xad, 0x29, 0xfe, 0x59, 0xb5, 0x2b, 0x1f, 0xa9, 0x1f, 0x8b, 0x02, 0xe5, 0x8b, 0xfc,
-	0xcf, 0xb0, 0xeb, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x11, 0xfe, 0x04, 0xa9, 0x4e, 0x16, 0x00,
-	0x00,
+	// 1307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0x21, 0x69, 0x92, 0x97, 0xb6, 0x91, 0x86, 0xa4, 0x38, 0x9b, 0xc8, 0x09, 0x0b,
+	0xcd, 0xef, 0xec, 0xc6, 0x4e, 0x9a, 0x56, 0xfc, 0xaa, 0x6a, 0x89, 0x86, 0x1c, 0x8a, 0x52, 0x13,
+	0x71, 0xe0, 0x12, 0xad, 0xe3, 0x8d, 0x59, 0x61, 0xef, 0xba, 0xde, 0x4d, 0x84, 0x15, 0xf9, 0x82,
+	0x44, 0x0f, 0x88, 0x03, 0x50, 0x84, 0x4a, 0xc4, 0xa1, 0x07, 0x24, 0x38, 0x71, 0x02, 0x21, 0x21,
+	0x71, 0xe8, 0x01, 0xa9, 0xc7, 0x0a, 0x2e, 0x9c, 0x10, 0x4a, 0xf8, 0x43, 0xd0, 0xce, 0xbc, 0xb5,
+	0xf7, 0xc7, 0xec, 0xda, 0x16, 0x16, 0xcd, 0xa9, 0xf5, 0xee, 0x7b, 0xf3, 0x3e, 0xf3, 0x7d, 0x6f,
+	0x67, 0xbf, 0x1b, 0x98, 0xda, 0xb7, 0xec, 0x8a, 0x65, 0xab, 0xa5, 0x9a, 0x75, 0x58, 0x55, 0x8f,
+	0x32, 0xea, 0xbd, 0x43, 0xbd, 0x56, 0x57, 0xaa, 0x35, 0xcb, 0xb1, 0xe8, 0x18, 0xbf, 0xa9, 0xb0,
+	0x9b, 0xca, 0x51, 0x46, 0x1a, 0x2f, 0x59, 0x25, 0x8b, 0xdd, 0x53, 0xdd, 0xff, 0xf1, 0x30, 0x69,
+	0xba, 0x64, 0x59, 0xa5, 0xb2, 0xae, 0x6a, 0x55, 0x43, 0xd5, 0x4c, 0xd3, 0x72, 0x34, 0xc7, 0xb0,
+	0x4c, 0x1b, 0xef, 0x46, 0x2a, 0x38, 0xf5, 0xaa, 0xee, 0xdd, 0x5c, 0xc2, 0x9b, 0x05, 0xcd, 0xd6,
+	0x79, 0x69, 0xf5, 0x28, 0x53, 0xd0, 0x1d, 0x2d, 0xa3, 0x56, 0xb5, 0x92, 0x61, 0xb2, 0x95, 0x30,
+	0x76, 0x92, 0xc7, 0xee, 0xf1, 0xfa, 0x88, 0xc6, 0x7e, 0xc8, 0x59, 0x98, 0xb8, 0xeb, 0x26, 0x6f,
+	0xb9, 0x35, 0xb6, 0xcd, 0x03, 0x2b, 0xaf, 0xdf, 0x3b, 0xd4, 0x6d, 0x87, 0x4e, 0xc2, 0x30, 0xab,
+	0xbb, 0x67, 0x14, 0x53, 0x64, 0x96, 0x2c, 0x0c, 0xe4, 0x87, 0xd8, 0xef, 0xed, 0xa2, 0xfc, 0x16,
+	0x5c, 0x09, 0xe7, 0xd8, 0x55, 0xcb, 0xb4, 0x75, 0xaa, 0xc0, 0x80, 0x61, 0x1e, 0x58, 0x2c, 0x61,
+	0x34, 0x2b, 0x29, 0x21, 0x15, 0x94, 0x56, 0x06, 0x8b, 0x93, 0xef, 0xc2, 0x54, 0x6b, 0xa5, 0x1d,
+	0xab, 0x6c, 0xec, 0xd7, 0xfd, 0x0c, 0x59, 0x18, 0xd2, 0x8a, 0xc5, 0x9a, 0x6e, 0xdb, 0x6c, 0xc5,
+	0x91, 0x5c, 0xea, 0xf7, 0x1f, 0x57, 0xc7, 0x71, 0xd1, 0x5b, 0xfc, 0xce, 0x3b, 0x4e, 0xcd, 0x30,
+	0x4b, 0x79, 0x2f, 0x50, 0xde, 0x85, 0x69, 0xf1, 0x92, 0x88, 0xb8, 0x11, 0x40, 0x9c, 0x15, 0x23,
+	0xfa, 0xf2, 0x38, 0x68, 0x03, 0x52, 0xad, 0x55, 0xef, 0xe8, 0x95, 0x82, 0x5e, 0xb3, 0xdb, 0x2b,
+	0x45, 0x6f, 0x03, 0xb4, 0x9a, 0x91, 0xea, 0x67, 0x25, 0xe7, 0xbc, 0x92, 0x6e, 0xe7, 0x14, 0x3e,
+	0x34, 0xd8, 0x39, 0x65, 0x47, 0x2b, 0xe9, 0xb8, 0x6c, 0xde, 0x97, 0x29, 0x7f, 0x43, 0x60, 0x52,
+	0x50, 0x1f, 0xb7, 0xb4, 0x09, 0x43, 0x15, 0x7e, 0x29, 0x45, 0x66, 0x9f, 0x5b, 0x18, 0xcd, 0x4e,
+	0x8b, 0x77, 0xc5, 0xf3, 0xf2, 0x5e, 0x30, 0xdd, 0x12, 0xd0, 0xcd, 0xb7, 0xa5, 0xe3, 0x45, 0x03,
+	0x78, 0x0f, 0x02, 0x78, 0x76, 0xae, 0x7e, 0xab, 0x58, 0x31, 0x4c, 0x4f, 0x1f, 0x05, 0x06, 0x35,
+	0xf7, 0x77, 0xdb, 0x1e, 0xf2, 0xb0, 0x9e, 0x89, 0xf6, 0x35, 0x01, 0x49, 0x44, 0x85, 0xaa, 0x65,
+	0xe1, 0x02, 0x93, 0xc7, 0x13, 0x2d, 0x69, 0x5a, 0x31, 0xb2, 0x77, 0x8a, 0x7d, 0x4c, 0x60, 0x36,
+	0x34, 0xa6, 0x86, 0x6e, 0xe7, 0xf8, 0xcf, 0xff, 0x71, 0xb0, 0x7e, 0x22, 0xf0, 0x62, 0x02, 0x07,
+	0x4a, 0xb5, 0x05, 0x97, 0x39, 0x48, 0x15, 0x03, 0x50, 0xb2, 0xf6, 0x4f, 0xcf, 0xa5, 0x92, 0x7f,
+	0xdd, 0xde, 0xe9, 0x77, 0x12, 0xa3, 0xdf, 0xb9, 0x18, 0xbc, 0x38, 0x51, 0x83, 0xf3, 0x77, 0xfe,
+	0x44, 0xbd, 0x0e, 0xe3, 0x0c, 0x7b, 0xa7, 0x66, 0x55, 0x2d, 0x5b, 0x2b, 0x7b, 0x3a, 0xce, 0xc0,
+	0x68, 0x15, 0x2f, 0xb5, 0x46, 0x11, 0xbc, 0x4b, 0xdb, 0x45, 0xf9, 0x6d, 0x98, 0x08, 0x25, 0xe2,
+	0x1e, 0xaf, 0xc1, 0xb0, 0x17, 0x86, 0x07, 0xee, 0x64, 0x64, 0x77, 0xcd, 0xa4, 0x66, 0xa8, 0xfc,
+	0x88, 0x80, 0x1c, 0x58, 0xd0, 0x9b, 0x48, 0x2e, 0xc2, 0x7f, 0x78, 0x3d, 0xf4, 0xac, 0xc7, 0xdf,
+	0x11, 0x78, 0x29, 0x11, 0x11, 0x15, 0xb8, 0x0e, 0x23, 0xde, 0xb6, 0xbc, 0x06, 0x27, 0x48, 0xd0,
+	0x8a, 0xed, 0x5d, 0x57, 0x6b, 0x30, 0xc3, 0x40, 0xdf, 0xb5, 0x1c, 0x3d, 0xd7, 0xc4, 0x75, 0x7f,
+	0xd5, 0x3a, 0x6d, 0xb0, 0xfb, 0x24, 0x1d, 0xb9, 0x09, 0xa9, 0xfe, 0x36, 0x3a, 0xf3, 0x30, 0xf9,
+	0x0e, 0x3e, 0x9d, 0xc2, 0x9a, 0xa8, 0xcc, 0x22, 0x0c, 0xb8, 0xc1, 0x38, 0x17, 0x13, 0x11, 0x51,
+	0xdc, 0xe8, 0x3c, 0x0b, 0x91, 0xef, 0x13, 0xf4, 0x09, 0xee, 0x35, 0x3b, 0xd7, 0xf5, 0x80, 0xf6,
+	0xac, 0xeb, 0x5f, 0x12, 0x98, 0x16, 0x83, 0xe0, 0xa6, 0x96, 0xb9, 0x50, 0x5e, 0xab, 0x63, 0x76,
+	0xc5, 0x63, 0x7a, 0xd7, 0xe2, 0x2f, 0x08, 0xda, 0x13, 0xc4, 0x0a, 0x34, 0xb7, 0xd9, 0x3b, 0xd2,
+	0x51, 0xef, 0x7a, 0xa6, 0xd5, 0xe7, 0x9e, 0x29, 0x08, 0x42, 0x3d, 0x53, 0xa1, 0x1e, 0x86, 0x2d,
+	0x01, 0x5a, 0xa2, 0x73, 0x70, 0xa0, 0x9c, 0x10, 0x98, 0x12, 0xa2, 0x9d, 0x07, 0xbb, 0xf2, 0x0a,
+	0xbc, 0xc0, 0xd8, 0x76, 0xb5, 0x72, 0xd9, 0x3d, 0xdb, 0x0e, 0xcb, 0x4e, 0xc7, 0x2f, 0x87, 0x5d,
+	0x48, 0x45, 0x73, 0x71, 0x53, 0x37, 0x60, 0xd0, 0x71, 0x2f, 0xe3, 0x21, 0x10, 0xf5, 0xad, 0xbe,
+	0xa4, 0xdc, 0xc0, 0x93, 0xbf, 0x66, 0xfa, 0xf2, 0x3c, 0x41, 0xbe, 0xef, 0xbd, 0x63, 0x6f, 0x97,
+	0x35, 0xc7, 0xd1, 0x4d, 0xbd, 0xf8, 0x8c, 0xac, 0xf9, 0x0f, 0xde, 0xbb, 0x2a, 0x06, 0x04, 0x77,
+	0x7a, 0x33, 0xec, 0xd1, 0xaf, 0x46, 0xf6, 0x2a, 0x5a, 0xa0, 0xf7, 0x66, 0x3d, 0xfb, 0x1b, 0x85,
+	0x41, 0x06, 0x4c, 0x3f, 0x25, 0x30, 0xd2, 0x1c, 0x1a, 0x3a, 0x17, 0x01, 0x12, 0x7e, 0x18, 0x4a,
+	0xf3, 0x6d, 0xe3, 0x78, 0x51, 0x59, 0xf9, 0xe8, 0x8f, 0x7f, 0x1e, 0xf4, 0x2f, 0xd0, 0x39, 0x35,
+	0xfc, 0x1d, 0x8b, 0x3d, 0x31, 0x0f, 0x2c, 0xf5, 0xd8, 0xeb, 0x4f, 0x83, 0x7e, 0x4b, 0x60, 0x2c,
+	0x64, 0x75, 0xe8, 0x4a, 0x42, 0xb1, 0xc8, 0xf7, 0xa2, 0xb4, 0xda, 0x61, 0x34, 0x02, 0x6e, 0x30,
+	0x40, 0x85, 0xae, 0xc4, 0x00, 0x32, 0x63, 0x56, 0x47, 0x4e, 0x7c, 0xde, 0x1b, 0xf4, 0x21, 0x81,
+	0x8b, 0xfe, 0x16, 0xd3, 0xc5, 0x84, 0xaa, 0xc1, 0x79, 0x94, 0x96, 0x3a, 0x09, 0x45, 0xba, 0x0c,
+	0xa3, 0x5b, 0xa6, 0x8b, 0x31, 0x74, 0x38, 0x18, 0x7e, 0x05, 0x4f, 0x08, 0x5c, 0x0a, 0x7c, 0xec,
+	0xd0, 0xa4, 0x82, 0x21, 0xbb, 0x2c, 0x2d, 0x77, 0x14, 0x8b, 0x74, 0x6b, 0x8c, 0x6e, 0x89, 0x2e,
+	0x88, 0xe9, 0xec, 0xbd, 0x42, 0x7d, 0x8f, 0xb9, 0x6a, 0x57, 0xb9, 0x8a, 0x61, 0x36, 0xe8, 0x2f,
+	0x04, 0xc6, 0x45, 0x5f, 0x19, 0x34, 0xd3, 0xae, 0x6b, 0x91, 0x2f, 0x23, 0x29, 0xdb, 0x4d, 0x0a,
+	0x12, 0xbf, 0xca, 0x88, 0xaf, 0xd1, 0xf5, 0xa4, 0x6e, 0x1b, 0x3a, 0x23, 0xe7, 0xb7, 0x7c, 0xca,
+	0xfe, 0x1c, 0x85, 0xe7, 0x02, 0x77, 0x06, 0x1f, 0xd0, 0x39, 0xdb, 0x4d, 0x0a, 0xc2, 0xdf, 0x60,
+	0xf0, 0x59, 0xba, 0xd6, 0x01, 0x7c, 0x50, 0xf6, 0x4f, 0x08, 0x0c, 0x7b, 0x36, 0x85, 0x5e, 0x15,
+	0x97, 0x0e, 0xf9, 0x29, 0x69, 0xae, 0x5d, 0x18, 0x52, 0xa9, 0x8c, 0x6a, 0x91, 0xce, 0x47, 0xa8,
+	0xbc, 0xf3, 0x5f, 0x3d, 0xf6, 0xbd, 0x1c, 0x1a, 0xf4, 0x31, 0x81, 0x2b, 0x62, 0xc3, 0x4c, 0xd7,
+	0x93, 0x6b, 0x0a, 0xbf, 0x00, 0xa4, 0x8d, 0xee, 0x92, 0x10, 0xfb, 0x35, 0x86, 0xbd, 0x49, 0x37,
+	0x62, 0xb1, 0x5b, 0x43, 0x80, 0x87, 0x80, 0xef, 0xf9, 0x7f, 0x4c, 0xe0, 0x79, 0x81, 0xaf, 0xa5,
+	0x6b, 0x62, 0x96, 0x78, 0xdb, 0x2d, 0x65, 0xba, 0xc8, 0x40, 0xf4, 0x37, 0x19, 0xfa, 0x4d, 0xfa,
+	0x7a, 0x04, 0xdd, 0x75, 0x4a, 0x2e, 0x75, 0x53, 0x6f, 0xf7, 0x42, 0x2d, 0xa8, 0xbf, 0x7a, 0xcc,
+	0x2e, 0x36, 0xe8, 0xf7, 0x04, 0xc6, 0x42, 0x16, 0x36, 0xee, 0xa8, 0x15, 0x5b, 0x6e, 0x69, 0xb5,
+	0xc3, 0xe8, 0xb6, 0xf3, 0xeb, 0x12, 0xd9, 0x7e, 0xf0, 0xd0, 0xc8, 0x7c, 0x45, 0xe0, 0xa2, 0xdf,
+	0x41, 0xc6, 0x1d, 0xb7, 0x02, 0xeb, 0x1b, 0x77, 0xdc, 0x8a, 0x0c, 0x69, 0xc2, 0x2c, 0x37, 0x09,
+	0x51, 0x51, 0xd4, 0xf0, 0x11, 0x81, 0xcb, 0x41, 0xaf, 0x46, 0xdb, 0x9c, 0xa0, 0x01, 0xb3, 0x29,
+	0xad, 0x74, 0x16, 0x8c, 0x78, 0xeb, 0x0c, 0x6f, 0x95, 0x2e, 0x27, 0x9c, 0xb7, 0xfc, 0x8d, 0xe0,
+	0x1b, 0xd5, 0x13, 0x02, 0xa3, 0x3e, 0x07, 0x45, 0x17, 0xc4, 0x25, 0xa3, 0xae, 0x4e, 0x5a, 0xec,
+	0x20, 0x12, 0xc9, 0x36, 0x19, 0xd9, 0x1a, 0x55, 0xe2, 0x9f, 0xa6, 0xd0, 0x14, 0x32, 0x07, 0x47,
+	0x7f, 0x25, 0x30, 0x21, 0xf4, 0x4c, 0x34, 0xe6, 0x80, 0x4c, 0x72, 0x7a, 0xd2, 0x7a, 0x57, 0x39,
+	0x6d, 0x5f, 0x09, 0x07, 0x5e, 0xde, 0x5e, 0xdc, 0xcb, 0x36, 0xf7, 0xc6, 0x93, 0xd3, 0x34, 0x79,
+	0x7a, 0x9a, 0x26, 0x7f, 0x9f, 0xa6, 0xc9, 0x67, 0x67, 0xe9, 0xbe, 0xa7, 0x67, 0xe9, 0xbe, 0x3f,
+	0xcf, 0xd2, 0x7d, 0xef, 0xbd, 0x5c, 0x32, 0x9c, 0xf7, 0x0f, 0x0b, 0xca, 0xbe, 0x55, 0xf1, 0x16,
+	0xe6, 0xff, 0xac, 0xda, 0xc5, 0x0f, 0xd4, 0x0f, 0x79, 0x95, 0xc2, 0x05, 0xf6, 0x07, 0xf8, 0xf5,
+	0x7f, 0x07, 0x00, 0x12, 0xaa, 0xc6, 0x6f, 0x48, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1536,6 +1652,10 @@ type QueryClient interface {
 	// then it simply returns the `final_tally_result` state stored in the
 	// proposal itself.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// FlattenedGroupMembers queries the effective members of a group, that is
+	// its direct members along with the members of its sub-groups, recursively
+	// up to the maximum sub-group depth.
+	FlattenedGroupMembers(ctx context.Context, in *QueryFlattenedGroupMembersRequest, opts ...grpc.CallOption) (*QueryFlattenedGroupMembersResponse, error)
 }
 
 type queryClient struct {
@@ -1663,6 +1783,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) FlattenedGroupMembers(ctx context.Context, in *QueryFlattenedGroupMembersRequest, opts ...grpc.CallOption) (*QueryFlattenedGroupMembersResponse, error) {
+	out := new(QueryFlattenedGroupMembersResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/FlattenedGroupMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GroupInfo queries group info based on group id.
@@ -1695,6 +1824,10 @@ type QueryServer interface {
 	// then it simply returns the `final_tally_result` state stored in the
 	// proposal itself.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// FlattenedGroupMembers queries the effective members of a group, that is
+	// its direct members along with the members of its sub-groups, recursively
+	// up to the maximum sub-group depth.
+	FlattenedGroupMembers(context.Context, *QueryFlattenedGroupMembersRequest) (*QueryFlattenedGroupMembersResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1740,6 +1873,9 @@ func (*UnimplementedQueryServer) GroupsByMember(ctx context.Context, req *QueryG
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) FlattenedGroupMembers(ctx context.Context, req *QueryFlattenedGroupMembersRequest) (*QueryFlattenedGroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlattenedGroupMembers not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1979,6 +2115,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FlattenedGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFlattenedGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FlattenedGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/FlattenedGroupMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FlattenedGroupMembers(ctx, req.(*QueryFlattenedGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2035,6 +2189,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "FlattenedGroupMembers",
+			Handler:    _Query_FlattenedGroupMembers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -3084,6 +3242,95 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFlattenedGroupMembersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFlattenedGroupMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFlattenedGroupMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFlattenedGroupMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFlattenedGroupMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFlattenedGroupMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3508,6 +3755,41 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFlattenedGroupMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFlattenedGroupMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6196,6 +6478,231 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFlattenedGroupMembersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFlattenedGroupMembersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFlattenedGroupMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFlattenedGroupMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFlattenedGroupMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFlattenedGroupMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &FlattenedGroupMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
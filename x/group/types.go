@@ -32,14 +32,22 @@ type DecisionPolicy interface {
 	// votes are accepted.
 	GetVotingPeriod() time.Duration
 	// Allow defines policy-specific logic to allow a proposal to pass or not,
-	// based on its tally result, the group's total power and the time since
-	// the proposal was submitted.
-	Allow(tallyResult TallyResult, totalPower string, sinceSubmission time.Duration) (DecisionPolicyResult, error)
+	// based on its tally result, the group's total power, the time since
+	// the proposal was submitted, the proposal's messages and the weighted
+	// votes of the group members counted in the tally.
+	Allow(tallyResult TallyResult, totalPower string, sinceSubmission time.Duration, msgs []sdk.Msg, votes []WeightedVote) (DecisionPolicyResult, error)
 
 	ValidateBasic() error
 	Validate(g GroupInfo, config Config) error
 }
 
+// WeightedVote is a vote on a proposal together with the weight its voter
+// holds in the group at the time of the tally.
+type WeightedVote struct {
+	Vote   Vote
+	Weight string
+}
+
 // Implements DecisionPolicy Interface
 var _ DecisionPolicy = &ThresholdDecisionPolicy{}
 
@@ -65,7 +73,8 @@ func (p ThresholdDecisionPolicy) ValidateBasic() error {
 }
 
 // Allow allows a proposal to pass when the tally of yes votes equals or exceeds the threshold before the timeout.
-func (p ThresholdDecisionPolicy) Allow(tallyResult TallyResult, totalPower string, sinceSubmission time.Duration) (DecisionPolicyResult, error) {
+// The proposal's messages and the individual votes are not taken into account.
+func (p ThresholdDecisionPolicy) Allow(tallyResult TallyResult, totalPower string, sinceSubmission time.Duration, _ []sdk.Msg, _ []WeightedVote) (DecisionPolicyResult, error) {
 	if sinceSubmission < p.Windows.MinExecutionPeriod {
 		return DecisionPolicyResult{}, errors.ErrUnauthorized.Wrapf("must wait %s after submission before execution, currently at %s", p.Windows.MinExecutionPeriod, sinceSubmission)
 	}
@@ -178,7 +187,8 @@ func (p *PercentageDecisionPolicy) Validate(g GroupInfo, config Config) error {
 }
 
 // Allow allows a proposal to pass when the tally of yes votes equals or exceeds the percentage threshold before the timeout.
-func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string, sinceSubmission time.Duration) (DecisionPolicyResult, error) {
+// The proposal's messages and the individual votes are not taken into account.
+func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string, sinceSubmission time.Duration, _ []sdk.Msg, _ []WeightedVote) (DecisionPolicyResult, error) {
 	if sinceSubmission < p.Windows.MinExecutionPeriod {
 		return DecisionPolicyResult{}, errors.ErrUnauthorized.Wrapf("must wait %s after submission before execution, currently at %s", p.Windows.MinExecutionPeriod, sinceSubmission)
 	}
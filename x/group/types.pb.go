@@ -632,6 +632,83 @@ func (m *GroupMember) GetMember() *Member {
 	return nil
 }
 
+// FlattenedGroupMember represents a member of a group, either a direct member
+// or a member of one of its sub-groups. A sub-group is a group whose group
+// policy account is a member of another group.
+type FlattenedGroupMember struct {
+	// group_id is the unique ID of the group the member directly belongs to.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// member is the member data, as stored in the group with group_id.
+	Member *Member `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	// depth is the nesting depth of the member: 0 for direct members of the
+	// queried group, 1 for members of its sub-groups, and so on.
+	Depth uint64 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	// sub_group_id is the unique ID of the group whose group policy account is
+	// the member, or 0 if the member is not a sub-group.
+	SubGroupId uint64 `protobuf:"varint,4,opt,name=sub_group_id,json=subGroupId,proto3" json:"sub_group_id,omitempty"`
+}
+
+func (m *FlattenedGroupMember) Reset()         { *m = FlattenedGroupMember{} }
+func (m *FlattenedGroupMember) String() string { return proto.CompactTextString(m) }
+func (*FlattenedGroupMember) ProtoMessage()    {}
+func (*FlattenedGroupMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
+}
+func (m *FlattenedGroupMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlattenedGroupMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlattenedGroupMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlattenedGroupMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlattenedGroupMember.Merge(m, src)
+}
+func (m *FlattenedGroupMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlattenedGroupMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlattenedGroupMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlattenedGroupMember proto.InternalMessageInfo
+
+func (m *FlattenedGroupMember) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *FlattenedGroupMember) GetMember() *Member {
+	if m != nil {
+		return m.Member
+	}
+	return nil
+}
+
+func (m *FlattenedGroupMember) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *FlattenedGroupMember) GetSubGroupId() uint64 {
+	if m != nil {
+		return m.SubGroupId
+	}
+	return 0
+}
+
 // GroupPolicyInfo represents the high-level on-chain information for a group policy.
 type GroupPolicyInfo struct {
 	// address is the account address of group policy.
@@ -655,7 +732,7 @@ func (m *GroupPolicyInfo) Reset()         { *m = GroupPolicyInfo{} }
 func (m *GroupPolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupPolicyInfo) ProtoMessage()    {}
 func (*GroupPolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
 }
 func (m *GroupPolicyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -730,7 +807,7 @@ func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,7 +852,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -822,7 +899,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -897,6 +974,7 @@ func init() {
 	proto.RegisterType((*DecisionPolicyWindows)(nil), "cosmos.group.v1.DecisionPolicyWindows")
 	proto.RegisterType((*GroupInfo)(nil), "cosmos.group.v1.GroupInfo")
 	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1.GroupMember")
+	proto.RegisterType((*FlattenedGroupMember)(nil), "cosmos.group.v1.FlattenedGroupMember")
 	proto.RegisterType((*GroupPolicyInfo)(nil), "cosmos.group.v1.GroupPolicyInfo")
 	proto.RegisterType((*Proposal)(nil), "cosmos.group.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.group.v1.TallyResult")
@@ -906,89 +984,91 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xf6, 0xda, 0x8e, 0x3f, 0x5e, 0xa7, 0xb6, 0x99, 0x86, 0x66, 0x93, 0x14, 0x3b, 0x98, 0x0a,
-	0xa2, 0xa2, 0xd8, 0x6d, 0x2a, 0x81, 0xd4, 0x03, 0x60, 0x3b, 0x5b, 0xea, 0xaa, 0xb5, 0xad, 0xdd,
-	0x75, 0x42, 0xb9, 0xac, 0x36, 0xde, 0xe9, 0x66, 0x85, 0xbd, 0x63, 0x76, 0xc7, 0x49, 0xfd, 0x0f,
-	0x7a, 0x41, 0xf4, 0xc8, 0x05, 0xa9, 0x12, 0xbf, 0x00, 0xa9, 0x07, 0xc4, 0x85, 0x6b, 0xd5, 0x03,
-	0xaa, 0x38, 0x71, 0x02, 0xd4, 0x5e, 0xe0, 0xc4, 0x95, 0x23, 0xda, 0x99, 0xd9, 0xc4, 0x1f, 0x89,
-	0x69, 0x2a, 0xe0, 0x94, 0xcc, 0x3c, 0xcf, 0xfb, 0xce, 0xf3, 0x7e, 0xae, 0x0c, 0x6b, 0x5d, 0xe2,
-	0xf7, 0x89, 0x5f, 0xb1, 0x3d, 0x32, 0x1c, 0x54, 0x0e, 0xae, 0x56, 0xe8, 0x68, 0x80, 0xfd, 0xf2,
-	0xc0, 0x23, 0x94, 0xa0, 0x1c, 0x07, 0xcb, 0x0c, 0x2c, 0x1f, 0x5c, 0x5d, 0x5d, 0xb2, 0x89, 0x4d,
-	0x18, 0x56, 0x09, 0xfe, 0xe3, 0xb4, 0xd5, 0x82, 0x4d, 0x88, 0xdd, 0xc3, 0x15, 0x76, 0xda, 0x1b,
-	0xde, 0xab, 0x58, 0x43, 0xcf, 0xa4, 0x0e, 0x71, 0x05, 0x5e, 0x9c, 0xc6, 0xa9, 0xd3, 0xc7, 0x3e,
-	0x35, 0xfb, 0x03, 0x41, 0x58, 0xe1, 0xef, 0x18, 0xdc, 0xb3, 0x78, 0x54, 0x40, 0xd3, 0xb6, 0xa6,
-	0x3b, 0xe2, 0x50, 0xe9, 0x5b, 0x09, 0x12, 0x77, 0x70, 0x7f, 0x0f, 0x7b, 0x68, 0x0b, 0x92, 0xa6,
-	0x65, 0x79, 0xd8, 0xf7, 0x65, 0x69, 0x5d, 0xda, 0x48, 0xd7, 0xe4, 0x9f, 0x1e, 0x6f, 0x2e, 0x09,
-	0x47, 0x55, 0x8e, 0x68, 0xd4, 0x73, 0x5c, 0x5b, 0x0d, 0x89, 0xe8, 0x02, 0x24, 0x0e, 0xb1, 0x63,
-	0xef, 0x53, 0x39, 0x1a, 0x98, 0xa8, 0xe2, 0x84, 0x56, 0x21, 0xd5, 0xc7, 0xd4, 0xb4, 0x4c, 0x6a,
-	0xca, 0x31, 0x86, 0x1c, 0x9d, 0xd1, 0x87, 0x90, 0x32, 0x2d, 0x0b, 0x5b, 0x86, 0x49, 0xe5, 0xf8,
-	0xba, 0xb4, 0x91, 0xd9, 0x5a, 0x2d, 0x73, 0x81, 0xe5, 0x50, 0x60, 0x59, 0x0f, 0x83, 0xab, 0xa5,
-	0x9e, 0xfc, 0x52, 0x8c, 0x3c, 0xfc, 0xb5, 0x28, 0xb1, 0x47, 0xb1, 0x55, 0xa5, 0xa5, 0x43, 0x38,
-	0xc7, 0x25, 0xab, 0xf8, 0xf3, 0x21, 0xf6, 0xe9, 0xff, 0xa5, 0xbc, 0xf4, 0x85, 0x04, 0xcb, 0xfa,
-	0xbe, 0x87, 0xfd, 0x7d, 0xd2, 0xb3, 0xb6, 0x71, 0xd7, 0xf1, 0x1d, 0xe2, 0xb6, 0x49, 0xcf, 0xe9,
-	0x8e, 0xd0, 0x45, 0x48, 0xd3, 0x10, 0xe2, 0x2a, 0xd4, 0xe3, 0x0b, 0xf4, 0x11, 0x24, 0x0f, 0x1d,
-	0xd7, 0x22, 0x87, 0x3e, 0x7b, 0x2e, 0xb3, 0xf5, 0x76, 0x79, 0xaa, 0x2d, 0xca, 0x93, 0xfe, 0x76,
-	0x39, 0x5b, 0x0d, 0xcd, 0xae, 0xa3, 0xa7, 0x8f, 0x37, 0xb3, 0x93, 0x9c, 0xd2, 0x43, 0x09, 0xe4,
-	0x36, 0xf6, 0xba, 0xd8, 0xa5, 0xa6, 0x8d, 0xa7, 0x04, 0x15, 0x00, 0x06, 0x47, 0x98, 0x50, 0x34,
-	0x76, 0xf3, 0x1f, 0x49, 0xfa, 0x4e, 0x82, 0xd7, 0x4f, 0x34, 0x43, 0x37, 0xe1, 0xdc, 0x01, 0xa1,
-	0x8e, 0x6b, 0x1b, 0x03, 0xec, 0x39, 0x84, 0x27, 0x29, 0xb3, 0xb5, 0x32, 0x53, 0xfb, 0x6d, 0xd1,
-	0xf8, 0xbc, 0xf4, 0x5f, 0x05, 0xa5, 0x5f, 0xe4, 0x96, 0x6d, 0x66, 0x88, 0x3a, 0xb0, 0xd4, 0x77,
-	0x5c, 0x03, 0xdf, 0xc7, 0xdd, 0x61, 0x40, 0x0c, 0x1d, 0x46, 0x5f, 0xde, 0x21, 0xea, 0x3b, 0xae,
-	0x12, 0xda, 0x73, 0xb7, 0xa5, 0x3f, 0x24, 0x48, 0x7f, 0x1c, 0x84, 0xde, 0x70, 0xef, 0x11, 0x94,
-	0x85, 0xa8, 0xc3, 0x35, 0xc6, 0xd5, 0xa8, 0x63, 0xa1, 0x32, 0x2c, 0x98, 0x56, 0xdf, 0x71, 0x79,
-	0xbb, 0xcc, 0xe9, 0x30, 0x4e, 0x9b, 0x3b, 0x01, 0x32, 0x24, 0x0f, 0xb0, 0x17, 0xa4, 0x88, 0x0d,
-	0x40, 0x5c, 0x0d, 0x8f, 0xe8, 0x4d, 0x58, 0xa4, 0x84, 0x9a, 0x3d, 0x43, 0xf4, 0xe6, 0x02, 0xb3,
-	0xcc, 0xb0, 0xbb, 0x5d, 0xde, 0xa0, 0x75, 0x80, 0xae, 0x87, 0x4d, 0xca, 0x07, 0x28, 0x71, 0x86,
-	0x01, 0x4a, 0x0b, 0xbb, 0x2a, 0x2d, 0xdd, 0x85, 0x0c, 0x0b, 0x55, 0x8c, 0xfe, 0x0a, 0xa4, 0x58,
-	0xd1, 0x8d, 0xa3, 0x90, 0x93, 0xec, 0xdc, 0xb0, 0x50, 0x05, 0x12, 0x7d, 0x46, 0x12, 0xe9, 0x5d,
-	0x9e, 0xe9, 0x12, 0x31, 0x8b, 0x82, 0x56, 0xfa, 0x2b, 0x0a, 0x39, 0xe6, 0x9b, 0x97, 0x9f, 0x25,
-	0xf3, 0x55, 0x06, 0x74, 0x5c, 0x53, 0x74, 0x52, 0xd3, 0x51, 0x2d, 0x62, 0x67, 0xaf, 0x45, 0xfc,
-	0xf4, 0x5a, 0x2c, 0x4c, 0xd6, 0xc2, 0x84, 0x9c, 0x25, 0x3a, 0xd9, 0x18, 0xb0, 0x58, 0x44, 0xb6,
-	0x97, 0x66, 0xb2, 0x5d, 0x75, 0x47, 0xb5, 0xd2, 0xd3, 0xc7, 0x9b, 0x85, 0xf9, 0x13, 0xa4, 0x66,
-	0xad, 0xc9, 0x19, 0x9d, 0xac, 0x65, 0xf2, 0x95, 0x6a, 0x79, 0x3d, 0xf5, 0xe0, 0x51, 0x31, 0xf2,
-	0xfb, 0xa3, 0xa2, 0x54, 0xfa, 0x61, 0x01, 0x52, 0x6d, 0x8f, 0x0c, 0x88, 0x6f, 0xf6, 0x66, 0x1a,
-	0xf8, 0x16, 0x2c, 0xf1, 0x7c, 0xf2, 0x58, 0x8c, 0xb0, 0x20, 0xff, 0xd4, 0xcf, 0xc8, 0x3e, 0x2e,
-	0xa6, 0x40, 0xe6, 0x36, 0xf7, 0x7b, 0x90, 0x1e, 0x30, 0x0d, 0xd8, 0xf3, 0xe5, 0xf8, 0x7a, 0x6c,
-	0xae, 0xf3, 0x63, 0x2a, 0x52, 0x20, 0xe3, 0x0f, 0xf7, 0xfa, 0x0e, 0x35, 0x82, 0x2f, 0x1b, 0x2b,
-	0xc6, 0xcb, 0x26, 0x03, 0xb8, 0x61, 0x00, 0xa1, 0xb7, 0xe0, 0x1c, 0x0f, 0x33, 0xac, 0x6a, 0x82,
-	0x65, 0x60, 0x91, 0x5d, 0xee, 0x88, 0xd2, 0x5e, 0x99, 0xca, 0x45, 0xc8, 0x4d, 0x32, 0xee, 0x78,
-	0xc4, 0xa1, 0xc5, 0xfb, 0x90, 0xf0, 0xa9, 0x49, 0x87, 0xbe, 0x9c, 0x5a, 0x97, 0x36, 0xb2, 0x5b,
-	0xc5, 0x99, 0x31, 0x08, 0x13, 0xaf, 0x31, 0x9a, 0x2a, 0xe8, 0xa8, 0x0d, 0xe8, 0x9e, 0xe3, 0x9a,
-	0x3d, 0x83, 0x9a, 0xbd, 0xde, 0xc8, 0xf0, 0xb0, 0x3f, 0xec, 0x51, 0x39, 0xcd, 0xa2, 0xbb, 0x38,
-	0xe3, 0x44, 0x0f, 0x48, 0x2a, 0xe3, 0xd4, 0xe2, 0x41, 0x7c, 0x6a, 0x9e, 0x59, 0x8f, 0xdd, 0xa3,
-	0x36, 0xbc, 0x36, 0xb1, 0x48, 0x0d, 0xec, 0x5a, 0x32, 0x9c, 0x21, 0x5d, 0xb9, 0xf1, 0x6d, 0xaa,
-	0xb8, 0x16, 0x6a, 0x43, 0x8e, 0x2f, 0x53, 0xe2, 0x85, 0x02, 0x33, 0x2c, 0xca, 0x77, 0x4e, 0x8d,
-	0x52, 0x11, 0x7c, 0xae, 0x49, 0xcd, 0xe2, 0x89, 0x33, 0xba, 0x12, 0x34, 0x88, 0xef, 0x9b, 0x36,
-	0xf6, 0xe5, 0xc5, 0xf5, 0xd8, 0x69, 0x43, 0xa3, 0x1e, 0xb1, 0xae, 0xc7, 0x83, 0x2e, 0x2e, 0x7d,
-	0x2d, 0x41, 0x66, 0x3c, 0xd6, 0x35, 0x48, 0x8f, 0xb0, 0x6f, 0x74, 0xc9, 0xd0, 0xa5, 0xe2, 0x1b,
-	0x96, 0x1a, 0x61, 0xbf, 0x1e, 0x9c, 0x83, 0x52, 0x9b, 0x7b, 0x3e, 0x35, 0x1d, 0x57, 0x10, 0xf8,
-	0x97, 0x7c, 0x51, 0x5c, 0x72, 0xd2, 0x0a, 0xa4, 0x5c, 0x22, 0x70, 0xde, 0xaa, 0x49, 0x97, 0x70,
-	0xe8, 0x5d, 0x40, 0x2e, 0x31, 0x0e, 0x1d, 0xba, 0x6f, 0x1c, 0x60, 0x1a, 0x92, 0xf8, 0x82, 0xc8,
-	0xb9, 0x64, 0xd7, 0xa1, 0xfb, 0x3b, 0x98, 0x72, 0xb2, 0xd0, 0xf7, 0xa7, 0x04, 0xf1, 0x1d, 0x42,
-	0x31, 0x2a, 0x42, 0x66, 0x20, 0x52, 0x71, 0xbc, 0x34, 0x21, 0xbc, 0xe2, 0x3b, 0xea, 0x80, 0x50,
-	0xb1, 0x36, 0xe7, 0xee, 0x28, 0x46, 0x43, 0xd7, 0x20, 0x41, 0x06, 0xc1, 0xd7, 0x88, 0xa9, 0xcc,
-	0x6e, 0xad, 0xcd, 0xa4, 0x3e, 0x78, 0xb7, 0xc5, 0x28, 0xaa, 0xa0, 0xce, 0x5d, 0x6c, 0xff, 0xce,
-	0x3c, 0x5d, 0xfe, 0x52, 0x02, 0x38, 0x7e, 0x19, 0xad, 0xc1, 0xf2, 0x4e, 0x4b, 0x57, 0x8c, 0x56,
-	0x5b, 0x6f, 0xb4, 0x9a, 0x46, 0xa7, 0xa9, 0xb5, 0x95, 0x7a, 0xe3, 0x46, 0x43, 0xd9, 0xce, 0x47,
-	0xd0, 0x79, 0xc8, 0x8d, 0x83, 0x77, 0x15, 0x2d, 0x2f, 0xa1, 0x65, 0x38, 0x3f, 0x7e, 0x59, 0xad,
-	0x69, 0x7a, 0xb5, 0xd1, 0xcc, 0x47, 0x11, 0x82, 0xec, 0x38, 0xd0, 0x6c, 0xe5, 0x63, 0xe8, 0x22,
-	0xc8, 0x93, 0x77, 0xc6, 0x6e, 0x43, 0xbf, 0x69, 0xec, 0x28, 0x7a, 0x2b, 0x1f, 0x5f, 0x8d, 0x3f,
-	0xf8, 0xa6, 0x10, 0xb9, 0xfc, 0xa3, 0x04, 0xd9, 0xc9, 0x61, 0x43, 0x45, 0x58, 0x6b, 0xab, 0xad,
-	0x76, 0x4b, 0xab, 0xde, 0x36, 0x34, 0xbd, 0xaa, 0x77, 0xb4, 0x29, 0x65, 0x6f, 0xc0, 0xca, 0x34,
-	0x41, 0xeb, 0xd4, 0xee, 0x34, 0x74, 0x5d, 0xd9, 0xce, 0x4b, 0xc1, 0xb3, 0xd3, 0x70, 0xb5, 0x5e,
-	0x57, 0xda, 0x01, 0x1a, 0x3d, 0x09, 0x55, 0x95, 0x5b, 0x4a, 0x3d, 0x40, 0x63, 0x41, 0x46, 0x66,
-	0x6c, 0x6b, 0x2d, 0x35, 0x00, 0xe3, 0x27, 0xbd, 0x1b, 0x04, 0xb4, 0xad, 0x56, 0x77, 0x9b, 0xf9,
-	0x05, 0x11, 0xd0, 0xf7, 0x12, 0x5c, 0x38, 0x79, 0xae, 0xd0, 0x06, 0x5c, 0x3a, 0xb2, 0x57, 0x3e,
-	0x51, 0xea, 0x1d, 0xbd, 0xa5, 0x1a, 0xaa, 0xa2, 0x75, 0x6e, 0xeb, 0x53, 0x11, 0x5e, 0x82, 0xf5,
-	0x53, 0x99, 0xcd, 0x96, 0x6e, 0xa8, 0x9d, 0x66, 0x5e, 0x9a, 0xcb, 0xd2, 0x3a, 0xf5, 0xba, 0xa2,
-	0x69, 0xf9, 0xe8, 0x5c, 0xd6, 0x8d, 0x6a, 0xe3, 0x76, 0x47, 0x55, 0xf2, 0x31, 0x2e, 0xbe, 0xf6,
-	0xc1, 0x93, 0xe7, 0x05, 0xe9, 0xd9, 0xf3, 0x82, 0xf4, 0xdb, 0xf3, 0x82, 0xf4, 0xf0, 0x45, 0x21,
-	0xf2, 0xec, 0x45, 0x21, 0xf2, 0xf3, 0x8b, 0x42, 0xe4, 0xd3, 0x4b, 0xb6, 0x43, 0xf7, 0x87, 0x7b,
-	0xe5, 0x2e, 0xe9, 0x8b, 0x9f, 0x23, 0xe2, 0xcf, 0xa6, 0x6f, 0x7d, 0x56, 0xb9, 0xcf, 0x7f, 0x2d,
-	0xed, 0x25, 0x58, 0x27, 0x5e, 0xfb, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xea, 0x13, 0x0f, 0x14, 0x44,
-	0x0d, 0x00, 0x00,
+	// 1342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x1b, 0xcf, 0x3a, 0xfe, 0x7c, 0x9c, 0xda, 0x7e, 0xa7, 0x7e, 0x9b, 0x4d, 0xd2, 0xd7, 0xf6, 0x6b,
+	0x2a, 0x88, 0x8a, 0x62, 0xb7, 0xa9, 0x04, 0x52, 0x0f, 0x80, 0xed, 0x6c, 0xa9, 0xab, 0xd6, 0xb6,
+	0xd6, 0xeb, 0x84, 0x72, 0x59, 0xad, 0xbd, 0x53, 0x7b, 0x85, 0xbd, 0x63, 0x76, 0xc7, 0x49, 0xfd,
+	0x1f, 0xf4, 0x82, 0xe8, 0x11, 0x21, 0x21, 0x55, 0xe2, 0x2f, 0x40, 0xea, 0x01, 0x71, 0xe1, 0x5a,
+	0xf5, 0x80, 0x2a, 0x4e, 0x9c, 0x00, 0xb5, 0x17, 0x38, 0x71, 0xe5, 0x88, 0x76, 0x66, 0xd6, 0xf1,
+	0x47, 0xe2, 0x92, 0x8a, 0x72, 0x8a, 0x67, 0x7e, 0xbf, 0x67, 0x9e, 0xaf, 0xdf, 0xf3, 0x38, 0x86,
+	0xad, 0x0e, 0x71, 0x07, 0xc4, 0x2d, 0x76, 0x1d, 0x32, 0x1a, 0x16, 0x0f, 0xaf, 0x16, 0xe9, 0x78,
+	0x88, 0xdd, 0xc2, 0xd0, 0x21, 0x94, 0xa0, 0x24, 0x07, 0x0b, 0x0c, 0x2c, 0x1c, 0x5e, 0xdd, 0x4c,
+	0x77, 0x49, 0x97, 0x30, 0xac, 0xe8, 0x7d, 0xe2, 0xb4, 0xcd, 0x4c, 0x97, 0x90, 0x6e, 0x1f, 0x17,
+	0xd9, 0xa9, 0x3d, 0xba, 0x57, 0x34, 0x47, 0x8e, 0x41, 0x2d, 0x62, 0x0b, 0x3c, 0x3b, 0x8f, 0x53,
+	0x6b, 0x80, 0x5d, 0x6a, 0x0c, 0x86, 0x82, 0xb0, 0xc1, 0xfd, 0xe8, 0xfc, 0x65, 0xe1, 0x54, 0x40,
+	0xf3, 0xb6, 0x86, 0x3d, 0xe6, 0x50, 0xfe, 0x1b, 0x09, 0xc2, 0x77, 0xf0, 0xa0, 0x8d, 0x1d, 0xb4,
+	0x0b, 0x11, 0xc3, 0x34, 0x1d, 0xec, 0xba, 0xb2, 0x94, 0x93, 0xb6, 0x63, 0x65, 0xf9, 0xc7, 0xc7,
+	0x3b, 0x69, 0xf1, 0x50, 0x89, 0x23, 0x4d, 0xea, 0x58, 0x76, 0x57, 0xf5, 0x89, 0xe8, 0x02, 0x84,
+	0x8f, 0xb0, 0xd5, 0xed, 0x51, 0x39, 0xe0, 0x99, 0xa8, 0xe2, 0x84, 0x36, 0x21, 0x3a, 0xc0, 0xd4,
+	0x30, 0x0d, 0x6a, 0xc8, 0xab, 0x0c, 0x99, 0x9c, 0xd1, 0xfb, 0x10, 0x35, 0x4c, 0x13, 0x9b, 0xba,
+	0x41, 0xe5, 0x60, 0x4e, 0xda, 0x8e, 0xef, 0x6e, 0x16, 0x78, 0x80, 0x05, 0x3f, 0xc0, 0x82, 0xe6,
+	0x27, 0x57, 0x8e, 0x3e, 0xf9, 0x39, 0xbb, 0xf2, 0xf0, 0x97, 0xac, 0xc4, 0x9c, 0x62, 0xb3, 0x44,
+	0xf3, 0x47, 0x70, 0x8e, 0x87, 0xac, 0xe2, 0x4f, 0x47, 0xd8, 0xa5, 0xff, 0x56, 0xe4, 0xf9, 0xcf,
+	0x24, 0x58, 0xd7, 0x7a, 0x0e, 0x76, 0x7b, 0xa4, 0x6f, 0xee, 0xe1, 0x8e, 0xe5, 0x5a, 0xc4, 0x6e,
+	0x90, 0xbe, 0xd5, 0x19, 0xa3, 0x8b, 0x10, 0xa3, 0x3e, 0xc4, 0xa3, 0x50, 0x8f, 0x2f, 0xd0, 0x07,
+	0x10, 0x39, 0xb2, 0x6c, 0x93, 0x1c, 0xb9, 0xcc, 0x5d, 0x7c, 0xf7, 0xcd, 0xc2, 0x9c, 0x2c, 0x0a,
+	0xb3, 0xef, 0x1d, 0x70, 0xb6, 0xea, 0x9b, 0x5d, 0x47, 0x4f, 0x1f, 0xef, 0x24, 0x66, 0x39, 0xf9,
+	0x87, 0x12, 0xc8, 0x0d, 0xec, 0x74, 0xb0, 0x4d, 0x8d, 0x2e, 0x9e, 0x0b, 0x28, 0x03, 0x30, 0x9c,
+	0x60, 0x22, 0xa2, 0xa9, 0x9b, 0xd7, 0x14, 0xd2, 0xb7, 0x12, 0xfc, 0xf7, 0x44, 0x33, 0x74, 0x13,
+	0xce, 0x1d, 0x12, 0x6a, 0xd9, 0x5d, 0x7d, 0x88, 0x1d, 0x8b, 0xf0, 0x22, 0xc5, 0x77, 0x37, 0x16,
+	0x7a, 0xbf, 0x27, 0x84, 0xcf, 0x5b, 0xff, 0x85, 0xd7, 0xfa, 0x35, 0x6e, 0xd9, 0x60, 0x86, 0xa8,
+	0x05, 0xe9, 0x81, 0x65, 0xeb, 0xf8, 0x3e, 0xee, 0x8c, 0x3c, 0xa2, 0xff, 0x60, 0xe0, 0xef, 0x3f,
+	0x88, 0x06, 0x96, 0xad, 0xf8, 0xf6, 0xfc, 0xd9, 0xfc, 0xef, 0x12, 0xc4, 0x3e, 0xf4, 0x52, 0xaf,
+	0xda, 0xf7, 0x08, 0x4a, 0x40, 0xc0, 0xe2, 0x31, 0x06, 0xd5, 0x80, 0x65, 0xa2, 0x02, 0x84, 0x0c,
+	0x73, 0x60, 0xd9, 0x72, 0xe0, 0x25, 0x0a, 0xe3, 0xb4, 0xa5, 0x13, 0x20, 0x43, 0xe4, 0x10, 0x3b,
+	0x5e, 0x89, 0xd8, 0x00, 0x04, 0x55, 0xff, 0x88, 0xfe, 0x0f, 0x6b, 0x94, 0x50, 0xa3, 0xaf, 0x0b,
+	0x6d, 0x86, 0x98, 0x65, 0x9c, 0xdd, 0x1d, 0x70, 0x81, 0x56, 0x00, 0x3a, 0x0e, 0x36, 0x28, 0x1f,
+	0xa0, 0xf0, 0x19, 0x06, 0x28, 0x26, 0xec, 0x4a, 0x34, 0x7f, 0x17, 0xe2, 0x2c, 0x55, 0x31, 0xfa,
+	0x1b, 0x10, 0x65, 0x4d, 0xd7, 0x27, 0x29, 0x47, 0xd8, 0xb9, 0x6a, 0xa2, 0x22, 0x84, 0x07, 0x8c,
+	0x24, 0xca, 0xbb, 0xbe, 0xa0, 0x12, 0x31, 0x8b, 0x82, 0x96, 0xff, 0x52, 0x82, 0xf4, 0x8d, 0xbe,
+	0x41, 0x29, 0xb6, 0xb1, 0xf9, 0x9a, 0x9c, 0xa0, 0x34, 0x84, 0x4c, 0x3c, 0xa4, 0x3d, 0x56, 0xda,
+	0xa0, 0xca, 0x0f, 0x28, 0x07, 0x6b, 0xee, 0xa8, 0xad, 0x4f, 0xbc, 0xf0, 0xe2, 0x82, 0x3b, 0x6a,
+	0xf3, 0xbe, 0x9a, 0xf9, 0x3f, 0x03, 0x90, 0x64, 0x9f, 0xb9, 0x36, 0x59, 0xa7, 0x5f, 0x65, 0x7b,
+	0x4c, 0xe7, 0x12, 0x98, 0xcd, 0x65, 0x22, 0x94, 0xd5, 0xb3, 0x0b, 0x25, 0x78, 0xba, 0x50, 0x42,
+	0xb3, 0x42, 0x31, 0x20, 0x69, 0x8a, 0x31, 0xd3, 0x87, 0x2c, 0x17, 0x21, 0x85, 0xf4, 0x82, 0x14,
+	0x4a, 0xf6, 0xb8, 0x9c, 0x7f, 0xfa, 0x78, 0x27, 0xb3, 0x7c, 0xbc, 0xd5, 0x84, 0x39, 0x73, 0x9e,
+	0x13, 0x5a, 0xe4, 0x95, 0x84, 0x76, 0x3d, 0xfa, 0xe0, 0x51, 0x76, 0xe5, 0xb7, 0x47, 0x59, 0x29,
+	0xff, 0x7d, 0x08, 0xa2, 0x0d, 0x87, 0x0c, 0x89, 0x6b, 0xf4, 0x17, 0xa6, 0xeb, 0x16, 0xa4, 0x79,
+	0x3d, 0x79, 0x2e, 0xba, 0xdf, 0x90, 0x97, 0x0d, 0x1b, 0xea, 0x1e, 0x37, 0x53, 0x20, 0x4b, 0x27,
+	0xef, 0x1d, 0x88, 0x0d, 0x59, 0x0c, 0xd8, 0x71, 0xe5, 0x60, 0x6e, 0x75, 0xe9, 0xe3, 0xc7, 0x54,
+	0xa4, 0x40, 0xdc, 0x1d, 0xb5, 0x07, 0x16, 0xd5, 0xbd, 0xaf, 0x5d, 0x39, 0x74, 0x86, 0x62, 0x00,
+	0x37, 0xf4, 0x20, 0xf4, 0x06, 0x9c, 0xe3, 0x69, 0xfa, 0x5d, 0x0d, 0xb3, 0x0a, 0xac, 0xb1, 0xcb,
+	0x7d, 0xd1, 0xda, 0x2b, 0x73, 0xb5, 0xf0, 0xb9, 0x11, 0xc6, 0x9d, 0xce, 0xd8, 0xb7, 0x78, 0x17,
+	0xc2, 0x2e, 0x35, 0xe8, 0xc8, 0x95, 0xa3, 0x39, 0x69, 0x3b, 0xb1, 0x9b, 0x5d, 0x18, 0x1f, 0xbf,
+	0xf0, 0x4d, 0x46, 0x53, 0x05, 0x1d, 0x35, 0x00, 0xdd, 0xb3, 0x6c, 0xa3, 0xaf, 0x53, 0xa3, 0xdf,
+	0x1f, 0xeb, 0x0e, 0x76, 0x47, 0x7d, 0x2a, 0xc7, 0x58, 0x76, 0x17, 0x17, 0x1e, 0xd1, 0x3c, 0x92,
+	0xca, 0x38, 0xe5, 0xa0, 0x97, 0x9f, 0x9a, 0x62, 0xd6, 0x53, 0xf7, 0xa8, 0x01, 0xff, 0x99, 0xd9,
+	0xf2, 0x3a, 0xb6, 0x4d, 0x19, 0xce, 0x50, 0xae, 0xe4, 0xf4, 0xaa, 0x57, 0x6c, 0x13, 0x35, 0x20,
+	0xc9, 0x37, 0x3d, 0x71, 0xfc, 0x00, 0xe3, 0x2c, 0xcb, 0xb7, 0x4e, 0xcd, 0x52, 0x11, 0x7c, 0x1e,
+	0x93, 0x9a, 0xc0, 0x33, 0x67, 0x74, 0xc5, 0x13, 0x88, 0xeb, 0x1a, 0x5d, 0xec, 0xca, 0x6b, 0xb9,
+	0xd5, 0xd3, 0x86, 0x46, 0x9d, 0xb0, 0xae, 0x07, 0x3d, 0x15, 0xe7, 0xbf, 0x92, 0x20, 0x3e, 0x9d,
+	0xeb, 0x16, 0xc4, 0xc6, 0xd8, 0xd5, 0x3b, 0x64, 0x64, 0x53, 0xf1, 0x05, 0x1b, 0x1d, 0x63, 0xb7,
+	0xe2, 0x9d, 0xbd, 0x56, 0x1b, 0x6d, 0x97, 0x1a, 0x96, 0x2d, 0x08, 0xfc, 0xdf, 0x8c, 0x35, 0x71,
+	0xc9, 0x49, 0x1b, 0x10, 0xb5, 0x89, 0xc0, 0xb9, 0x54, 0x23, 0x36, 0xe1, 0xd0, 0xdb, 0x80, 0x6c,
+	0xa2, 0x1f, 0x59, 0xb4, 0xa7, 0x1f, 0x62, 0xea, 0x93, 0xf8, 0x82, 0x48, 0xda, 0xe4, 0xc0, 0xa2,
+	0xbd, 0x7d, 0x4c, 0x39, 0x59, 0xc4, 0xf7, 0x87, 0x04, 0xc1, 0x7d, 0x42, 0x31, 0xca, 0x42, 0x7c,
+	0x28, 0x4a, 0x71, 0xbc, 0x6c, 0xc1, 0xbf, 0xe2, 0x3b, 0xea, 0x90, 0x50, 0xb1, 0x6e, 0x97, 0xee,
+	0x28, 0x46, 0x43, 0xd7, 0x20, 0x4c, 0x86, 0xde, 0x57, 0x25, 0x8b, 0x32, 0xb1, 0xbb, 0xb5, 0x50,
+	0x7a, 0xcf, 0x6f, 0x9d, 0x51, 0x54, 0x41, 0x5d, 0xba, 0xd8, 0xfe, 0x99, 0x79, 0xba, 0xfc, 0xb9,
+	0x04, 0x70, 0xec, 0x19, 0x6d, 0xc1, 0xfa, 0x7e, 0x5d, 0x53, 0xf4, 0x7a, 0x43, 0xab, 0xd6, 0x6b,
+	0x7a, 0xab, 0xd6, 0x6c, 0x28, 0x95, 0xea, 0x8d, 0xaa, 0xb2, 0x97, 0x5a, 0x41, 0xe7, 0x21, 0x39,
+	0x0d, 0xde, 0x55, 0x9a, 0x29, 0x09, 0xad, 0xc3, 0xf9, 0xe9, 0xcb, 0x52, 0xb9, 0xa9, 0x95, 0xaa,
+	0xb5, 0x54, 0x00, 0x21, 0x48, 0x4c, 0x03, 0xb5, 0x7a, 0x6a, 0x15, 0x5d, 0x04, 0x79, 0xf6, 0x4e,
+	0x3f, 0xa8, 0x6a, 0x37, 0xf5, 0x7d, 0x45, 0xab, 0xa7, 0x82, 0x9b, 0xc1, 0x07, 0x5f, 0x67, 0x56,
+	0x2e, 0xff, 0x20, 0x41, 0x62, 0x76, 0xd8, 0x50, 0x16, 0xb6, 0x1a, 0x6a, 0xbd, 0x51, 0x6f, 0x96,
+	0x6e, 0xeb, 0x4d, 0xad, 0xa4, 0xb5, 0x9a, 0x73, 0x91, 0xfd, 0x0f, 0x36, 0xe6, 0x09, 0xcd, 0x56,
+	0xf9, 0x4e, 0x55, 0xd3, 0x94, 0xbd, 0x94, 0xe4, 0xb9, 0x9d, 0x87, 0x4b, 0x95, 0x8a, 0xd2, 0xf0,
+	0xd0, 0xc0, 0x49, 0xa8, 0xaa, 0xdc, 0x52, 0x2a, 0x1e, 0xba, 0xea, 0x55, 0x64, 0xc1, 0xb6, 0x5c,
+	0x57, 0x3d, 0x30, 0x78, 0x92, 0x5f, 0x2f, 0xa1, 0x3d, 0xb5, 0x74, 0x50, 0x4b, 0x85, 0x44, 0x42,
+	0xdf, 0x49, 0x70, 0xe1, 0xe4, 0xb9, 0x42, 0xdb, 0x70, 0x69, 0x62, 0xaf, 0x7c, 0xa4, 0x54, 0x5a,
+	0x5a, 0x5d, 0xd5, 0x55, 0xa5, 0xd9, 0xba, 0xad, 0xcd, 0x65, 0x78, 0x09, 0x72, 0xa7, 0x32, 0x6b,
+	0x75, 0x4d, 0x57, 0x5b, 0xb5, 0x94, 0xb4, 0x94, 0xd5, 0x6c, 0x55, 0x2a, 0x4a, 0xb3, 0x99, 0x0a,
+	0x2c, 0x65, 0xdd, 0x28, 0x55, 0x6f, 0xb7, 0x54, 0x25, 0xb5, 0xca, 0x83, 0x2f, 0xbf, 0xf7, 0xe4,
+	0x79, 0x46, 0x7a, 0xf6, 0x3c, 0x23, 0xfd, 0xfa, 0x3c, 0x23, 0x3d, 0x7c, 0x91, 0x59, 0x79, 0xf6,
+	0x22, 0xb3, 0xf2, 0xd3, 0x8b, 0xcc, 0xca, 0xc7, 0x97, 0xba, 0x16, 0xed, 0x8d, 0xda, 0x85, 0x0e,
+	0x19, 0x88, 0xdf, 0x4a, 0xe2, 0xcf, 0x8e, 0x6b, 0x7e, 0x52, 0xbc, 0xcf, 0x7f, 0xca, 0xb5, 0xc3,
+	0x4c, 0x89, 0xd7, 0xfe, 0x1a, 0x00, 0xd8, 0x57, 0xc5, 0x61, 0xe1, 0x0d, 0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1354,6 +1434,56 @@ func (m *GroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FlattenedGroupMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlattenedGroupMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlattenedGroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubGroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SubGroupId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Depth != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Member != nil {
+		{
+			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GroupPolicyInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1374,12 +1504,12 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err8 != nil {
-		return 0, err8
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n8
-	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i -= n9
+	i = encodeVarintTypes(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x3a
 	if m.DecisionPolicy != nil {
@@ -1467,12 +1597,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x58
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err10 != nil {
-		return 0, err10
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n11
+	i = encodeVarintTypes(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x52
 	{
@@ -1500,12 +1630,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err12 != nil {
-		return 0, err12
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n12
-	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i -= n13
+	i = encodeVarintTypes(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Proposers) > 0 {
@@ -1610,12 +1740,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err13 != nil {
-		return 0, err13
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n13
-	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i -= n14
+	i = encodeVarintTypes(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -1792,6 +1922,28 @@ func (m *GroupMember) Size() (n int) {
 	return n
 }
 
+func (m *FlattenedGroupMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GroupId))
+	}
+	if m.Member != nil {
+		l = m.Member.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovTypes(uint64(m.Depth))
+	}
+	if m.SubGroupId != 0 {
+		n += 1 + sovTypes(uint64(m.SubGroupId))
+	}
+	return n
+}
+
 func (m *GroupPolicyInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2930,6 +3082,149 @@ func (m *GroupMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FlattenedGroupMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlattenedGroupMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlattenedGroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Member == nil {
+				m.Member = &Member{}
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubGroupId", wireType)
+			}
+			m.SubGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubGroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GroupPolicyInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -262,7 +262,7 @@ func TestPercentageDecisionPolicyAllow(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			policyResult, err := tc.policy.Allow(*tc.tally, tc.totalPower, tc.votingDuration)
+			policyResult, err := tc.policy.Allow(*tc.tally, tc.totalPower, tc.votingDuration, nil, nil)
 			if tc.expErr {
 				require.Error(t, err)
 			} else {
@@ -419,7 +419,7 @@ func TestThresholdDecisionPolicyAllow(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			policyResult, err := tc.policy.Allow(*tc.tally, tc.totalPower, tc.votingDuration)
+			policyResult, err := tc.policy.Allow(*tc.tally, tc.totalPower, tc.votingDuration, nil, nil)
 			if tc.expErr {
 				require.Error(t, err)
 			} else {
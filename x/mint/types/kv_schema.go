@@ -0,0 +1,13 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+)
+
+// KVSchema returns the key/value schema of the mint store.
+func KVSchema() []schema.Entry {
+	return []schema.Entry{
+		{Name: "minter", Prefix: MinterKey, Value: schema.ProtoValue(&Minter{})},
+		{Name: "params", Prefix: ParamsKey, Value: schema.ProtoValue(&Params{})},
+	}
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// KVSchema returns the key/value schema of the nft store.
+func KVSchema() []schema.Entry {
+	classID := schema.Field("class_id", schema.NullTerminated(schema.StringKey))
+	nftID := schema.Field("nft_id", schema.StringKey)
+	return []schema.Entry{
+		{
+			Name:   "class",
+			Prefix: ClassKey,
+			Key:    []schema.KeyField{schema.Field("class_id", schema.StringKey)},
+			Value:  schema.ProtoValue(&nft.Class{}),
+		},
+		{
+			Name:   "nft",
+			Prefix: NFTKey,
+			Key:    []schema.KeyField{classID, nftID},
+			Value:  schema.ProtoValue(&nft.NFT{}),
+		},
+		{
+			Name:   "nft_of_class_by_owner",
+			Prefix: NFTOfClassByOwnerKey,
+			Key: []schema.KeyField{
+				schema.Field("owner", delimited(schema.LengthPrefixed(schema.AccAddressKey))),
+				classID,
+				nftID,
+			},
+			Value: schema.BytesValue,
+		},
+		{
+			Name:   "owner",
+			Prefix: OwnerKey,
+			Key:    []schema.KeyField{classID, nftID},
+			Value:  schema.AccAddressValue,
+		},
+		{
+			Name:   "class_total_supply",
+			Prefix: ClassTotalSupply,
+			Key:    []schema.KeyField{schema.Field("class_id", schema.StringKey)},
+			Value:  schema.Uint64Value,
+		},
+	}
+}
+
+// delimited decodes a field followed by the Delimiter.
+func delimited(c schema.KeyCodec) schema.KeyCodec {
+	return func(key []byte) (interface{}, []byte, error) {
+		value, rest, err := c(key)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(rest) == 0 || rest[0] != Delimiter[0] {
+			return nil, nil, fmt.Errorf("missing delimiter")
+		}
+		return value, rest[1:], nil
+	}
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+)
+
+// KVSchema returns the key/value schema of the params store. The parameters of each
+// subspace are stored under the subspace name and a '/', as JSON.
+func KVSchema() []schema.Entry {
+	return []schema.Entry{
+		{
+			Name: "param",
+			Key: []schema.KeyField{
+				schema.Field("subspace", schema.Terminated('/', schema.StringKey)),
+				schema.Field("key", schema.StringKey),
+			},
+			Value: schema.JSONValue,
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	gogotypes "github.com/gogo/protobuf/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+)
+
+// KVSchema returns the key/value schema of the slashing store.
+func KVSchema() []schema.Entry {
+	return []schema.Entry{
+		{Name: "params", Prefix: ParamsKey, Value: schema.ProtoValue(&Params{})},
+		{
+			Name:   "validator_signing_info",
+			Prefix: ValidatorSigningInfoKeyPrefix,
+			Key:    []schema.KeyField{schema.Field("address", schema.LengthPrefixed(schema.ConsAddressKey))},
+			Value:  schema.ProtoValue(&ValidatorSigningInfo{}),
+		},
+		{
+			Name:   "validator_missed_block",
+			Prefix: ValidatorMissedBlockBitArrayKeyPrefix,
+			Key: []schema.KeyField{
+				schema.Field("address", schema.LengthPrefixed(schema.ConsAddressKey)),
+				schema.Field("index", schema.LittleEndianUint64Key),
+			},
+			Value: schema.ProtoValue(&gogotypes.BoolValue{}),
+		},
+		{
+			Name:   "address_pubkey",
+			Prefix: AddrPubkeyRelationKeyPrefix,
+			Key:    []schema.KeyField{schema.Field("address", schema.LengthPrefixed(schema.ConsAddressKey))},
+			Value:  schema.ProtoValue(&codectypes.Any{}),
+		},
+	}
+}
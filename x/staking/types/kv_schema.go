@@ -0,0 +1,135 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+)
+
+// KVSchema returns the key/value schema of the staking store.
+func KVSchema() []schema.Entry {
+	var (
+		delegator    = schema.Field("delegator", schema.LengthPrefixed(schema.AccAddressKey))
+		validator    = schema.Field("validator", schema.LengthPrefixed(schema.ValAddressKey))
+		validatorSrc = schema.Field("validator_src", schema.LengthPrefixed(schema.ValAddressKey))
+		validatorDst = schema.Field("validator_dst", schema.LengthPrefixed(schema.ValAddressKey))
+		time         = schema.Field("time", schema.TimeKey)
+	)
+	return []schema.Entry{
+		{
+			Name:   "last_validator_power",
+			Prefix: LastValidatorPowerKey,
+			Key:    []schema.KeyField{validator},
+			Value:  schema.ProtoValue(&gogotypes.Int64Value{}),
+		},
+		{Name: "last_total_power", Prefix: LastTotalPowerKey, Value: schema.ProtoValue(&sdk.IntProto{})},
+		{
+			Name:   "validator",
+			Prefix: ValidatorsKey,
+			Key:    []schema.KeyField{validator},
+			Value:  schema.ProtoValue(&Validator{}),
+		},
+		{
+			Name:   "validator_by_cons_addr",
+			Prefix: ValidatorsByConsAddrKey,
+			Key:    []schema.KeyField{schema.Field("address", schema.LengthPrefixed(schema.ConsAddressKey))},
+			Value:  schema.ValAddressValue,
+		},
+		{
+			Name:   "validator_by_power",
+			Prefix: ValidatorsByPowerIndexKey,
+			Key: []schema.KeyField{
+				schema.Field("power", schema.Uint64Key),
+				schema.Field("validator", schema.LengthPrefixed(invertedValAddressKey)),
+			},
+			Value: schema.ValAddressValue,
+		},
+		{
+			Name:   "delegation",
+			Prefix: DelegationKey,
+			Key:    []schema.KeyField{delegator, validator},
+			Value:  schema.ProtoValue(&Delegation{}),
+		},
+		{
+			Name:   "unbonding_delegation",
+			Prefix: UnbondingDelegationKey,
+			Key:    []schema.KeyField{delegator, validator},
+			Value:  schema.ProtoValue(&UnbondingDelegation{}),
+		},
+		{
+			Name:   "unbonding_delegation_by_validator",
+			Prefix: UnbondingDelegationByValIndexKey,
+			Key:    []schema.KeyField{validator, delegator},
+			Value:  schema.BytesValue,
+		},
+		{
+			Name:   "redelegation",
+			Prefix: RedelegationKey,
+			Key:    []schema.KeyField{delegator, validatorSrc, validatorDst},
+			Value:  schema.ProtoValue(&Redelegation{}),
+		},
+		{
+			Name:   "redelegation_by_validator_src",
+			Prefix: RedelegationByValSrcIndexKey,
+			Key:    []schema.KeyField{validatorSrc, delegator, validatorDst},
+			Value:  schema.BytesValue,
+		},
+		{
+			Name:   "redelegation_by_validator_dst",
+			Prefix: RedelegationByValDstIndexKey,
+			Key:    []schema.KeyField{validatorDst, delegator, validatorSrc},
+			Value:  schema.BytesValue,
+		},
+		{
+			Name:   "unbonding_queue",
+			Prefix: UnbondingQueueKey,
+			Key:    []schema.KeyField{time},
+			Value:  schema.ProtoValue(&DVPairs{}),
+		},
+		{
+			Name:   "redelegation_queue",
+			Prefix: RedelegationQueueKey,
+			Key:    []schema.KeyField{time},
+			Value:  schema.ProtoValue(&DVVTriplets{}),
+		},
+		{
+			Name:   "validator_queue",
+			Prefix: ValidatorQueueKey,
+			Key:    []schema.KeyField{schema.Field("time", validatorQueueTimeKey), schema.Field("height", schema.Uint64Key)},
+			Value:  schema.ProtoValue(&ValAddresses{}),
+		},
+		{
+			Name:   "historical_info",
+			Prefix: HistoricalInfoKey,
+			Key:    []schema.KeyField{schema.Field("height", schema.StringKey)},
+			Value:  schema.ProtoValue(&HistoricalInfo{}),
+		},
+	}
+}
+
+// invertedValAddressKey decodes the rest of the key as a bit-inverted validator operator
+// address, see GetValidatorsByPowerIndexKey.
+func invertedValAddressKey(key []byte) (interface{}, []byte, error) {
+	addr := make(sdk.ValAddress, len(key))
+	for i, b := range key {
+		addr[i] = ^b
+	}
+	return addr.String(), nil, nil
+}
+
+// validatorQueueTimeKey decodes a time prefixed with its length on 8 bytes, see
+// GetValidatorQueueKey.
+func validatorQueueTimeKey(key []byte) (interface{}, []byte, error) {
+	if len(key) < 8 {
+		return nil, nil, fmt.Errorf("expected 8 bytes, got %d", len(key))
+	}
+	n := binary.BigEndian.Uint64(key)
+	if uint64(len(key)-8) < n {
+		return nil, nil, fmt.Errorf("expected %d bytes, got %d", n, len(key)-8)
+	}
+	return schema.Fixed(int(n), schema.TimeKey)(key[8:])
+}
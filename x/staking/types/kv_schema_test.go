@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestKVSchema(t *testing.T) {
+	registry := schema.NewRegistry(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()))
+	registry.Register(types.StoreKey, types.KVSchema()...)
+
+	valAddr := sdk.ValAddress(keysAddr1)
+	val := newValidator(t, valAddr, keysPK1)
+	val.Tokens = sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	addrs, err := (&types.ValAddresses{Addresses: []string{valAddr.String()}}).Marshal()
+	require.NoError(t, err)
+	now := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		key      []byte
+		value    []byte
+		expected string
+	}{
+		{
+			"validator by power",
+			types.GetValidatorsByPowerIndexKey(val, sdk.DefaultPowerReduction),
+			valAddr,
+			`{"entry":"validator_by_power","key":{"power":10,"validator":"` + valAddr.String() + `"},"value":"` + valAddr.String() + `"}`,
+		},
+		{
+			"validator queue",
+			types.GetValidatorQueueKey(now, 7),
+			addrs,
+			`{"entry":"validator_queue","key":{"time":"2022-01-02T03:04:05Z","height":7},"value":{"addresses":["` + valAddr.String() + `"]}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			decoded, err := registry.Decode(types.StoreKey, tc.key, tc.value)
+			require.NoError(t, err)
+			bz, err := json.Marshal(decoded)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, string(bz))
+		})
+	}
+}
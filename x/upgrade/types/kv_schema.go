@@ -0,0 +1,35 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/kv/schema"
+)
+
+// KVSchema returns the key/value schema of the upgrade store.
+func KVSchema() []schema.Entry {
+	return []schema.Entry{
+		{Name: "plan", Prefix: PlanKey(), Value: schema.ProtoValue(&Plan{})},
+		{
+			Name:   "done",
+			Prefix: []byte{DoneByte},
+			Key:    []schema.KeyField{schema.Field("height", schema.Uint64Key), schema.Field("name", schema.StringKey)},
+			Value:  schema.BytesValue,
+		},
+		{
+			Name:   "module_version",
+			Prefix: []byte{VersionMapByte},
+			Key:    []schema.KeyField{schema.Field("module", schema.StringKey)},
+			Value:  schema.Uint64Value,
+		},
+		{Name: "protocol_version", Prefix: []byte{ProtocolVersionByte}, Value: schema.Uint64Value},
+		{
+			// The upgraded client and consensus states are IBC types, unknown to the SDK.
+			Name:   "upgraded_ibc_state",
+			Prefix: []byte(KeyUpgradedIBCState + "/"),
+			Key: []schema.KeyField{
+				schema.Field("height", schema.Terminated('/', schema.StringKey)),
+				schema.Field("name", schema.StringKey),
+			},
+			Value: schema.BytesValue,
+		},
+	}
+}